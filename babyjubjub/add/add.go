@@ -24,6 +24,12 @@ func (c *BabyJubJubCurveAdd) RequiredGas(input []byte) uint64 {
 	return BabyJubJubCurveAddGas
 }
 
+// MaxOutputSize returns the byte length of the affine sum returned by Run,
+// which is BabyJubJubCurveAddOutputSize regardless of the input.
+func (c *BabyJubJubCurveAdd) MaxOutputSize(input []byte) int {
+	return BabyJubJubCurveAddOutputSize
+}
+
 // Run executes the BabyJubJub point addition precompile.
 //
 // The input must be exactly BabyJubJubAddInputSize bytes, which encode two
@@ -35,24 +41,43 @@ func (c *BabyJubJubCurveAdd) RequiredGas(input []byte) uint64 {
 //
 // Run performs the following steps:
 //  1. Parses the two points from input using utils.ReadAffinePoint.
-//  2. Validates that both points lie on the BabyJubJub curve and in the
-//     correct subgroup.
-//  3. Adds the points in projective coordinates.
-//  4. Returns the resulting affine point serialized with utils.MarshalPoint.
+//     A point that cannot be read is reported as an invalid input length.
+//  2. Validates that both points lie on the BabyJubJub curve.
+//  3. Validates that both points lie in the correct subgroup.
+//  4. Adds the points in projective coordinates.
+//  5. Returns the resulting affine point serialized with utils.MarshalPoint.
 //
 // Returns an error if:
 //   - The input length is incorrect.
-//   - Any point is invalid, not on the curve, or not in the subgroup.
+//   - Any point is not on the curve (ErrorBabyJubJubCurvePointNotOnCurve).
+//   - Any point is not in the subgroup (ErrorBabyJubJubCurvePointNotInSubgroup).
 func (c *BabyJubJubCurveAdd) Run(input []byte) ([]byte, error) {
 	if len(input) != BabyJubJubCurveAddInputSize {
 		return nil, utils.ErrorBabyJubJubCurveInvalidInputLength
 	}
 
-	point1, _ := utils.ReadAffinePoint(input, 0)
-	point2, _ := utils.ReadAffinePoint(input, 1)
+	return c.run(input)
+}
+
+// run adds the two points encoded in input.
+//
+// It does not rely on Run's length check: if either point cannot be read,
+// ErrorBabyJubJubCurveInvalidInputLength is returned instead of dereferencing
+// a nil point.
+func (c *BabyJubJubCurveAdd) run(input []byte) ([]byte, error) {
+	point1, err1 := utils.ReadAffinePoint(input, 0)
+	point2, err2 := utils.ReadAffinePoint(input, 1)
+
+	if err1 != nil || err2 != nil {
+		return nil, utils.ErrorBabyJubJubCurveInvalidInputLength
+	}
+
+	if !point1.InCurve() || !point2.InCurve() {
+		return nil, utils.ErrorBabyJubJubCurvePointNotOnCurve
+	}
 
 	if !point1.InSubGroup() || !point2.InSubGroup() {
-		return nil, utils.ErrorBabyJubJubCurveInvalidPoint
+		return nil, utils.ErrorBabyJubJubCurvePointNotInSubgroup
 	}
 
 	result := babyjub.NewPoint().Projective().Add(point1.Projective(), point2.Projective()).Affine()
@@ -62,3 +87,6 @@ func (c *BabyJubJubCurveAdd) Run(input []byte) ([]byte, error) {
 
 // Ensure BabyJubJubCurveAdd implements the common.Precompile interface.
 var _ common.Precompile = (*BabyJubJubCurveAdd)(nil)
+
+// Ensure BabyJubJubCurveAdd implements the common.OutputSizer interface.
+var _ common.OutputSizer = (*BabyJubJubCurveAdd)(nil)
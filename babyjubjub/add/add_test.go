@@ -9,6 +9,8 @@ import (
 	"github.com/leanovate/gopter"
 	"github.com/leanovate/gopter/prop"
 	"github.com/privacy-ethereum/privacy-precompiles/babyjubjub/utils"
+	"github.com/privacy-ethereum/privacy-precompiles/common"
+	"github.com/privacy-ethereum/privacy-precompiles/internal/commontest"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -21,6 +23,14 @@ func TestBabyJubJubCurveAddName(t *testing.T) {
 	assert.Equal(t, expected, actual)
 }
 
+func TestBabyJubJubCurveAddMaxOutputSize(t *testing.T) {
+	precompile := BabyJubJubCurveAdd{}
+
+	var sizer common.OutputSizer = &precompile
+
+	assert.Equal(t, utils.BabyJubJubCurveAffinePointSize, sizer.MaxOutputSize(nil))
+}
+
 func TestAddPoints(t *testing.T) {
 	tests := []struct {
 		name          string
@@ -55,7 +65,26 @@ func TestAddPoints(t *testing.T) {
 				utils.MarshalPoint(&babyjub.Point{X: big.NewInt(123), Y: big.NewInt(456)}),
 				utils.MarshalPoint(&babyjub.Point{X: big.NewInt(789), Y: big.NewInt(101)})...,
 			),
-			expectedError: utils.ErrorBabyJubJubCurveInvalidPoint,
+			expectedError: utils.ErrorBabyJubJubCurvePointNotOnCurve,
+		},
+		{
+			name: "second point not on curve",
+			input: append(
+				utils.MarshalPoint(babyjub.B8),
+				utils.MarshalPoint(&babyjub.Point{X: big.NewInt(789), Y: big.NewInt(101)})...,
+			),
+			expectedError: utils.ErrorBabyJubJubCurvePointNotOnCurve,
+		},
+		{
+			name: "second point on curve but not in subgroup",
+			input: append(
+				utils.MarshalPoint(babyjub.B8),
+				utils.MarshalPoint(&babyjub.Point{
+					X: big.NewInt(0),
+					Y: new(big.Int).Sub(utils.FieldPrime, big.NewInt(1)), // p - 1 == -1 mod p
+				})...,
+			),
+			expectedError: utils.ErrorBabyJubJubCurvePointNotInSubgroup,
 		},
 		{
 			name: "points not in subgroup",
@@ -70,7 +99,7 @@ func TestAddPoints(t *testing.T) {
 					utils.MarshalPoint(point)...,
 				)
 			}(),
-			expectedError: utils.ErrorBabyJubJubCurveInvalidPoint,
+			expectedError: utils.ErrorBabyJubJubCurvePointNotInSubgroup,
 		},
 		{
 			name:          "input too short",
@@ -107,6 +136,44 @@ func TestAddPoints(t *testing.T) {
 	}
 }
 
+func TestAddPointsTruncatedInput(t *testing.T) {
+	input := append(utils.MarshalPoint(babyjub.B8), utils.MarshalPoint(babyjub.B8)...)
+
+	tests := []struct {
+		name  string
+		input []byte
+	}{
+		{
+			name:  "empty input",
+			input: []byte{},
+		},
+		{
+			name:  "missing second point",
+			input: input[:utils.BabyJubJubCurveAffinePointSize],
+		},
+		{
+			name:  "truncated second point",
+			input: input[:BabyJubJubCurveAddInputSize-1],
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			precompile := BabyJubJubCurveAdd{}
+
+			var actual []byte
+			var err error
+
+			assert.NotPanics(t, func() {
+				actual, err = precompile.run(tt.input)
+			})
+
+			assert.Nil(t, actual)
+			assert.Equal(t, utils.ErrorBabyJubJubCurveInvalidInputLength, err)
+		})
+	}
+}
+
 func TestRunProperties(t *testing.T) {
 	parameters := gopter.DefaultTestParameters()
 	properties := gopter.NewProperties(parameters)
@@ -132,3 +199,26 @@ func TestRunProperties(t *testing.T) {
 
 	properties.TestingRun(t)
 }
+
+func BenchmarkAdd(b *testing.B) {
+	p1, _ := utils.BabyJubJubPointGenerator().Sample()
+	p2, _ := utils.BabyJubJubPointGenerator().Sample()
+	input := append(utils.MarshalPoint(p1.(*babyjub.Point)), utils.MarshalPoint(p2.(*babyjub.Point))...)
+
+	commontest.BenchmarkGasRatio(b, &BabyJubJubCurveAdd{}, input)
+}
+
+func FuzzBabyJubJubAdd(f *testing.F) {
+	f.Add(append(utils.MarshalPoint(babyjub.B8), utils.MarshalPoint(babyjub.B8)...))
+	f.Add([]byte{0x00})
+
+	f.Fuzz(func(t *testing.T, input []byte) {
+		precompile := BabyJubJubCurveAdd{}
+
+		actual, err := precompile.Run(input)
+
+		if err == nil {
+			assert.LessOrEqual(t, len(actual), precompile.MaxOutputSize(input))
+		}
+	})
+}
@@ -0,0 +1,119 @@
+package basemul
+
+import (
+	"math/big"
+
+	"github.com/iden3/go-iden3-crypto/babyjub"
+	"github.com/iden3/go-iden3-crypto/ff"
+	"github.com/privacy-ethereum/privacy-precompiles/babyjubjub/utils"
+	"github.com/privacy-ethereum/privacy-precompiles/common"
+	commonUtils "github.com/privacy-ethereum/privacy-precompiles/utils"
+)
+
+// baseTable holds, for every window i and digit d, the point
+// d * 2^(i * BabyJubJubBaseMulWindowSize) * B8 in projective coordinates.
+var baseTable = newBaseTable()
+
+// BabyJubJubBaseMul implements the BabyJubJub base point multiplication
+// precompile.
+//
+// It computes [scalar]B8 using a fixed-window table precomputed at package
+// initialization, which is much faster than the variable-base
+// multiplication of mul.BabyJubJubCurveMul.
+type BabyJubJubBaseMul struct{}
+
+// Name returns the human-readable name of the precompile.
+func (c *BabyJubJubBaseMul) Name() string {
+	return "BabyJubJubBaseMul"
+}
+
+// RequiredGas returns the fixed gas cost of executing this precompile.
+//
+// For BabyJubJub base point multiplication, the gas cost is
+// BabyJubJubBaseMulGas.
+func (c *BabyJubJubBaseMul) RequiredGas(input []byte) uint64 {
+	return BabyJubJubBaseMulGas
+}
+
+// MaxOutputSize returns the byte length of the affine product returned by Run,
+// which is BabyJubJubBaseMulOutputSize regardless of the input.
+func (c *BabyJubJubBaseMul) MaxOutputSize(input []byte) int {
+	return BabyJubJubBaseMulOutputSize
+}
+
+// Run executes the BabyJubJub base point multiplication precompile.
+//
+// The input must be exactly BabyJubJubBaseMulInputSize bytes, which encode
+// a scalar as a big-endian integer.
+//
+// Run performs the following steps:
+//  1. Parses the scalar using utils.ReadField.
+//  2. Reduces the scalar modulo the BabyJubJub subgroup order.
+//  3. Sums one precomputed table point per window of the scalar.
+//  4. Returns the resulting affine point serialized with utils.MarshalPoint.
+//
+// Returns an error if:
+//   - The input length is incorrect.
+func (c *BabyJubJubBaseMul) Run(input []byte) ([]byte, error) {
+	if len(input) != BabyJubJubBaseMulInputSize {
+		return nil, utils.ErrorBabyJubJubCurveInvalidInputLength
+	}
+
+	scalar, _ := commonUtils.ReadField(input, 0, utils.BabyJubJubCurveFieldByteSize)
+	scalar = utils.ReduceScalar(scalar)
+
+	return utils.MarshalPoint(baseMul(scalar)), nil
+}
+
+// baseMul returns [scalar]B8 for a scalar lower than
+// 2^(8 * BabyJubJubBaseMulInputSize).
+func baseMul(scalar *big.Int) *babyjub.Point {
+	digits := scalar.FillBytes(make([]byte, BabyJubJubBaseMulInputSize))
+	result := identity()
+
+	for index, digit := range digits {
+		window := 2 * (BabyJubJubBaseMulInputSize - 1 - index)
+
+		result.Add(result, baseTable[window][digit&0x0f])
+		result.Add(result, baseTable[window+1][digit>>4])
+	}
+
+	return result.Affine()
+}
+
+// newBaseTable computes the BabyJubJubBaseMulWindows windows of the B8
+// table.
+func newBaseTable() [BabyJubJubBaseMulWindows][1 << BabyJubJubBaseMulWindowSize]*babyjub.PointProjective {
+	var table [BabyJubJubBaseMulWindows][1 << BabyJubJubBaseMulWindowSize]*babyjub.PointProjective
+
+	base := babyjub.B8.Projective()
+
+	for window := range table {
+		table[window][0] = identity()
+
+		for digit := 1; digit < len(table[window]); digit++ {
+			table[window][digit] = identity().Add(table[window][digit-1], base)
+		}
+
+		// The next window starts at 2^BabyJubJubBaseMulWindowSize times the
+		// current base, i.e. one step past the last digit.
+		base = identity().Add(table[window][len(table[window])-1], base)
+	}
+
+	return table
+}
+
+// identity returns the neutral element (0, 1) in projective coordinates.
+func identity() *babyjub.PointProjective {
+	return &babyjub.PointProjective{
+		X: ff.NewElement().SetZero(),
+		Y: ff.NewElement().SetOne(),
+		Z: ff.NewElement().SetOne(),
+	}
+}
+
+// Ensure BabyJubJubBaseMul implements the common.Precompile interface.
+var _ common.Precompile = (*BabyJubJubBaseMul)(nil)
+
+// Ensure BabyJubJubBaseMul implements the common.OutputSizer interface.
+var _ common.OutputSizer = (*BabyJubJubBaseMul)(nil)
@@ -0,0 +1,144 @@
+package basemul
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	"github.com/iden3/go-iden3-crypto/babyjub"
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/prop"
+	"github.com/privacy-ethereum/privacy-precompiles/babyjubjub/mul"
+	"github.com/privacy-ethereum/privacy-precompiles/babyjubjub/utils"
+	"github.com/privacy-ethereum/privacy-precompiles/common"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBabyJubJubBaseMulName(t *testing.T) {
+	precompile := BabyJubJubBaseMul{}
+
+	expected := "BabyJubJubBaseMul"
+	actual := precompile.Name()
+
+	assert.Equal(t, expected, actual)
+}
+
+func TestBabyJubJubBaseMulMaxOutputSize(t *testing.T) {
+	precompile := BabyJubJubBaseMul{}
+
+	var sizer common.OutputSizer = &precompile
+
+	assert.Equal(t, utils.BabyJubJubCurveAffinePointSize, sizer.MaxOutputSize(nil))
+}
+
+func TestBaseMul(t *testing.T) {
+	tests := []struct {
+		name          string
+		input         []byte
+		expected      *babyjub.Point
+		expectedError error
+	}{
+		{
+			name:     "scalar 0",
+			input:    big.NewInt(0).FillBytes(make([]byte, BabyJubJubBaseMulInputSize)),
+			expected: babyjub.NewPoint(),
+		},
+		{
+			name:     "scalar 1",
+			input:    big.NewInt(1).FillBytes(make([]byte, BabyJubJubBaseMulInputSize)),
+			expected: babyjub.B8,
+		},
+		{
+			name:     "non-zero scalar",
+			input:    big.NewInt(1234).FillBytes(make([]byte, BabyJubJubBaseMulInputSize)),
+			expected: babyjub.NewPoint().Mul(big.NewInt(1234), babyjub.B8),
+		},
+		{
+			name:     "subgroup order",
+			input:    babyjub.SubOrder.FillBytes(make([]byte, BabyJubJubBaseMulInputSize)),
+			expected: babyjub.NewPoint(),
+		},
+		{
+			name: "scalar above the subgroup order",
+			input: new(big.Int).Add(babyjub.SubOrder, big.NewInt(1234)).
+				FillBytes(make([]byte, BabyJubJubBaseMulInputSize)),
+			expected: babyjub.NewPoint().Mul(big.NewInt(1234), babyjub.B8),
+		},
+		{
+			name:          "invalid input length",
+			input:         make([]byte, BabyJubJubBaseMulInputSize+1),
+			expectedError: utils.ErrorBabyJubJubCurveInvalidInputLength,
+		},
+		{
+			name:          "empty input",
+			input:         []byte{},
+			expectedError: utils.ErrorBabyJubJubCurveInvalidInputLength,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			precompile := BabyJubJubBaseMul{}
+
+			actual, err := precompile.Run(tt.input)
+			gas := precompile.RequiredGas(tt.input)
+
+			if tt.expectedError != nil {
+				assert.NotNil(t, err)
+				assert.Equal(t, tt.expectedError, err)
+
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, BabyJubJubBaseMulGas, gas)
+			assert.Equal(t, utils.MarshalPoint(tt.expected), actual)
+		})
+	}
+}
+
+func TestBaseMulProperties(t *testing.T) {
+	parameters := gopter.DefaultTestParameters()
+	properties := gopter.NewProperties(parameters)
+
+	properties.Property("Run matches the variable-base multiplication of B8", prop.ForAll(
+		func(scalar *big.Int) bool {
+			baseMulPrecompile := BabyJubJubBaseMul{}
+			mulPrecompile := mul.BabyJubJubCurveMul{}
+
+			encodedScalar := scalar.FillBytes(make([]byte, utils.BabyJubJubCurveFieldByteSize))
+
+			expected, err := mulPrecompile.Run(append(utils.MarshalPoint(babyjub.B8), encodedScalar...))
+
+			if err != nil {
+				return false
+			}
+
+			actual, err := baseMulPrecompile.Run(encodedScalar)
+
+			return err == nil && bytes.Equal(expected, actual)
+		},
+		utils.ScalarGenerator(),
+	))
+
+	properties.TestingRun(t)
+}
+
+func BenchmarkBaseMul(b *testing.B) {
+	precompile := BabyJubJubBaseMul{}
+	input := new(big.Int).Sub(babyjub.SubOrder, big.NewInt(1)).FillBytes(make([]byte, BabyJubJubBaseMulInputSize))
+
+	for b.Loop() {
+		_, _ = precompile.Run(input)
+	}
+}
+
+func BenchmarkMulB8(b *testing.B) {
+	precompile := mul.BabyJubJubCurveMul{}
+	scalar := new(big.Int).Sub(babyjub.SubOrder, big.NewInt(1)).FillBytes(make([]byte, BabyJubJubBaseMulInputSize))
+	input := append(utils.MarshalPoint(babyjub.B8), scalar...)
+
+	for b.Loop() {
+		_, _ = precompile.Run(input)
+	}
+}
@@ -0,0 +1,53 @@
+package basemul
+
+import (
+	"github.com/iden3/go-iden3-crypto/babyjub"
+	"github.com/privacy-ethereum/privacy-precompiles/babyjubjub/utils"
+	"github.com/privacy-ethereum/privacy-precompiles/common"
+)
+
+// BabyJubJubBasePoint implements the BabyJubJub base point precompile.
+//
+// It returns the generator B8 of the prime-order subgroup, the base point
+// used by BabyJubJubBaseMul and EdDSA, so contracts do not have to
+// hardcode its coordinates.
+type BabyJubJubBasePoint struct{}
+
+// Name returns the human-readable name of the precompile.
+func (c *BabyJubJubBasePoint) Name() string {
+	return "BabyJubJubBasePoint"
+}
+
+// RequiredGas returns the fixed gas cost of executing this precompile.
+//
+// For the BabyJubJub base point, the gas cost is BabyJubJubBasePointGas.
+func (c *BabyJubJubBasePoint) RequiredGas(input []byte) uint64 {
+	return BabyJubJubBasePointGas
+}
+
+// MaxOutputSize returns the byte length of the affine point returned by Run,
+// which is BabyJubJubBasePointOutputSize regardless of the input.
+func (c *BabyJubJubBasePoint) MaxOutputSize(input []byte) int {
+	return BabyJubJubBasePointOutputSize
+}
+
+// Run executes the BabyJubJub base point precompile.
+//
+// The input must be empty. Run returns B8 serialized with
+// utils.MarshalPoint.
+//
+// Returns an error if:
+//   - The input is not empty (ErrorBabyJubJubCurveInvalidInputLength).
+func (c *BabyJubJubBasePoint) Run(input []byte) ([]byte, error) {
+	if len(input) != 0 {
+		return nil, utils.ErrorBabyJubJubCurveInvalidInputLength
+	}
+
+	return utils.MarshalPoint(babyjub.B8), nil
+}
+
+// Ensure BabyJubJubBasePoint implements the common.Precompile interface.
+var _ common.Precompile = (*BabyJubJubBasePoint)(nil)
+
+// Ensure BabyJubJubBasePoint implements the common.OutputSizer interface.
+var _ common.OutputSizer = (*BabyJubJubBasePoint)(nil)
@@ -0,0 +1,82 @@
+package basemul
+
+import (
+	"testing"
+
+	"github.com/iden3/go-iden3-crypto/babyjub"
+	"github.com/privacy-ethereum/privacy-precompiles/babyjubjub/utils"
+	"github.com/privacy-ethereum/privacy-precompiles/common"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBabyJubJubBasePointName(t *testing.T) {
+	precompile := BabyJubJubBasePoint{}
+
+	expected := "BabyJubJubBasePoint"
+	actual := precompile.Name()
+
+	assert.Equal(t, expected, actual)
+}
+
+func TestBabyJubJubBasePointMaxOutputSize(t *testing.T) {
+	precompile := BabyJubJubBasePoint{}
+
+	var sizer common.OutputSizer = &precompile
+
+	assert.Equal(t, utils.BabyJubJubCurveAffinePointSize, sizer.MaxOutputSize(nil))
+}
+
+func TestBasePoint(t *testing.T) {
+	tests := []struct {
+		name          string
+		input         []byte
+		expectedError error
+	}{
+		{
+			name:  "empty input",
+			input: []byte{},
+		},
+		{
+			name:  "nil input",
+			input: nil,
+		},
+		{
+			name:          "one byte",
+			input:         []byte{0},
+			expectedError: utils.ErrorBabyJubJubCurveInvalidInputLength,
+		},
+		{
+			name:          "scalar input",
+			input:         make([]byte, BabyJubJubBaseMulInputSize),
+			expectedError: utils.ErrorBabyJubJubCurveInvalidInputLength,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			precompile := BabyJubJubBasePoint{}
+
+			actual, err := precompile.Run(tt.input)
+			gas := precompile.RequiredGas(tt.input)
+
+			if tt.expectedError != nil {
+				assert.NotNil(t, err)
+				assert.Equal(t, tt.expectedError, err)
+
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Len(t, actual, BabyJubJubBasePointOutputSize)
+			assert.Equal(t, BabyJubJubBasePointGas, gas)
+
+			point, err := utils.UnmarshalPoint(actual)
+
+			assert.Nil(t, err)
+			assert.True(t, point.InCurve())
+			assert.True(t, point.InSubGroup())
+			assert.Equal(t, 0, point.X.Cmp(babyjub.B8.X))
+			assert.Equal(t, 0, point.Y.Cmp(babyjub.B8.Y))
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package basemul
+
+import "github.com/privacy-ethereum/privacy-precompiles/babyjubjub/utils"
+
+// BabyJubJub base point multiplication precompile constants
+const (
+	// BabyJubJubBaseMulInputSize defines the fixed byte length of the input
+	// to the BabyJubJub base point multiplication precompile. The input is a
+	// single scalar encoded as a big-endian field element.
+	BabyJubJubBaseMulInputSize = utils.BabyJubJubCurveFieldByteSize
+
+	// BabyJubJubBaseMulOutputSize defines the fixed byte length of the output
+	// of the BabyJubJub base point multiplication precompile.
+	//
+	// The output is a single affine point serialized as:
+	//   X || Y
+	BabyJubJubBaseMulOutputSize = utils.BabyJubJubCurveAffinePointSize
+
+	// BabyJubJubBaseMulWindowSize defines the number of scalar bits consumed
+	// per lookup in the precomputed B8 table.
+	BabyJubJubBaseMulWindowSize = 4
+
+	// BabyJubJubBaseMulWindows defines the number of windows covering a
+	// BabyJubJubBaseMulInputSize-byte scalar.
+	BabyJubJubBaseMulWindows = 8 * BabyJubJubBaseMulInputSize / BabyJubJubBaseMulWindowSize
+
+	// BabyJubJubBaseMulGas is the gas cost estimate for executing the
+	// BabyJubJub base point multiplication precompile in Ethereum.
+	//
+	// The precomputed table replaces the doublings and about half of the
+	// additions of a variable-base multiplication with one addition per
+	// window, so the cost is a third of mul.BabyJubJubCurveMulGas.
+	BabyJubJubBaseMulGas uint64 = 4800
+
+	// BabyJubJubBasePointOutputSize defines the fixed byte length of the
+	// output of the BabyJubJub base point precompile.
+	//
+	// The output is the affine point B8 serialized as:
+	//   X || Y
+	BabyJubJubBasePointOutputSize = utils.BabyJubJubCurveAffinePointSize
+
+	// BabyJubJubBasePointGas is the gas cost estimate for executing the
+	// BabyJubJub base point precompile in Ethereum. The output is a
+	// constant, so only a minimal fee is charged.
+	BabyJubJubBasePointGas uint64 = 100
+)
@@ -0,0 +1,87 @@
+package compression
+
+import (
+	"math/big"
+
+	"github.com/iden3/go-iden3-crypto/babyjub"
+	"github.com/privacy-ethereum/privacy-precompiles/babyjubjub/utils"
+	"github.com/privacy-ethereum/privacy-precompiles/common"
+)
+
+// BabyJubJubCompress implements the BabyJubJub point compression precompile.
+//
+// It converts an affine point into the 32-byte compressed encoding accepted
+// by BabyJubJubDecompress and the compressed precompiles such as
+// mul.BabyJubJubCurveMulCompressed.
+type BabyJubJubCompress struct{}
+
+// Name returns the human-readable name of the precompile.
+func (c *BabyJubJubCompress) Name() string {
+	return "BabyJubJubCompress"
+}
+
+// RequiredGas returns the fixed gas cost of executing this precompile.
+//
+// For BabyJubJub point compression, the gas cost is BabyJubJubCompressGas.
+func (c *BabyJubJubCompress) RequiredGas(input []byte) uint64 {
+	return BabyJubJubCompressGas
+}
+
+// MaxOutputSize returns the byte length of the compressed point returned by Run,
+// which is BabyJubJubCompressOutputSize regardless of the input.
+func (c *BabyJubJubCompress) MaxOutputSize(input []byte) int {
+	return BabyJubJubCompressOutputSize
+}
+
+// Run executes the BabyJubJub point compression precompile.
+//
+// The input must be exactly BabyJubJubCompressInputSize bytes, which encode
+// a single affine point in the format:
+//
+//	x || y
+//
+// Each coordinate is a big-endian field element padded to BabyJubJubFieldByteSize bytes.
+//
+// Run performs the following steps:
+//  1. Parses the point from input using utils.ReadAffinePoint.
+//  2. Validates that the point lies on the curve and in the subgroup.
+//  3. Reduces both coordinates modulo FieldPrime, so every encoding of the
+//     same point compresses identically.
+//  4. Returns the point serialized with utils.MarshalPointCompressed.
+//
+// Returns an error if:
+//   - The input length is incorrect.
+//   - The point is not on the curve (ErrorBabyJubJubCurvePointNotOnCurve).
+//   - The point is not in the subgroup (ErrorBabyJubJubCurvePointNotInSubgroup).
+func (c *BabyJubJubCompress) Run(input []byte) ([]byte, error) {
+	if len(input) != BabyJubJubCompressInputSize {
+		return nil, utils.ErrorBabyJubJubCurveInvalidInputLength
+	}
+
+	point, err := utils.ReadAffinePoint(input, 0)
+
+	if err != nil {
+		return nil, utils.ErrorBabyJubJubCurveInvalidInputLength
+	}
+
+	if !point.InCurve() {
+		return nil, utils.ErrorBabyJubJubCurvePointNotOnCurve
+	}
+
+	if !point.InSubGroup() {
+		return nil, utils.ErrorBabyJubJubCurvePointNotInSubgroup
+	}
+
+	canonical := &babyjub.Point{
+		X: new(big.Int).Mod(point.X, utils.FieldPrime),
+		Y: new(big.Int).Mod(point.Y, utils.FieldPrime),
+	}
+
+	return utils.MarshalPointCompressed(canonical), nil
+}
+
+// Ensure BabyJubJubCompress implements the common.Precompile interface.
+var _ common.Precompile = (*BabyJubJubCompress)(nil)
+
+// Ensure BabyJubJubCompress implements the common.OutputSizer interface.
+var _ common.OutputSizer = (*BabyJubJubCompress)(nil)
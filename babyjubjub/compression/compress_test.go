@@ -0,0 +1,153 @@
+package compression
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	"github.com/iden3/go-iden3-crypto/babyjub"
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/prop"
+	"github.com/privacy-ethereum/privacy-precompiles/babyjubjub/utils"
+	"github.com/privacy-ethereum/privacy-precompiles/common"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBabyJubJubCompressName(t *testing.T) {
+	precompile := BabyJubJubCompress{}
+
+	expected := "BabyJubJubCompress"
+	actual := precompile.Name()
+
+	assert.Equal(t, expected, actual)
+}
+
+func TestBabyJubJubCompressMaxOutputSize(t *testing.T) {
+	precompile := BabyJubJubCompress{}
+
+	var sizer common.OutputSizer = &precompile
+
+	assert.Equal(t, utils.BabyJubJubCurveCompressedPointSize, sizer.MaxOutputSize(nil))
+}
+
+func TestCompress(t *testing.T) {
+	tests := []struct {
+		name          string
+		input         []byte
+		expected      []byte
+		expectedError error
+	}{
+		{
+			name:     "B8",
+			input:    utils.MarshalPoint(babyjub.B8),
+			expected: utils.MarshalPointCompressed(babyjub.B8),
+		},
+		{
+			name:     "identity",
+			input:    utils.MarshalPoint(babyjub.NewPoint()),
+			expected: utils.MarshalPointCompressed(babyjub.NewPoint()),
+		},
+		{
+			name: "unreduced coordinate",
+			input: utils.MarshalPoint(&babyjub.Point{
+				X: babyjub.B8.X,
+				Y: new(big.Int).Add(babyjub.B8.Y, utils.FieldPrime),
+			}),
+			expected: utils.MarshalPointCompressed(babyjub.B8),
+		},
+		{
+			name: "point is not on curve",
+			input: utils.MarshalPoint(&babyjub.Point{
+				X: big.NewInt(1),
+				Y: big.NewInt(1),
+			}),
+			expectedError: utils.ErrorBabyJubJubCurvePointNotOnCurve,
+		},
+		{
+			name: "point is not in subgroup",
+			input: utils.MarshalPoint(&babyjub.Point{
+				X: big.NewInt(0),
+				Y: new(big.Int).Sub(utils.FieldPrime, big.NewInt(1)), // p - 1 == -1 mod p
+			}),
+			expectedError: utils.ErrorBabyJubJubCurvePointNotInSubgroup,
+		},
+		{
+			name:          "compressed input",
+			input:         utils.MarshalPointCompressed(babyjub.B8),
+			expectedError: utils.ErrorBabyJubJubCurveInvalidInputLength,
+		},
+		{
+			name:          "empty input",
+			input:         []byte{},
+			expectedError: utils.ErrorBabyJubJubCurveInvalidInputLength,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			precompile := BabyJubJubCompress{}
+
+			actual, err := precompile.Run(tt.input)
+			gas := precompile.RequiredGas(tt.input)
+
+			if tt.expectedError != nil {
+				assert.NotNil(t, err)
+				assert.Equal(t, tt.expectedError, err)
+
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Len(t, actual, BabyJubJubCompressOutputSize)
+			assert.Equal(t, BabyJubJubCompressGas, gas)
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func TestRunProperties(t *testing.T) {
+	parameters := gopter.DefaultTestParameters()
+	properties := gopter.NewProperties(parameters)
+
+	properties.Property("Decompress(Compress(P)) == P", prop.ForAll(
+		func(point *babyjub.Point) bool {
+			compressed, err := (&BabyJubJubCompress{}).Run(utils.MarshalPoint(point))
+
+			if err != nil {
+				return false
+			}
+
+			decompressed, err := (&BabyJubJubDecompress{}).Run(compressed)
+
+			if err != nil {
+				return false
+			}
+
+			return bytes.Equal(utils.MarshalPoint(point), decompressed)
+		},
+		utils.BabyJubJubPointGenerator(),
+	))
+
+	properties.Property("Compress(Decompress(C)) == C", prop.ForAll(
+		func(point *babyjub.Point) bool {
+			compressed := utils.MarshalPointCompressed(point)
+
+			decompressed, err := (&BabyJubJubDecompress{}).Run(compressed)
+
+			if err != nil {
+				return false
+			}
+
+			recompressed, err := (&BabyJubJubCompress{}).Run(decompressed)
+
+			if err != nil {
+				return false
+			}
+
+			return bytes.Equal(compressed, recompressed)
+		},
+		utils.BabyJubJubPointGenerator(),
+	))
+
+	properties.TestingRun(t)
+}
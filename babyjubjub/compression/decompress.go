@@ -0,0 +1,70 @@
+package compression
+
+import (
+	"github.com/privacy-ethereum/privacy-precompiles/babyjubjub/utils"
+	"github.com/privacy-ethereum/privacy-precompiles/common"
+)
+
+// BabyJubJubDecompress implements the BabyJubJub point decompression
+// precompile.
+//
+// It is the inverse of BabyJubJubCompress: it recovers the affine point
+// from its 32-byte compressed encoding.
+type BabyJubJubDecompress struct{}
+
+// Name returns the human-readable name of the precompile.
+func (c *BabyJubJubDecompress) Name() string {
+	return "BabyJubJubDecompress"
+}
+
+// RequiredGas returns the fixed gas cost of executing this precompile.
+//
+// For BabyJubJub point decompression, the gas cost is BabyJubJubDecompressGas.
+func (c *BabyJubJubDecompress) RequiredGas(input []byte) uint64 {
+	return BabyJubJubDecompressGas
+}
+
+// MaxOutputSize returns the byte length of the affine point returned by Run,
+// which is BabyJubJubDecompressOutputSize regardless of the input.
+func (c *BabyJubJubDecompress) MaxOutputSize(input []byte) int {
+	return BabyJubJubDecompressOutputSize
+}
+
+// Run executes the BabyJubJub point decompression precompile.
+//
+// The input must be exactly BabyJubJubDecompressInputSize bytes holding a
+// compressed point as produced by utils.MarshalPointCompressed.
+//
+// Run performs the following steps:
+//  1. Decompresses the point using utils.UnmarshalPointCompressed.
+//  2. Validates that the point lies in the correct subgroup.
+//  3. Returns the point serialized with utils.MarshalPoint.
+//
+// Returns an error if:
+//   - The input length is incorrect.
+//   - Y is not a field element for which a curve point exists, or the sign
+//     bit is set while X is zero (ErrorBabyJubJubCurvePointInvalid).
+//   - The point is not in the subgroup (ErrorBabyJubJubCurvePointNotInSubgroup).
+func (c *BabyJubJubDecompress) Run(input []byte) ([]byte, error) {
+	if len(input) != BabyJubJubDecompressInputSize {
+		return nil, utils.ErrorBabyJubJubCurveInvalidInputLength
+	}
+
+	point, err := utils.UnmarshalPointCompressed(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if !point.InSubGroup() {
+		return nil, utils.ErrorBabyJubJubCurvePointNotInSubgroup
+	}
+
+	return utils.MarshalPoint(point), nil
+}
+
+// Ensure BabyJubJubDecompress implements the common.Precompile interface.
+var _ common.Precompile = (*BabyJubJubDecompress)(nil)
+
+// Ensure BabyJubJubDecompress implements the common.OutputSizer interface.
+var _ common.OutputSizer = (*BabyJubJubDecompress)(nil)
@@ -0,0 +1,127 @@
+package compression
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/iden3/go-iden3-crypto/babyjub"
+	"github.com/privacy-ethereum/privacy-precompiles/babyjubjub/utils"
+	"github.com/privacy-ethereum/privacy-precompiles/common"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBabyJubJubDecompressName(t *testing.T) {
+	precompile := BabyJubJubDecompress{}
+
+	expected := "BabyJubJubDecompress"
+	actual := precompile.Name()
+
+	assert.Equal(t, expected, actual)
+}
+
+func TestBabyJubJubDecompressMaxOutputSize(t *testing.T) {
+	precompile := BabyJubJubDecompress{}
+
+	var sizer common.OutputSizer = &precompile
+
+	assert.Equal(t, utils.BabyJubJubCurveAffinePointSize, sizer.MaxOutputSize(nil))
+}
+
+func TestDecompress(t *testing.T) {
+	tests := []struct {
+		name          string
+		input         []byte
+		expected      []byte
+		expectedError error
+	}{
+		{
+			name:     "B8",
+			input:    utils.MarshalPointCompressed(babyjub.B8),
+			expected: utils.MarshalPoint(babyjub.B8),
+		},
+		{
+			name:     "negated B8",
+			input:    utils.MarshalPointCompressed(&babyjub.Point{X: new(big.Int).Sub(utils.FieldPrime, babyjub.B8.X), Y: babyjub.B8.Y}),
+			expected: utils.MarshalPoint(&babyjub.Point{X: new(big.Int).Sub(utils.FieldPrime, babyjub.B8.X), Y: babyjub.B8.Y}),
+		},
+		{
+			name:     "identity",
+			input:    utils.MarshalPointCompressed(babyjub.NewPoint()),
+			expected: utils.MarshalPoint(babyjub.NewPoint()),
+		},
+		{
+			name: "identity with sign bit set",
+			input: func() []byte {
+				input := utils.MarshalPointCompressed(babyjub.NewPoint())
+				input[BabyJubJubDecompressInputSize-1] |= 0x80
+
+				return input
+			}(),
+			expectedError: utils.ErrorBabyJubJubCurvePointInvalid,
+		},
+		{
+			name: "Y without valid X",
+			input: func() []byte {
+				input := make([]byte, BabyJubJubDecompressInputSize)
+				input[0] = 2
+
+				return input
+			}(),
+			expectedError: utils.ErrorBabyJubJubCurvePointInvalid,
+		},
+		{
+			name: "Y outside of the field",
+			input: func() []byte {
+				input := make([]byte, BabyJubJubDecompressInputSize)
+
+				for index := range input {
+					input[index] = 0xff
+				}
+
+				input[BabyJubJubDecompressInputSize-1] = 0x7f
+
+				return input
+			}(),
+			expectedError: utils.ErrorBabyJubJubCurvePointInvalid,
+		},
+		{
+			name: "point is not in subgroup",
+			input: utils.MarshalPointCompressed(&babyjub.Point{
+				X: big.NewInt(0),
+				Y: new(big.Int).Sub(utils.FieldPrime, big.NewInt(1)), // p - 1 == -1 mod p
+			}),
+			expectedError: utils.ErrorBabyJubJubCurvePointNotInSubgroup,
+		},
+		{
+			name:          "uncompressed input",
+			input:         utils.MarshalPoint(babyjub.B8),
+			expectedError: utils.ErrorBabyJubJubCurveInvalidInputLength,
+		},
+		{
+			name:          "empty input",
+			input:         []byte{},
+			expectedError: utils.ErrorBabyJubJubCurveInvalidInputLength,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			precompile := BabyJubJubDecompress{}
+
+			actual, err := precompile.Run(tt.input)
+			gas := precompile.RequiredGas(tt.input)
+
+			if tt.expectedError != nil {
+				assert.NotNil(t, err)
+				assert.Equal(t, tt.expectedError, err)
+
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Len(t, actual, BabyJubJubDecompressOutputSize)
+			assert.Equal(t, BabyJubJubDecompressGas, gas)
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
@@ -0,0 +1,40 @@
+package compression
+
+import "github.com/privacy-ethereum/privacy-precompiles/babyjubjub/utils"
+
+// BabyJubJub point compression precompile constants
+const (
+	// BabyJubJubCompressInputSize defines the fixed byte length of the input
+	// to the BabyJubJub point compression precompile. The input consists of
+	// a single affine point serialized as X || Y.
+	BabyJubJubCompressInputSize = utils.BabyJubJubCurveAffinePointSize
+
+	// BabyJubJubCompressOutputSize defines the fixed byte length of the
+	// output of the BabyJubJub point compression precompile. The output is
+	// a single compressed point (see utils.MarshalPointCompressed).
+	BabyJubJubCompressOutputSize = utils.BabyJubJubCurveCompressedPointSize
+
+	// BabyJubJubCompressGas is the gas cost estimate for executing the
+	// BabyJubJub point compression precompile in Ethereum.
+	//
+	// The cost is dominated by the subgroup check, so it matches the point
+	// validation precompile.
+	BabyJubJubCompressGas uint64 = 10000
+
+	// BabyJubJubDecompressInputSize defines the fixed byte length of the
+	// input to the BabyJubJub point decompression precompile. The input is
+	// a single compressed point (see utils.MarshalPointCompressed).
+	BabyJubJubDecompressInputSize = utils.BabyJubJubCurveCompressedPointSize
+
+	// BabyJubJubDecompressOutputSize defines the fixed byte length of the
+	// output of the BabyJubJub point decompression precompile. The output is
+	// a single affine point serialized as X || Y.
+	BabyJubJubDecompressOutputSize = utils.BabyJubJubCurveAffinePointSize
+
+	// BabyJubJubDecompressGas is the gas cost estimate for executing the
+	// BabyJubJub point decompression precompile in Ethereum.
+	//
+	// It extends BabyJubJubCompressGas with the modular square root needed
+	// to recover the X coordinate.
+	BabyJubJubDecompressGas uint64 = BabyJubJubCompressGas + 1600
+)
@@ -0,0 +1,101 @@
+package ecdh
+
+import (
+	"github.com/iden3/go-iden3-crypto/babyjub"
+	"github.com/privacy-ethereum/privacy-precompiles/babyjubjub/utils"
+	"github.com/privacy-ethereum/privacy-precompiles/common"
+	commonUtils "github.com/privacy-ethereum/privacy-precompiles/utils"
+)
+
+// BabyJubJubECDH implements the BabyJubJub elliptic curve Diffie-Hellman
+// precompile.
+//
+// Given a private scalar a and a peer public key [b]B8, it returns the
+// shared secret [a*b]B8, which both parties derive independently. It is a
+// scalar multiplication with a compressed output, intended for stealth
+// addresses and note encryption.
+type BabyJubJubECDH struct{}
+
+// Name returns the human-readable name of the precompile.
+func (c *BabyJubJubECDH) Name() string {
+	return "BabyJubJubECDH"
+}
+
+// RequiredGas returns the fixed gas cost of executing this precompile.
+//
+// For BabyJubJub ECDH, the gas cost is BabyJubJubECDHGas.
+func (c *BabyJubJubECDH) RequiredGas(input []byte) uint64 {
+	return BabyJubJubECDHGas
+}
+
+// MaxOutputSize returns the byte length of the shared secret returned by Run,
+// which is BabyJubJubECDHOutputSize regardless of the input.
+func (c *BabyJubJubECDH) MaxOutputSize(input []byte) int {
+	return BabyJubJubECDHOutputSize
+}
+
+// Run executes the BabyJubJub ECDH precompile.
+//
+// The input must be exactly BabyJubJubECDHInputSize bytes, which encode:
+//
+//	scalar || x || y
+//
+// Where:
+//   - scalar is the private key, a big-endian integer padded to
+//     BabyJubJubCurveFieldByteSize bytes.
+//   - (x, y) is the peer public key, an affine point on the BabyJubJub curve.
+//
+// Run performs the following steps:
+//  1. Parses the scalar using utils.ReadField and reduces it modulo the
+//     BabyJubJub subgroup order using utils.ReduceScalar.
+//  2. Parses the public key using utils.ReadAffinePoint.
+//  3. Validates that the public key lies on the curve and in the subgroup.
+//  4. Computes the shared point [scalar]P.
+//  5. Returns the shared point serialized with utils.MarshalPointCompressed.
+//
+// Returns an error if:
+//   - The input length is incorrect.
+//   - The point is not on the curve (ErrorBabyJubJubCurvePointNotOnCurve).
+//   - The point is not in the subgroup (ErrorBabyJubJubCurvePointNotInSubgroup).
+//   - The shared point is the identity (ErrorBabyJubJubECDHSharedSecretIsIdentity).
+func (c *BabyJubJubECDH) Run(input []byte) ([]byte, error) {
+	if len(input) != BabyJubJubECDHInputSize {
+		return nil, utils.ErrorBabyJubJubCurveInvalidInputLength
+	}
+
+	scalar, _ := commonUtils.ReadField(input, 0, utils.BabyJubJubCurveFieldByteSize)
+
+	if scalar == nil {
+		return nil, utils.ErrorBabyJubJubCurveInvalidInputLength
+	}
+
+	scalar = utils.ReduceScalar(scalar)
+
+	point, err := utils.ReadAffinePoint(input[utils.BabyJubJubCurveFieldByteSize:], 0)
+
+	if err != nil {
+		return nil, utils.ErrorBabyJubJubCurveInvalidInputLength
+	}
+
+	if !point.InCurve() {
+		return nil, utils.ErrorBabyJubJubCurvePointNotOnCurve
+	}
+
+	if !point.InSubGroup() {
+		return nil, utils.ErrorBabyJubJubCurvePointNotInSubgroup
+	}
+
+	shared := babyjub.NewPoint().Mul(scalar, point)
+
+	if utils.IsIdentity(shared) {
+		return nil, ErrorBabyJubJubECDHSharedSecretIsIdentity
+	}
+
+	return utils.MarshalPointCompressed(shared), nil
+}
+
+// Ensure BabyJubJubECDH implements the common.Precompile interface.
+var _ common.Precompile = (*BabyJubJubECDH)(nil)
+
+// Ensure BabyJubJubECDH implements the common.OutputSizer interface.
+var _ common.OutputSizer = (*BabyJubJubECDH)(nil)
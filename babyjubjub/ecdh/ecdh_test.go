@@ -0,0 +1,165 @@
+package ecdh
+
+import (
+	"bytes"
+	"math/big"
+	"slices"
+	"testing"
+
+	"github.com/iden3/go-iden3-crypto/babyjub"
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/prop"
+	"github.com/privacy-ethereum/privacy-precompiles/babyjubjub/utils"
+	"github.com/privacy-ethereum/privacy-precompiles/common"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBabyJubJubECDHName(t *testing.T) {
+	precompile := BabyJubJubECDH{}
+
+	expected := "BabyJubJubECDH"
+	actual := precompile.Name()
+
+	assert.Equal(t, expected, actual)
+}
+
+func TestBabyJubJubECDHMaxOutputSize(t *testing.T) {
+	precompile := BabyJubJubECDH{}
+
+	var sizer common.OutputSizer = &precompile
+
+	assert.Equal(t, utils.BabyJubJubCurveCompressedPointSize, sizer.MaxOutputSize(nil))
+}
+
+func TestECDH(t *testing.T) {
+	scalar := func(value *big.Int) []byte {
+		return value.FillBytes(make([]byte, utils.BabyJubJubCurveFieldByteSize))
+	}
+
+	tests := []struct {
+		name          string
+		input         []byte
+		expected      *babyjub.Point
+		expectedError error
+	}{
+		{
+			name:     "scalar one returns the public key",
+			input:    slices.Concat(scalar(big.NewInt(1)), utils.MarshalPoint(babyjub.B8)),
+			expected: babyjub.B8,
+		},
+		{
+			name:     "non-zero scalar",
+			input:    slices.Concat(scalar(big.NewInt(1234)), utils.MarshalPoint(babyjub.B8)),
+			expected: babyjub.NewPoint().Mul(big.NewInt(1234), babyjub.B8),
+		},
+		{
+			name:     "scalar is reduced modulo the subgroup order",
+			input:    slices.Concat(scalar(new(big.Int).Add(utils.SubOrder, big.NewInt(1234))), utils.MarshalPoint(babyjub.B8)),
+			expected: babyjub.NewPoint().Mul(big.NewInt(1234), babyjub.B8),
+		},
+		{
+			name:          "zero scalar",
+			input:         slices.Concat(scalar(big.NewInt(0)), utils.MarshalPoint(babyjub.B8)),
+			expectedError: ErrorBabyJubJubECDHSharedSecretIsIdentity,
+		},
+		{
+			name:          "scalar equal to the subgroup order",
+			input:         slices.Concat(scalar(utils.SubOrder), utils.MarshalPoint(babyjub.B8)),
+			expectedError: ErrorBabyJubJubECDHSharedSecretIsIdentity,
+		},
+		{
+			name:          "identity public key",
+			input:         slices.Concat(scalar(big.NewInt(1234)), utils.MarshalPoint(babyjub.NewPoint())),
+			expectedError: ErrorBabyJubJubECDHSharedSecretIsIdentity,
+		},
+		{
+			name: "point is not on curve",
+			input: slices.Concat(scalar(big.NewInt(1234)), utils.MarshalPoint(&babyjub.Point{
+				X: big.NewInt(1),
+				Y: big.NewInt(1),
+			})),
+			expectedError: utils.ErrorBabyJubJubCurvePointNotOnCurve,
+		},
+		{
+			name: "point is not in subgroup",
+			input: slices.Concat(scalar(big.NewInt(1234)), utils.MarshalPoint(&babyjub.Point{
+				X: big.NewInt(0),
+				Y: new(big.Int).Sub(utils.FieldPrime, big.NewInt(1)), // p - 1 == -1 mod p
+			})),
+			expectedError: utils.ErrorBabyJubJubCurvePointNotInSubgroup,
+		},
+		{
+			name:          "truncated input",
+			input:         slices.Concat(scalar(big.NewInt(1234)), utils.MarshalPoint(babyjub.B8))[:BabyJubJubECDHInputSize-1],
+			expectedError: utils.ErrorBabyJubJubCurveInvalidInputLength,
+		},
+		{
+			name:          "empty input",
+			input:         []byte{},
+			expectedError: utils.ErrorBabyJubJubCurveInvalidInputLength,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			precompile := BabyJubJubECDH{}
+
+			actual, err := precompile.Run(tt.input)
+			gas := precompile.RequiredGas(tt.input)
+
+			if tt.expectedError != nil {
+				assert.NotNil(t, err)
+				assert.Equal(t, tt.expectedError, err)
+
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Len(t, actual, BabyJubJubECDHOutputSize)
+			assert.Equal(t, BabyJubJubECDHGas, gas)
+			assert.Equal(t, utils.MarshalPointCompressed(tt.expected), actual)
+		})
+	}
+}
+
+func TestRunProperties(t *testing.T) {
+	parameters := gopter.DefaultTestParameters()
+	properties := gopter.NewProperties(parameters)
+
+	nonZeroScalar := utils.ScalarGenerator().SuchThat(func(value *big.Int) bool {
+		return utils.ReduceScalar(value).Sign() != 0
+	})
+
+	properties.Property("ECDH(a, [b]B8) == ECDH(b, [a]B8)", prop.ForAll(
+		func(a, b *big.Int) bool {
+			precompile := BabyJubJubECDH{}
+
+			alicePublicKey := babyjub.NewPoint().Mul(utils.ReduceScalar(a), babyjub.B8)
+			bobPublicKey := babyjub.NewPoint().Mul(utils.ReduceScalar(b), babyjub.B8)
+
+			aliceSecret, err := precompile.Run(slices.Concat(
+				a.FillBytes(make([]byte, utils.BabyJubJubCurveFieldByteSize)),
+				utils.MarshalPoint(bobPublicKey),
+			))
+
+			if err != nil {
+				return false
+			}
+
+			bobSecret, err := precompile.Run(slices.Concat(
+				b.FillBytes(make([]byte, utils.BabyJubJubCurveFieldByteSize)),
+				utils.MarshalPoint(alicePublicKey),
+			))
+
+			if err != nil {
+				return false
+			}
+
+			return bytes.Equal(aliceSecret, bobSecret)
+		},
+		nonZeroScalar,
+		nonZeroScalar,
+	))
+
+	properties.TestingRun(t)
+}
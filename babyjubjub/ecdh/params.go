@@ -0,0 +1,40 @@
+package ecdh
+
+import (
+	"errors"
+
+	"github.com/privacy-ethereum/privacy-precompiles/babyjubjub/mul"
+	"github.com/privacy-ethereum/privacy-precompiles/babyjubjub/utils"
+)
+
+// BabyJubJub ECDH precompile constants
+const (
+	// BabyJubJubECDHInputSize defines the fixed byte length of the input to
+	// the BabyJubJub ECDH precompile.
+	//
+	// The input consists of:
+	//   - One private scalar
+	//   - One public key affine point
+	//
+	// Total layout:
+	//   scalar || x || y
+	BabyJubJubECDHInputSize = utils.BabyJubJubCurveFieldByteSize + utils.BabyJubJubCurveAffinePointSize
+
+	// BabyJubJubECDHOutputSize defines the fixed byte length of the output
+	// of the BabyJubJub ECDH precompile: the shared point in compressed form
+	// (see utils.MarshalPointCompressed).
+	BabyJubJubECDHOutputSize = utils.BabyJubJubCurveCompressedPointSize
+
+	// BabyJubJubECDHGas is the gas cost estimate for executing the BabyJubJub
+	// ECDH precompile in Ethereum. It is dominated by one variable-base
+	// scalar multiplication.
+	BabyJubJubECDHGas = mul.BabyJubJubCurveMulGas
+)
+
+var (
+	// ErrorBabyJubJubECDHSharedSecretIsIdentity is returned when the shared
+	// point is the identity, i.e. the public key is the identity or the
+	// scalar is a multiple of the subgroup order. Such a secret is known to
+	// anyone and must not be used.
+	ErrorBabyJubJubECDHSharedSecretIsIdentity = errors.New("shared secret is identity")
+)
@@ -0,0 +1,171 @@
+package eddsa
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"math/big"
+
+	"github.com/iden3/go-iden3-crypto/babyjub"
+	iden3Poseidon "github.com/iden3/go-iden3-crypto/poseidon"
+	"github.com/privacy-ethereum/privacy-precompiles/babyjubjub/utils"
+	"github.com/privacy-ethereum/privacy-precompiles/common"
+)
+
+// BabyJubJubEdDSABatchVerify implements a precompile verifying a batch of
+// BabyJubJub EdDSA signatures in a single call.
+//
+// It satisfies the common.Precompile interface and can be used in a generic
+// precompile execution framework, e.g. by rollups settling many signatures
+// at once. Instead of checking every signature equation on its own, Run
+// checks a single random linear combination of them, evaluated with one
+// fixed-base multiplication and one multi-scalar multiplication for the
+// whole batch.
+type BabyJubJubEdDSABatchVerify struct{}
+
+// Name returns the human-readable name of the precompile.
+func (c *BabyJubJubEdDSABatchVerify) Name() string {
+	return "BabyJubJubEdDSABatchVerify"
+}
+
+// RequiredGas returns the gas cost of executing this precompile.
+//
+// Gas is calculated as:
+//
+//	BabyJubJubEdDSABatchVerifyBaseGas + (number_of_signatures * BabyJubJubEdDSABatchVerifyPerSignatureGas)
+//
+// Where the number of signatures is the number of complete records in input.
+func (c *BabyJubJubEdDSABatchVerify) RequiredGas(input []byte) uint64 {
+	return BabyJubJubEdDSABatchVerifyBaseGas +
+		uint64(len(input)/BabyJubJubCurveEdDSAVerifyInputSize)*BabyJubJubEdDSABatchVerifyPerSignatureGas
+}
+
+// Run executes the batch EdDSA verification precompile.
+//
+// The input must consist of N signature records encoded as:
+//
+//	record_0 || record_1 || ... || record_{N-1}
+//
+// Where:
+//   - Each record is Ax || Ay || R8x || R8y || S || M, exactly as accepted
+//     by BabyJubJubCurveEdDSAVerify.
+//   - 1 <= N <= BabyJubJubEdDSABatchVerifyMaxSignatures.
+//
+// Run performs the following steps:
+//
+//  1. Parses and validates every record as BabyJubJubCurveEdDSAVerify does.
+//
+//  2. Derives a BabyJubJubEdDSABatchVerifyCoefficientSize-byte coefficient
+//     z_i for every record from the SHA-256 hash of the input and the record
+//     index.
+//
+//  3. Computes h_i = Poseidon(R8x_i, R8y_i, Ax_i, Ay_i, M_i) for every
+//     record.
+//
+//  4. Checks the combined equation
+//
+//     (sum z_i*S_i)*B8 == sum z_i*R8_i + sum (8*z_i*h_i)*A_i
+//
+//     with scalars reduced modulo the subgroup order.
+//
+//  5. Returns []byte{1} if it holds, []byte{0} otherwise.
+//
+// Every point is checked to be in the prime-order subgroup, so the combined
+// equation holds for a batch with an invalid signature only if the
+// coefficients, which the signers cannot choose, cancel it out: a
+// probability of about 2^-128.
+//
+// Returns an error if:
+//   - The input length is zero or not a multiple of BabyJubJubCurveEdDSAVerifyInputSize.
+//   - The number of records exceeds BabyJubJubEdDSABatchVerifyMaxSignatures.
+//   - Any record is rejected by BabyJubJubCurveEdDSAVerify.
+func (c *BabyJubJubEdDSABatchVerify) Run(input []byte) ([]byte, error) {
+	if len(input) == 0 || len(input)%BabyJubJubCurveEdDSAVerifyInputSize != 0 {
+		return nil, ErrorBabyJubJubCurveEdDSAVerifyInvalidInputLength
+	}
+
+	numberOfSignatures := len(input) / BabyJubJubCurveEdDSAVerifyInputSize
+
+	if numberOfSignatures > BabyJubJubEdDSABatchVerifyMaxSignatures {
+		return nil, ErrorBabyJubJubCurveEdDSAVerifyInvalidInputLength
+	}
+
+	seed := sha256.Sum256(input)
+	sumS := new(big.Int)
+	scalars := make([]*big.Int, 0, 2*numberOfSignatures)
+	points := make([]*babyjub.Point, 0, 2*numberOfSignatures)
+
+	for index := range numberOfSignatures {
+		start := index * BabyJubJubCurveEdDSAVerifyInputSize
+		publicKey, signature, message, err := parseSignatureRecord(input[start : start+BabyJubJubCurveEdDSAVerifyInputSize])
+
+		if err != nil {
+			return nil, err
+		}
+
+		hm, err := iden3Poseidon.Hash([]*big.Int{signature.R8.X, signature.R8.Y, publicKey.X, publicKey.Y, message})
+
+		if err != nil {
+			return []byte{0}, nil
+		}
+
+		z := batchCoefficient(seed, index)
+
+		sumS.Add(sumS, new(big.Int).Mul(z, signature.S))
+
+		// 8*z*h is reduced, which is sound since A is in the subgroup.
+		zh := new(big.Int).Mul(z, hm)
+		zh.Lsh(zh, 3).Mod(zh, utils.SubOrder)
+
+		scalars = append(scalars, z, zh)
+		points = append(points, signature.R8, publicKey.Point())
+	}
+
+	left := babyjub.NewPoint().Mul(sumS.Mod(sumS, utils.SubOrder), babyjub.B8)
+	right := multiScalarMul(scalars, points)
+
+	if left.X.Cmp(right.X) == 0 && left.Y.Cmp(right.Y) == 0 {
+		return []byte{1}, nil
+	}
+
+	return []byte{0}, nil
+}
+
+// multiScalarMul returns the sum of scalars[i]*points[i]. The
+// multiplications share their doublings (Straus' method), so the whole sum
+// costs about as many doublings as a single multiplication.
+func multiScalarMul(scalars []*big.Int, points []*babyjub.Point) *babyjub.Point {
+	projective := make([]*babyjub.PointProjective, len(points))
+	bits := 0
+
+	for index, point := range points {
+		projective[index] = point.Projective()
+		bits = max(bits, scalars[index].BitLen())
+	}
+
+	accumulator := babyjub.NewPoint().Projective()
+
+	for bit := bits - 1; bit >= 0; bit-- {
+		accumulator.Add(accumulator, accumulator)
+
+		for index, scalar := range scalars {
+			if scalar.Bit(bit) == 1 {
+				accumulator.Add(accumulator, projective[index])
+			}
+		}
+	}
+
+	return accumulator.Affine()
+}
+
+// batchCoefficient returns the coefficient z_index of the combined equation
+// checked by Run: the first BabyJubJubEdDSABatchVerifyCoefficientSize bytes
+// of SHA-256(seed || index), where seed is the SHA-256 hash of the input.
+func batchCoefficient(seed [sha256.Size]byte, index int) *big.Int {
+	data := binary.BigEndian.AppendUint32(seed[:], uint32(index))
+	digest := sha256.Sum256(data)
+
+	return new(big.Int).SetBytes(digest[:BabyJubJubEdDSABatchVerifyCoefficientSize])
+}
+
+// Ensure BabyJubJubEdDSABatchVerify implements the common.Precompile interface.
+var _ common.Precompile = (*BabyJubJubEdDSABatchVerify)(nil)
@@ -0,0 +1,232 @@
+package eddsa
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	"github.com/iden3/go-iden3-crypto/babyjub"
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/gen"
+	"github.com/leanovate/gopter/prop"
+	"github.com/privacy-ethereum/privacy-precompiles/babyjubjub/utils"
+	"github.com/privacy-ethereum/privacy-precompiles/internal/commontest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBabyJubJubEdDSABatchVerifyName(t *testing.T) {
+	precompile := BabyJubJubEdDSABatchVerify{}
+
+	expected := "BabyJubJubEdDSABatchVerify"
+	actual := precompile.Name()
+
+	assert.Equal(t, expected, actual)
+}
+
+func TestEdDSABatchVerify(t *testing.T) {
+	valid := prepareInput()
+	invalid := func() []byte {
+		input := prepareInput()
+		input[len(input)-1] ^= 0x01
+
+		return input
+	}()
+
+	tests := []struct {
+		name          string
+		input         []byte
+		expected      []byte
+		expectedGas   uint64
+		expectedError error
+	}{
+		{
+			name:        "single valid signature",
+			input:       valid,
+			expected:    []byte{1},
+			expectedGas: BabyJubJubEdDSABatchVerifyBaseGas + BabyJubJubEdDSABatchVerifyPerSignatureGas,
+		},
+		{
+			name:        "all signatures valid",
+			input:       bytes.Repeat(valid, 3),
+			expected:    []byte{1},
+			expectedGas: BabyJubJubEdDSABatchVerifyBaseGas + 3*BabyJubJubEdDSABatchVerifyPerSignatureGas,
+		},
+		{
+			name:        "last signature invalid",
+			input:       append(bytes.Repeat(valid, 2), invalid...),
+			expected:    []byte{0},
+			expectedGas: BabyJubJubEdDSABatchVerifyBaseGas + 3*BabyJubJubEdDSABatchVerifyPerSignatureGas,
+		},
+		{
+			name:        "first signature invalid",
+			input:       append(invalid, valid...),
+			expected:    []byte{0},
+			expectedGas: BabyJubJubEdDSABatchVerifyBaseGas + 2*BabyJubJubEdDSABatchVerifyPerSignatureGas,
+		},
+		{
+			name:     "maximum batch size",
+			input:    bytes.Repeat(valid, BabyJubJubEdDSABatchVerifyMaxSignatures),
+			expected: []byte{1},
+			expectedGas: BabyJubJubEdDSABatchVerifyBaseGas +
+				BabyJubJubEdDSABatchVerifyMaxSignatures*BabyJubJubEdDSABatchVerifyPerSignatureGas,
+		},
+		{
+			name: "invalid S in second record",
+			input: func() []byte {
+				record := prepareInput()
+				start := utils.BabyJubJubCurveAffinePointSize + 2*utils.BabyJubJubCurveFieldByteSize
+
+				copy(record[start:start+utils.BabyJubJubCurveFieldByteSize], babyjub.SubOrder.Bytes())
+
+				return append(append([]byte{}, valid...), record...)
+			}(),
+			expectedError: ErrorBabyJubJubCurveEdDSAVerifyInvalidS,
+		},
+		{
+			name: "invalid signature followed by a malformed record",
+			input: func() []byte {
+				record := prepareInput()
+				start := utils.BabyJubJubCurveAffinePointSize + 2*utils.BabyJubJubCurveFieldByteSize
+
+				copy(record[start:start+utils.BabyJubJubCurveFieldByteSize], babyjub.SubOrder.Bytes())
+
+				return append(append([]byte{}, invalid...), record...)
+			}(),
+			expectedError: ErrorBabyJubJubCurveEdDSAVerifyInvalidS,
+		},
+		{
+			name:        "invalid signatures cancelling out in a plain sum",
+			input:       compensatingSignatures(),
+			expected:    []byte{0},
+			expectedGas: BabyJubJubEdDSABatchVerifyBaseGas + 2*BabyJubJubEdDSABatchVerifyPerSignatureGas,
+		},
+		{
+			name:          "batch size exceeded",
+			input:         bytes.Repeat(valid, BabyJubJubEdDSABatchVerifyMaxSignatures+1),
+			expectedError: ErrorBabyJubJubCurveEdDSAVerifyInvalidInputLength,
+		},
+		{
+			name:          "input not a multiple of the record size",
+			input:         append(bytes.Repeat(valid, 2), 0x00),
+			expectedError: ErrorBabyJubJubCurveEdDSAVerifyInvalidInputLength,
+		},
+		{
+			name:          "empty input",
+			input:         []byte{},
+			expectedError: ErrorBabyJubJubCurveEdDSAVerifyInvalidInputLength,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			precompile := BabyJubJubEdDSABatchVerify{}
+
+			actual, err := precompile.Run(tt.input)
+			gas := precompile.RequiredGas(tt.input)
+
+			if tt.expectedError != nil {
+				assert.NotNil(t, err)
+				assert.Equal(t, tt.expectedError, err)
+
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, tt.expectedGas, gas)
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func TestBatchRunProperties(t *testing.T) {
+	parameters := gopter.DefaultTestParameters()
+	parameters.MinSuccessfulTests = 20
+	properties := gopter.NewProperties(parameters)
+
+	properties.Property("Run accepts a batch only if every signature is valid", prop.ForAll(
+		func(privateKeys []babyjub.PrivateKey, messages []*big.Int, tampered []bool) bool {
+			precompile := BabyJubJubEdDSABatchVerify{}
+			input := make([]byte, 0, len(privateKeys)*BabyJubJubCurveEdDSAVerifyInputSize)
+			allValid := true
+
+			for index, privateKey := range privateKeys {
+				signature := privateKey.SignPoseidon(messages[index])
+				message := messages[index]
+
+				if tampered[index] {
+					message = new(big.Int).Add(message, big.NewInt(1))
+					allValid = false
+				}
+
+				input = append(input, signatureRecord(privateKey.Public(), signature, message)...)
+			}
+
+			result, err := precompile.Run(input)
+
+			if err != nil {
+				return false
+			}
+
+			if allValid {
+				return bytes.Equal(result, []byte{1})
+			}
+
+			return bytes.Equal(result, []byte{0})
+		},
+		gen.SliceOfN(4, utils.PrivateKeyGenerator()),
+		gen.SliceOfN(4, utils.ScalarGenerator()),
+		gen.SliceOfN(4, gen.Weighted([]gen.WeightedGen{
+			{Weight: 3, Gen: gen.Const(false)},
+			{Weight: 1, Gen: gen.Const(true)},
+		})),
+	))
+
+	properties.TestingRun(t)
+}
+
+// compensatingSignatures returns two records of the same key whose S values
+// are shifted by opposite amounts. Both signatures are invalid, but the sum
+// of their verification equations holds.
+func compensatingSignatures() []byte {
+	var privateKey babyjub.PrivateKey
+
+	privateKey[0] = 1
+
+	first := privateKey.SignPoseidon(big.NewInt(1))
+	second := privateKey.SignPoseidon(big.NewInt(2))
+
+	first.S = new(big.Int).Add(first.S, big.NewInt(1))
+	first.S.Mod(first.S, utils.SubOrder)
+	second.S = new(big.Int).Sub(second.S, big.NewInt(1))
+	second.S.Mod(second.S, utils.SubOrder)
+
+	input := signatureRecord(privateKey.Public(), first, big.NewInt(1))
+
+	return append(input, signatureRecord(privateKey.Public(), second, big.NewInt(2))...)
+}
+
+// signatureRecord encodes a single batch record with every element padded to
+// utils.BabyJubJubCurveFieldByteSize bytes.
+func signatureRecord(publicKey *babyjub.PublicKey, signature *babyjub.Signature, message *big.Int) []byte {
+	record := utils.MarshalPoint(publicKey.Point())
+	record = append(record, utils.MarshalPoint(signature.R8)...)
+	record = append(record, signature.S.FillBytes(make([]byte, utils.BabyJubJubCurveFieldByteSize))...)
+
+	return append(record, message.FillBytes(make([]byte, utils.BabyJubJubCurveFieldByteSize))...)
+}
+
+func BenchmarkEdDSABatchVerify(b *testing.B) {
+	input := make([]byte, 0, BabyJubJubEdDSABatchVerifyMaxSignatures*BabyJubJubCurveEdDSAVerifyInputSize)
+
+	for range BabyJubJubEdDSABatchVerifyMaxSignatures {
+		key, _ := utils.PrivateKeyGenerator().Sample()
+		sample, _ := utils.ScalarGenerator().Sample()
+
+		privateKey := key.(babyjub.PrivateKey)
+		message := sample.(*big.Int)
+
+		input = append(input, signatureRecord(privateKey.Public(), privateKey.SignPoseidon(message), message)...)
+	}
+
+	commontest.BenchmarkGasRatio(b, &BabyJubJubEdDSABatchVerify{}, input)
+}
@@ -0,0 +1,80 @@
+package eddsa
+
+import (
+	"github.com/privacy-ethereum/privacy-precompiles/common"
+)
+
+// BabyJubJubEdDSABatchVerifyBitmap implements a precompile verifying a batch
+// of BabyJubJub EdDSA signatures and reporting the result of each signature.
+//
+// It satisfies the common.Precompile interface and can be used in a generic
+// precompile execution framework. Unlike BabyJubJubEdDSABatchVerify, the
+// caller learns which signatures failed, e.g. to slash only the offending
+// signers.
+type BabyJubJubEdDSABatchVerifyBitmap struct{}
+
+// Name returns the human-readable name of the precompile.
+func (c *BabyJubJubEdDSABatchVerifyBitmap) Name() string {
+	return "BabyJubJubEdDSABatchVerifyBitmap"
+}
+
+// RequiredGas returns the gas cost of executing this precompile.
+//
+// Gas is calculated as:
+//
+//	BabyJubJubEdDSABatchVerifyBaseGas + (number_of_signatures * BabyJubJubEdDSABatchVerifyPerSignatureGas)
+//
+// Where the number of signatures is the number of complete records in input.
+func (c *BabyJubJubEdDSABatchVerifyBitmap) RequiredGas(input []byte) uint64 {
+	return (&BabyJubJubEdDSABatchVerify{}).RequiredGas(input)
+}
+
+// Run executes the bitmap batch EdDSA verification precompile.
+//
+// The input layout is identical to BabyJubJubEdDSABatchVerify:
+//
+//	record_0 || record_1 || ... || record_{N-1}
+//
+// Where each record is Ax || Ay || R8x || R8y || S || M and
+// 1 <= N <= BabyJubJubEdDSABatchVerifyMaxSignatures.
+//
+// The output is a bitmap of ceil(N/8) bytes where the bit for signature i is
+// set if it verified. Bits are packed most significant first: signature i
+// maps to bit (7 - i%8) of byte i/8, and unused trailing bits are zero. For
+// example, with N = 3 and only signature 1 valid the output is []byte{0x40}.
+//
+// Every record is verified with BabyJubJubCurveEdDSAVerify. A record it
+// rejects with an error (e.g. a public key outside the subgroup) is reported
+// as not verified instead of failing the whole call.
+//
+// Returns an error if:
+//   - The input length is zero or not a multiple of BabyJubJubCurveEdDSAVerifyInputSize.
+//   - The number of records exceeds BabyJubJubEdDSABatchVerifyMaxSignatures.
+func (c *BabyJubJubEdDSABatchVerifyBitmap) Run(input []byte) ([]byte, error) {
+	if len(input) == 0 || len(input)%BabyJubJubCurveEdDSAVerifyInputSize != 0 {
+		return nil, ErrorBabyJubJubCurveEdDSAVerifyInvalidInputLength
+	}
+
+	numberOfSignatures := len(input) / BabyJubJubCurveEdDSAVerifyInputSize
+
+	if numberOfSignatures > BabyJubJubEdDSABatchVerifyMaxSignatures {
+		return nil, ErrorBabyJubJubCurveEdDSAVerifyInvalidInputLength
+	}
+
+	verifier := BabyJubJubCurveEdDSAVerify{}
+	bitmap := make([]byte, (numberOfSignatures+7)/8)
+
+	for index := range numberOfSignatures {
+		start := index * BabyJubJubCurveEdDSAVerifyInputSize
+		result, err := verifier.Run(input[start : start+BabyJubJubCurveEdDSAVerifyInputSize])
+
+		if err == nil && result[0] == 1 {
+			bitmap[index/8] |= 0x80 >> (index % 8)
+		}
+	}
+
+	return bitmap, nil
+}
+
+// Ensure BabyJubJubEdDSABatchVerifyBitmap implements the common.Precompile interface.
+var _ common.Precompile = (*BabyJubJubEdDSABatchVerifyBitmap)(nil)
@@ -0,0 +1,168 @@
+package eddsa
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	"github.com/iden3/go-iden3-crypto/babyjub"
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/gen"
+	"github.com/leanovate/gopter/prop"
+	"github.com/privacy-ethereum/privacy-precompiles/babyjubjub/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBabyJubJubEdDSABatchVerifyBitmapName(t *testing.T) {
+	precompile := BabyJubJubEdDSABatchVerifyBitmap{}
+
+	expected := "BabyJubJubEdDSABatchVerifyBitmap"
+	actual := precompile.Name()
+
+	assert.Equal(t, expected, actual)
+}
+
+func TestEdDSABatchVerifyBitmap(t *testing.T) {
+	valid := prepareInput()
+	invalid := func() []byte {
+		input := prepareInput()
+		input[len(input)-1] ^= 0x01
+
+		return input
+	}()
+	malformed := func() []byte {
+		input := prepareInput()
+		copy(input[:utils.BabyJubJubCurveAffinePointSize], make([]byte, utils.BabyJubJubCurveAffinePointSize))
+
+		return input
+	}()
+
+	records := func(records ...[]byte) []byte {
+		return bytes.Join(records, nil)
+	}
+
+	tests := []struct {
+		name          string
+		input         []byte
+		expected      []byte
+		expectedGas   uint64
+		expectedError error
+	}{
+		{
+			name:        "single valid signature",
+			input:       valid,
+			expected:    []byte{0x80},
+			expectedGas: BabyJubJubEdDSABatchVerifyBaseGas + BabyJubJubEdDSABatchVerifyPerSignatureGas,
+		},
+		{
+			name:        "single invalid signature",
+			input:       invalid,
+			expected:    []byte{0x00},
+			expectedGas: BabyJubJubEdDSABatchVerifyBaseGas + BabyJubJubEdDSABatchVerifyPerSignatureGas,
+		},
+		{
+			name:        "only middle signature valid",
+			input:       records(invalid, valid, invalid),
+			expected:    []byte{0x40},
+			expectedGas: BabyJubJubEdDSABatchVerifyBaseGas + 3*BabyJubJubEdDSABatchVerifyPerSignatureGas,
+		},
+		{
+			name:        "malformed record reported as not verified",
+			input:       records(valid, malformed, valid),
+			expected:    []byte{0xa0},
+			expectedGas: BabyJubJubEdDSABatchVerifyBaseGas + 3*BabyJubJubEdDSABatchVerifyPerSignatureGas,
+		},
+		{
+			name:        "eight valid signatures fill one byte",
+			input:       bytes.Repeat(valid, 8),
+			expected:    []byte{0xff},
+			expectedGas: BabyJubJubEdDSABatchVerifyBaseGas + 8*BabyJubJubEdDSABatchVerifyPerSignatureGas,
+		},
+		{
+			name:        "ninth signature spills into second byte",
+			input:       records(bytes.Repeat(valid, 7), invalid, valid),
+			expected:    []byte{0xfe, 0x80},
+			expectedGas: BabyJubJubEdDSABatchVerifyBaseGas + 9*BabyJubJubEdDSABatchVerifyPerSignatureGas,
+		},
+		{
+			name:          "batch size exceeded",
+			input:         bytes.Repeat(valid, BabyJubJubEdDSABatchVerifyMaxSignatures+1),
+			expectedError: ErrorBabyJubJubCurveEdDSAVerifyInvalidInputLength,
+		},
+		{
+			name:          "input not a multiple of the record size",
+			input:         append(records(valid), 0x00),
+			expectedError: ErrorBabyJubJubCurveEdDSAVerifyInvalidInputLength,
+		},
+		{
+			name:          "empty input",
+			input:         []byte{},
+			expectedError: ErrorBabyJubJubCurveEdDSAVerifyInvalidInputLength,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			precompile := BabyJubJubEdDSABatchVerifyBitmap{}
+
+			actual, err := precompile.Run(tt.input)
+			gas := precompile.RequiredGas(tt.input)
+
+			if tt.expectedError != nil {
+				assert.NotNil(t, err)
+				assert.Equal(t, tt.expectedError, err)
+
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, tt.expectedGas, gas)
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func TestBitmapRunProperties(t *testing.T) {
+	parameters := gopter.DefaultTestParameters()
+	parameters.MinSuccessfulTests = 20
+	properties := gopter.NewProperties(parameters)
+
+	properties.Property("Bit i is set if and only if signature i is valid", prop.ForAll(
+		func(privateKeys []babyjub.PrivateKey, messages []*big.Int, tampered []bool) bool {
+			precompile := BabyJubJubEdDSABatchVerifyBitmap{}
+			input := make([]byte, 0, len(privateKeys)*BabyJubJubCurveEdDSAVerifyInputSize)
+
+			for index, privateKey := range privateKeys {
+				signature := privateKey.SignPoseidon(messages[index])
+				message := messages[index]
+
+				if tampered[index] {
+					message = new(big.Int).Add(message, big.NewInt(1))
+				}
+
+				input = append(input, signatureRecord(privateKey.Public(), signature, message)...)
+			}
+
+			bitmap, err := precompile.Run(input)
+
+			if err != nil || len(bitmap) != (len(privateKeys)+7)/8 {
+				return false
+			}
+
+			for index := range privateKeys {
+				bit := bitmap[index/8]&(0x80>>(index%8)) != 0
+
+				if bit == tampered[index] {
+					return false
+				}
+			}
+
+			return true
+		},
+		gen.SliceOfN(10, utils.PrivateKeyGenerator()),
+		gen.SliceOfN(10, utils.ScalarGenerator()),
+		gen.SliceOfN(10, gen.Bool()),
+	))
+
+	properties.TestingRun(t)
+}
@@ -0,0 +1,84 @@
+package eddsa
+
+import (
+	"github.com/privacy-ethereum/privacy-precompiles/babyjubjub/utils"
+	"github.com/privacy-ethereum/privacy-precompiles/common"
+)
+
+// BabyJubJubEdDSAVerifyCompressed implements a BabyJubJub EdDSA signature
+// verification precompile over compressed points.
+//
+// It accepts the public key and R8 in the 32-byte compressed encoding used
+// by iden3 tooling, cutting the input of BabyJubJubCurveEdDSAVerify from 192
+// to 128 bytes, and returns the same verdict for the same signature.
+type BabyJubJubEdDSAVerifyCompressed struct{}
+
+// Name returns the human-readable name of the precompile.
+func (c *BabyJubJubEdDSAVerifyCompressed) Name() string {
+	return "BabyJubJubEdDSAVerifyCompressed"
+}
+
+// RequiredGas returns the fixed gas cost of executing this precompile,
+// BabyJubJubEdDSAVerifyCompressedGas.
+func (c *BabyJubJubEdDSAVerifyCompressed) RequiredGas(input []byte) uint64 {
+	return BabyJubJubEdDSAVerifyCompressedGas
+}
+
+// MaxOutputSize returns the byte length of the boolean result returned by Run,
+// which is BabyJubJubCurveEdDSAVerifyOutputSize regardless of the input.
+func (c *BabyJubJubEdDSAVerifyCompressed) MaxOutputSize(input []byte) int {
+	return BabyJubJubCurveEdDSAVerifyOutputSize
+}
+
+// Run executes the compressed EdDSA signature verification precompile.
+//
+// The input must be exactly BabyJubJubEdDSAVerifyCompressedInputSize bytes,
+// which encode:
+//
+//	A || R8 || S || M
+//
+// Where:
+//   - A is the public key point, compressed with utils.MarshalPointCompressed.
+//   - R8 is the signature point, compressed the same way.
+//   - S and M are encoded as in BabyJubJubCurveEdDSAVerify.
+//
+// Run performs the following steps:
+//  1. Validates that the input length equals
+//     BabyJubJubEdDSAVerifyCompressedInputSize.
+//  2. Decompresses A and R8 using utils.UnmarshalPointCompressed.
+//  3. Verifies Ax || Ay || R8x || R8y || S || M with BabyJubJubCurveEdDSAVerify.
+//
+// Returns an error if:
+//   - The input length is invalid.
+//   - A or R8 cannot be decompressed.
+//   - The signature is rejected by BabyJubJubCurveEdDSAVerify.
+func (c *BabyJubJubEdDSAVerifyCompressed) Run(input []byte) ([]byte, error) {
+	if len(input) != BabyJubJubEdDSAVerifyCompressedInputSize {
+		return nil, ErrorBabyJubJubCurveEdDSAVerifyInvalidInputLength
+	}
+
+	publicKey, err := utils.UnmarshalPointCompressed(input[:utils.BabyJubJubCurveCompressedPointSize])
+
+	if err != nil {
+		return nil, ErrorBabyJubJubCurveEdDSAVerifyPublicKeyIsNotOnCurve
+	}
+
+	R8, err := utils.UnmarshalPointCompressed(input[utils.BabyJubJubCurveCompressedPointSize : 2*utils.BabyJubJubCurveCompressedPointSize])
+
+	if err != nil {
+		return nil, ErrorBabyJubJubCurveEdDSAVerifyR8IsNotOnCurve
+	}
+
+	verifyInput := make([]byte, 0, BabyJubJubCurveEdDSAVerifyInputSize)
+	verifyInput = append(verifyInput, utils.MarshalPoint(publicKey)...)
+	verifyInput = append(verifyInput, utils.MarshalPoint(R8)...)
+	verifyInput = append(verifyInput, input[2*utils.BabyJubJubCurveCompressedPointSize:]...)
+
+	return (&BabyJubJubCurveEdDSAVerify{}).Run(verifyInput)
+}
+
+// Ensure BabyJubJubEdDSAVerifyCompressed implements the common.Precompile interface.
+var _ common.Precompile = (*BabyJubJubEdDSAVerifyCompressed)(nil)
+
+// Ensure BabyJubJubEdDSAVerifyCompressed implements the common.OutputSizer interface.
+var _ common.OutputSizer = (*BabyJubJubEdDSAVerifyCompressed)(nil)
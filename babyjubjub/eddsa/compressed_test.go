@@ -0,0 +1,170 @@
+package eddsa
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/iden3/go-iden3-crypto/babyjub"
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/prop"
+	"github.com/privacy-ethereum/privacy-precompiles/babyjubjub/utils"
+	"github.com/privacy-ethereum/privacy-precompiles/common"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBabyJubJubEdDSAVerifyCompressedName(t *testing.T) {
+	precompile := BabyJubJubEdDSAVerifyCompressed{}
+
+	expected := "BabyJubJubEdDSAVerifyCompressed"
+	actual := precompile.Name()
+
+	assert.Equal(t, expected, actual)
+}
+
+func TestBabyJubJubEdDSAVerifyCompressedMaxOutputSize(t *testing.T) {
+	precompile := BabyJubJubEdDSAVerifyCompressed{}
+
+	var sizer common.OutputSizer = &precompile
+
+	assert.Equal(t, 1, sizer.MaxOutputSize(nil))
+}
+
+func TestEdDSAVerifyCompressed(t *testing.T) {
+	tests := []struct {
+		name          string
+		input         []byte
+		expected      []byte
+		expectedError error
+	}{
+		{
+			name:     "valid signature",
+			input:    compressedInput(prepareInput()),
+			expected: []byte{1},
+		},
+		{
+			name: "invalid signature",
+			input: func() []byte {
+				input := compressedInput(prepareInput())
+				input[len(input)-1] ^= 0x01
+
+				return input
+			}(),
+			expected: []byte{0},
+		},
+		{
+			name:          "empty input",
+			input:         []byte{},
+			expectedError: ErrorBabyJubJubCurveEdDSAVerifyInvalidInputLength,
+		},
+		{
+			name:          "uncompressed input",
+			input:         prepareInput(),
+			expectedError: ErrorBabyJubJubCurveEdDSAVerifyInvalidInputLength,
+		},
+		{
+			name: "public key cannot be decompressed",
+			input: func() []byte {
+				input := compressedInput(prepareInput())
+				copy(input[:utils.BabyJubJubCurveCompressedPointSize], invalidCompressedPoint())
+
+				return input
+			}(),
+			expectedError: ErrorBabyJubJubCurveEdDSAVerifyPublicKeyIsNotOnCurve,
+		},
+		{
+			name: "R8 cannot be decompressed",
+			input: func() []byte {
+				input := compressedInput(prepareInput())
+				copy(input[utils.BabyJubJubCurveCompressedPointSize:], invalidCompressedPoint())
+
+				return input
+			}(),
+			expectedError: ErrorBabyJubJubCurveEdDSAVerifyR8IsNotOnCurve,
+		},
+		{
+			name:          "small-order public key",
+			input:         compressedInput(withPublicKey(prepareInput(), torsionPoint(1))),
+			expectedError: ErrorBabyJubJubCurveEdDSAVerifyPublicKeyIsNotOnCurve,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			precompile := BabyJubJubEdDSAVerifyCompressed{}
+
+			actual, err := precompile.Run(tt.input)
+			gas := precompile.RequiredGas(tt.input)
+
+			if tt.expectedError != nil {
+				assert.NotNil(t, err)
+				assert.Equal(t, tt.expectedError, err)
+
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, BabyJubJubEdDSAVerifyCompressedGas, gas)
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func TestEdDSAVerifyCompressedProperties(t *testing.T) {
+	parameters := gopter.DefaultTestParameters()
+	parameters.MinSuccessfulTests = 20
+	properties := gopter.NewProperties(parameters)
+
+	properties.Property("compressed and uncompressed verdicts match", prop.ForAll(
+		func(privateKey babyjub.PrivateKey, message, signed *big.Int) bool {
+			input := signatureRecord(privateKey.Public(), privateKey.SignPoseidon(signed), message)
+
+			expected, expectedErr := (&BabyJubJubCurveEdDSAVerify{}).Run(input)
+			actual, err := (&BabyJubJubEdDSAVerifyCompressed{}).Run(compressedInput(input))
+
+			return err == expectedErr && string(actual) == string(expected)
+		},
+		utils.PrivateKeyGenerator(),
+		utils.ScalarGenerator(),
+		utils.ScalarGenerator(),
+	))
+
+	properties.Property("valid signatures verify in compressed form", prop.ForAll(
+		func(privateKey babyjub.PrivateKey, message *big.Int) bool {
+			input := compressedInput(signatureRecord(privateKey.Public(), privateKey.SignPoseidon(message), message))
+
+			actual, err := (&BabyJubJubEdDSAVerifyCompressed{}).Run(input)
+
+			return err == nil && actual[0] == 1
+		},
+		utils.PrivateKeyGenerator(),
+		utils.ScalarGenerator(),
+	))
+
+	properties.TestingRun(t)
+}
+
+// compressedInput converts an Ax || Ay || R8x || R8y || S || M verification
+// input into the A || R8 || S || M layout of BabyJubJubEdDSAVerifyCompressed.
+func compressedInput(input []byte) []byte {
+	publicKey, _ := utils.UnmarshalPoint(input[:utils.BabyJubJubCurveAffinePointSize])
+	R8, _ := utils.UnmarshalPoint(input[utils.BabyJubJubCurveAffinePointSize : 2*utils.BabyJubJubCurveAffinePointSize])
+
+	compressed := utils.MarshalPointCompressed(publicKey)
+	compressed = append(compressed, utils.MarshalPointCompressed(R8)...)
+
+	return append(compressed, input[2*utils.BabyJubJubCurveAffinePointSize:]...)
+}
+
+// invalidCompressedPoint returns a compressed encoding whose Y coordinate is
+// not lower than the field modulus, so it cannot be decompressed.
+func invalidCompressedPoint() []byte {
+	data := make([]byte, utils.BabyJubJubCurveCompressedPointSize)
+
+	for index := range data {
+		data[index] = 0xff
+	}
+
+	data[utils.BabyJubJubCurveCompressedPointSize-1] = 0x7f
+
+	return data
+}
@@ -1,6 +1,8 @@
 package eddsa
 
 import (
+	"math/big"
+
 	"github.com/iden3/go-iden3-crypto/babyjub"
 	"github.com/privacy-ethereum/privacy-precompiles/babyjubjub/utils"
 	"github.com/privacy-ethereum/privacy-precompiles/common"
@@ -28,6 +30,12 @@ func (c *BabyJubJubCurveEdDSAVerify) RequiredGas(input []byte) uint64 {
 	return BabyJubJubCurveEdDSAVerifyGas
 }
 
+// MaxOutputSize returns the byte length of the boolean result returned by Run,
+// which is BabyJubJubCurveEdDSAVerifyOutputSize regardless of the input.
+func (c *BabyJubJubCurveEdDSAVerify) MaxOutputSize(input []byte) int {
+	return BabyJubJubCurveEdDSAVerifyOutputSize
+}
+
 // Run executes the EdDSA signature verification precompile.
 //
 // The input must be exactly BabyJubJubCurveEdDSAVerifyInputSize bytes, which encode:
@@ -45,22 +53,43 @@ func (c *BabyJubJubCurveEdDSAVerify) RequiredGas(input []byte) uint64 {
 //
 // Run performs the following steps:
 //  1. Validates that the input length equals BabyJubJubCurveEdDSAVerifyInputSize.
-//  2. Parses the public key point and verifies it lies on the curve.
+//  2. Parses the public key point and verifies it lies on the curve, in the
+//     prime-order subgroup, and is not the identity.
 //  3. Parses the R8 signature point and verifies it lies on the curve.
-//  4. Parses the signature scalar S and verifies it is smaller than the subgroup order.
+//  4. Parses the signature scalar S and verifies, in constant time, that it
+//     is smaller than the subgroup order.
 //  5. Parses the message field element M.
 //  6. Verifies the signature using Poseidon-based BabyJubJub EdDSA.
 //  7. Returns []byte{1} if the signature is valid, []byte{0} otherwise.
 //
 // Returns an error if:
 //   - The input length is invalid.
-//   - The public key or R8 points are not on the BabyJubJub curve.
+//   - The public key or R8 points are not on the BabyJubJub curve or not in
+//     the subgroup.
+//   - The public key is the identity point.
 //   - The signature scalar S is invalid.
 func (c *BabyJubJubCurveEdDSAVerify) Run(input []byte) ([]byte, error) {
 	if len(input) != BabyJubJubCurveEdDSAVerifyInputSize {
 		return nil, ErrorBabyJubJubCurveEdDSAVerifyInvalidInputLength
 	}
 
+	publicKey, signature, message, err := parseSignatureRecord(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if publicKey.VerifyPoseidon(message, signature) {
+		return []byte{1}, nil
+	}
+
+	return []byte{0}, nil
+}
+
+// parseSignatureRecord parses and validates the
+// BabyJubJubCurveEdDSAVerifyInputSize-byte record described in Run,
+// returning its public key, signature and message.
+func parseSignatureRecord(input []byte) (*babyjub.PublicKey, *babyjub.Signature, *big.Int, error) {
 	offset := 0
 
 	publicKeyX, offset := commonUtils.ReadField(input, offset, utils.BabyJubJubCurveFieldByteSize)
@@ -71,8 +100,10 @@ func (c *BabyJubJubCurveEdDSAVerify) Run(input []byte) ([]byte, error) {
 		Y: publicKeyY,
 	}
 
-	if !publicKeyPoint.InCurve() || !publicKeyPoint.InSubGroup() {
-		return nil, ErrorBabyJubJubCurveEdDSAVerifyPublicKeyIsNotOnCurve
+	// The identity is the only small-order point in the subgroup. It is
+	// rejected as well, since any (R8, S) with R8 = S*B8 verifies against it.
+	if !publicKeyPoint.InCurve() || !publicKeyPoint.InSubGroup() || utils.IsIdentity(&publicKeyPoint) {
+		return nil, nil, nil, ErrorBabyJubJubCurveEdDSAVerifyPublicKeyIsNotOnCurve
 	}
 
 	r8X, offset := commonUtils.ReadField(input, offset, utils.BabyJubJubCurveFieldByteSize)
@@ -84,13 +115,14 @@ func (c *BabyJubJubCurveEdDSAVerify) Run(input []byte) ([]byte, error) {
 	}
 
 	if !R8.InCurve() || !R8.InSubGroup() {
-		return nil, ErrorBabyJubJubCurveEdDSAVerifyR8IsNotOnCurve
+		return nil, nil, nil, ErrorBabyJubJubCurveEdDSAVerifyR8IsNotOnCurve
 	}
 
 	S, offset := commonUtils.ReadField(input, offset, utils.BabyJubJubCurveFieldByteSize)
 
-	if S.Cmp(babyjub.SubOrder) >= 0 {
-		return nil, ErrorBabyJubJubCurveEdDSAVerifyInvalidS
+	// S is compared in constant time, as it is chosen by the signer.
+	if !commonUtils.ConstantTimeLess(S, utils.SubOrder, utils.BabyJubJubCurveFieldByteSize) {
+		return nil, nil, nil, ErrorBabyJubJubCurveEdDSAVerifyInvalidS
 	}
 
 	message, _ := commonUtils.ReadField(input, offset, utils.BabyJubJubCurveFieldByteSize)
@@ -98,12 +130,11 @@ func (c *BabyJubJubCurveEdDSAVerify) Run(input []byte) ([]byte, error) {
 	signature := &babyjub.Signature{R8: &R8, S: S}
 	publicKey := &babyjub.PublicKey{X: publicKeyPoint.X, Y: publicKeyPoint.Y}
 
-	if publicKey.VerifyPoseidon(message, signature) {
-		return []byte{1}, nil
-	}
-
-	return []byte{0}, nil
+	return publicKey, signature, message, nil
 }
 
 // Ensure BabyJubJubCurveEdDSAVerify implements the common.Precompile interface.
 var _ common.Precompile = (*BabyJubJubCurveEdDSAVerify)(nil)
+
+// Ensure BabyJubJubCurveEdDSAVerify implements the common.OutputSizer interface.
+var _ common.OutputSizer = (*BabyJubJubCurveEdDSAVerify)(nil)
@@ -9,6 +9,8 @@ import (
 	"github.com/leanovate/gopter"
 	"github.com/leanovate/gopter/prop"
 	"github.com/privacy-ethereum/privacy-precompiles/babyjubjub/utils"
+	"github.com/privacy-ethereum/privacy-precompiles/common"
+	"github.com/privacy-ethereum/privacy-precompiles/internal/commontest"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -21,6 +23,14 @@ func TestBabyJubJubEdDSAName(t *testing.T) {
 	assert.Equal(t, expected, actual)
 }
 
+func TestBabyJubJubEdDSAMaxOutputSize(t *testing.T) {
+	precompile := BabyJubJubCurveEdDSAVerify{}
+
+	var sizer common.OutputSizer = &precompile
+
+	assert.Equal(t, 1, sizer.MaxOutputSize(nil))
+}
+
 func TestEdDSAVerify(t *testing.T) {
 	tests := []struct {
 		name          string
@@ -103,6 +113,48 @@ func TestEdDSAVerify(t *testing.T) {
 			}(),
 			expectedError: ErrorBabyJubJubCurveEdDSAVerifyR8IsNotOnCurve,
 		},
+		{
+			name:          "small-order public key (order 2)",
+			input:         withPublicKey(prepareInput(), torsionPoint(4)),
+			expectedError: ErrorBabyJubJubCurveEdDSAVerifyPublicKeyIsNotOnCurve,
+		},
+		{
+			name:          "small-order public key (order 4)",
+			input:         withPublicKey(prepareInput(), torsionPoint(2)),
+			expectedError: ErrorBabyJubJubCurveEdDSAVerifyPublicKeyIsNotOnCurve,
+		},
+		{
+			name:          "small-order public key (order 8)",
+			input:         withPublicKey(prepareInput(), torsionPoint(1)),
+			expectedError: ErrorBabyJubJubCurveEdDSAVerifyPublicKeyIsNotOnCurve,
+		},
+		{
+			name:          "small-order public key (order 8, odd multiple)",
+			input:         withPublicKey(prepareInput(), torsionPoint(3)),
+			expectedError: ErrorBabyJubJubCurveEdDSAVerifyPublicKeyIsNotOnCurve,
+		},
+		{
+			name: "identity public key with forged signature",
+			input: func() []byte {
+				S := big.NewInt(1234)
+				signature := &babyjub.Signature{R8: babyjub.NewPoint().Mul(S, babyjub.B8), S: S}
+				publicKey := &babyjub.PublicKey{X: big.NewInt(0), Y: big.NewInt(1)}
+
+				return signatureRecord(publicKey, signature, big.NewInt(42))
+			}(),
+			expectedError: ErrorBabyJubJubCurveEdDSAVerifyPublicKeyIsNotOnCurve,
+		},
+		{
+			name: "unreduced identity public key with forged signature",
+			input: func() []byte {
+				S := big.NewInt(1234)
+				signature := &babyjub.Signature{R8: babyjub.NewPoint().Mul(S, babyjub.B8), S: S}
+				publicKey := &babyjub.PublicKey{X: new(big.Int).Set(utils.FieldPrime), Y: big.NewInt(1)}
+
+				return signatureRecord(publicKey, signature, big.NewInt(42))
+			}(),
+			expectedError: ErrorBabyJubJubCurveEdDSAVerifyPublicKeyIsNotOnCurve,
+		},
 		{
 			name: "invalid S",
 			input: func() []byte {
@@ -117,6 +169,20 @@ func TestEdDSAVerify(t *testing.T) {
 			}(),
 			expectedError: ErrorBabyJubJubCurveEdDSAVerifyInvalidS,
 		},
+		{
+			name: "largest valid S",
+			input: func() []byte {
+				input := prepareInput()
+
+				start := utils.BabyJubJubCurveAffinePointSize + 2*utils.BabyJubJubCurveFieldByteSize
+				end := start + utils.BabyJubJubCurveFieldByteSize
+
+				new(big.Int).Sub(babyjub.SubOrder, big.NewInt(1)).FillBytes(input[start:end])
+
+				return input
+			}(),
+			expected: []byte{0},
+		},
 	}
 
 	for _, tt := range tests {
@@ -167,6 +233,47 @@ func TestRunProperties(t *testing.T) {
 	))
 }
 
+func TestTorsionPoints(t *testing.T) {
+	b8 := babyjub.NewPoint().Mul(big.NewInt(8), generator())
+
+	assert.Equal(t, true, b8.X.Cmp(babyjub.B8.X) == 0 && b8.Y.Cmp(babyjub.B8.Y) == 0)
+
+	for k := int64(1); k < 8; k++ {
+		point := torsionPoint(k)
+
+		assert.Equal(t, true, point.InCurve())
+		assert.Equal(t, false, point.InSubGroup())
+
+		cleared := babyjub.NewPoint().Mul(big.NewInt(8), point)
+
+		assert.Equal(t, true, cleared.X.Sign() == 0 && cleared.Y.Cmp(big.NewInt(1)) == 0)
+	}
+}
+
+// generator returns the generator of the full BabyJubJub group, of order
+// 8 * babyjub.SubOrder.
+func generator() *babyjub.Point {
+	x, _ := new(big.Int).SetString("995203441582195749578291179787384436505546430278305826713579947235728471134", 10)
+	y, _ := new(big.Int).SetString("5472060717959818805561601436314318772137091100104008585924551046643952123905", 10)
+
+	return &babyjub.Point{X: x, Y: y}
+}
+
+// torsionPoint returns k*T, where T = SubOrder*G is a point of order 8.
+// Its order is 8/gcd(k, 8).
+func torsionPoint(k int64) *babyjub.Point {
+	scalar := new(big.Int).Mul(babyjub.SubOrder, big.NewInt(k))
+
+	return babyjub.NewPoint().Mul(scalar, generator())
+}
+
+// withPublicKey replaces the public key of an encoded verification input.
+func withPublicKey(input []byte, publicKey *babyjub.Point) []byte {
+	copy(input[:utils.BabyJubJubCurveAffinePointSize], utils.MarshalPoint(publicKey))
+
+	return input
+}
+
 func prepareInput() []byte {
 	privateKey := func() babyjub.PrivateKey {
 		var key babyjub.PrivateKey
@@ -196,3 +303,29 @@ func packedInput(publicKey *babyjub.PublicKey, signature *babyjub.Signature, mes
 		messageBytes...,
 	)
 }
+
+func BenchmarkEdDSAVerify(b *testing.B) {
+	key, _ := utils.PrivateKeyGenerator().Sample()
+	sample, _ := utils.ScalarGenerator().Sample()
+
+	privateKey := key.(babyjub.PrivateKey)
+	message := sample.(*big.Int)
+	input := packedInput(privateKey.Public(), privateKey.SignPoseidon(message), message)
+
+	commontest.BenchmarkGasRatio(b, &BabyJubJubCurveEdDSAVerify{}, input)
+}
+
+func FuzzEdDSA(f *testing.F) {
+	f.Add(prepareInput())
+	f.Add(withPublicKey(prepareInput(), torsionPoint(4)))
+
+	f.Fuzz(func(t *testing.T, input []byte) {
+		precompile := BabyJubJubCurveEdDSAVerify{}
+
+		actual, err := precompile.Run(input)
+
+		if err == nil {
+			assert.LessOrEqual(t, len(actual), precompile.MaxOutputSize(input))
+		}
+	})
+}
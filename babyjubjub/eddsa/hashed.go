@@ -0,0 +1,112 @@
+package eddsa
+
+import (
+	"math/big"
+
+	"github.com/privacy-ethereum/privacy-precompiles/common"
+	"github.com/privacy-ethereum/privacy-precompiles/poseidon"
+	"github.com/privacy-ethereum/privacy-precompiles/poseidon/sponge"
+)
+
+// BabyJubJubEdDSAVerifyHashed implements a BabyJubJub EdDSA signature
+// verification precompile over a raw message.
+//
+// It satisfies the common.Precompile interface and can be used in a generic
+// precompile execution framework. Unlike BabyJubJubCurveEdDSAVerify, which
+// expects an already computed message hash M, the message is an arbitrary
+// byte string hashed by the precompile before verification, as described in
+// HashMessage.
+type BabyJubJubEdDSAVerifyHashed struct{}
+
+// Name returns the human-readable name of the precompile.
+func (c *BabyJubJubEdDSAVerifyHashed) Name() string {
+	return "BabyJubJubEdDSAVerifyHashed"
+}
+
+// RequiredGas returns the gas cost of executing this precompile.
+//
+// Gas is calculated as:
+//
+//	BabyJubJubCurveEdDSAVerifyGas + PoseidonBaseGas + (number_of_words * PoseidonPerWordGas)
+//
+// Where number_of_words is the number of sponge words of the padded message,
+// as described in HashMessage.
+func (c *BabyJubJubEdDSAVerifyHashed) RequiredGas(input []byte) uint64 {
+	if len(input) < BabyJubJubEdDSAVerifyHashedPrefixSize {
+		return BabyJubJubCurveEdDSAVerifyGas
+	}
+
+	words := (len(input)-BabyJubJubEdDSAVerifyHashedPrefixSize)/BabyJubJubEdDSAVerifyHashedChunkSize + 1
+
+	return BabyJubJubCurveEdDSAVerifyGas + poseidon.PoseidonBaseGas + uint64(words)*poseidon.PoseidonPerWordGas
+}
+
+// Run executes the hashed-message EdDSA signature verification precompile.
+//
+// The input is encoded as:
+//
+//	Ax || Ay || R8x || R8y || S || message
+//
+// Where:
+//   - Ax, Ay, R8x, R8y and S are encoded as in BabyJubJubCurveEdDSAVerify.
+//   - message is the raw message, of any length including zero.
+//
+// Run performs the following steps:
+//  1. Validates that the input holds the fixed prefix.
+//  2. Hashes the message with HashMessage.
+//  3. Verifies Ax || Ay || R8x || R8y || S || M with BabyJubJubCurveEdDSAVerify.
+//
+// Returns an error if:
+//   - The input is shorter than BabyJubJubEdDSAVerifyHashedPrefixSize.
+//   - The signature is rejected by BabyJubJubCurveEdDSAVerify.
+func (c *BabyJubJubEdDSAVerifyHashed) Run(input []byte) ([]byte, error) {
+	if len(input) < BabyJubJubEdDSAVerifyHashedPrefixSize {
+		return nil, ErrorBabyJubJubCurveEdDSAVerifyInvalidInputLength
+	}
+
+	message, err := HashMessage(input[BabyJubJubEdDSAVerifyHashedPrefixSize:])
+
+	if err != nil {
+		return nil, err
+	}
+
+	verifyInput := make([]byte, 0, BabyJubJubCurveEdDSAVerifyInputSize)
+	verifyInput = append(verifyInput, input[:BabyJubJubEdDSAVerifyHashedPrefixSize]...)
+	verifyInput = append(verifyInput, message.FillBytes(make([]byte, poseidon.PoseidonOutputSize))...)
+
+	return (&BabyJubJubCurveEdDSAVerify{}).Run(verifyInput)
+}
+
+// HashMessage returns the message hash M signed for message by
+// BabyJubJubEdDSAVerifyHashed signers.
+//
+// The message is padded with a single 0x01 byte followed by zero bytes up to
+// a multiple of BabyJubJubEdDSAVerifyHashedChunkSize bytes, so that distinct
+// messages never share a padded form. Each chunk is read as a big-endian
+// integer, which is always inside the Poseidon field, and the resulting
+// words are hashed with the PoseidonSponge precompile.
+func HashMessage(message []byte) (*big.Int, error) {
+	words := len(message)/BabyJubJubEdDSAVerifyHashedChunkSize + 1
+	padded := make([]byte, words*BabyJubJubEdDSAVerifyHashedChunkSize)
+
+	copy(padded, message)
+	padded[len(message)] = 1
+
+	spongeInput := make([]byte, words*poseidon.PoseidonInputWordSize)
+
+	for index := range words {
+		chunk := padded[index*BabyJubJubEdDSAVerifyHashedChunkSize : (index+1)*BabyJubJubEdDSAVerifyHashedChunkSize]
+		copy(spongeInput[(index+1)*poseidon.PoseidonInputWordSize-BabyJubJubEdDSAVerifyHashedChunkSize:], chunk)
+	}
+
+	digest, err := (&sponge.PoseidonSponge{}).Run(spongeInput)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return new(big.Int).SetBytes(digest), nil
+}
+
+// Ensure BabyJubJubEdDSAVerifyHashed implements the common.Precompile interface.
+var _ common.Precompile = (*BabyJubJubEdDSAVerifyHashed)(nil)
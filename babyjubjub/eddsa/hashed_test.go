@@ -0,0 +1,187 @@
+package eddsa
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	"github.com/iden3/go-iden3-crypto/babyjub"
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/gen"
+	"github.com/leanovate/gopter/prop"
+	"github.com/privacy-ethereum/privacy-precompiles/babyjubjub/utils"
+	"github.com/privacy-ethereum/privacy-precompiles/poseidon"
+	"github.com/privacy-ethereum/privacy-precompiles/poseidon/sponge"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBabyJubJubEdDSAVerifyHashedName(t *testing.T) {
+	precompile := BabyJubJubEdDSAVerifyHashed{}
+
+	expected := "BabyJubJubEdDSAVerifyHashed"
+	actual := precompile.Name()
+
+	assert.Equal(t, expected, actual)
+}
+
+func TestEdDSAVerifyHashed(t *testing.T) {
+	privateKey := babyjub.PrivateKey{}
+	big.NewInt(1234).FillBytes(privateKey[:])
+
+	long := bytes.Repeat([]byte("message "), 75)
+
+	tests := []struct {
+		name          string
+		input         []byte
+		expected      []byte
+		expectedGas   uint64
+		expectedError error
+	}{
+		{
+			name:        "valid signature over a one byte message",
+			input:       prepareHashedInput(privateKey, []byte{0x61}, []byte{0x61}),
+			expected:    []byte{1},
+			expectedGas: BabyJubJubCurveEdDSAVerifyGas + poseidon.PoseidonBaseGas + poseidon.PoseidonPerWordGas,
+		},
+		{
+			name:        "valid signature over a message longer than 512 bytes",
+			input:       prepareHashedInput(privateKey, long, long),
+			expected:    []byte{1},
+			expectedGas: BabyJubJubCurveEdDSAVerifyGas + poseidon.PoseidonBaseGas + 20*poseidon.PoseidonPerWordGas,
+		},
+		{
+			name:        "valid signature over an empty message",
+			input:       prepareHashedInput(privateKey, nil, nil),
+			expected:    []byte{1},
+			expectedGas: BabyJubJubCurveEdDSAVerifyGas + poseidon.PoseidonBaseGas + poseidon.PoseidonPerWordGas,
+		},
+		{
+			name:        "valid signature over a message of a whole number of chunks",
+			input:       prepareHashedInput(privateKey, long[:2*BabyJubJubEdDSAVerifyHashedChunkSize], long[:2*BabyJubJubEdDSAVerifyHashedChunkSize]),
+			expected:    []byte{1},
+			expectedGas: BabyJubJubCurveEdDSAVerifyGas + poseidon.PoseidonBaseGas + 3*poseidon.PoseidonPerWordGas,
+		},
+		{
+			name:        "signed message differs",
+			input:       prepareHashedInput(privateKey, long, long[:len(long)-1]),
+			expected:    []byte{0},
+			expectedGas: BabyJubJubCurveEdDSAVerifyGas + poseidon.PoseidonBaseGas + 20*poseidon.PoseidonPerWordGas,
+		},
+		{
+			name:        "signed message differs by a trailing zero byte",
+			input:       prepareHashedInput(privateKey, []byte{0x61}, []byte{0x61, 0x00}),
+			expected:    []byte{0},
+			expectedGas: BabyJubJubCurveEdDSAVerifyGas + poseidon.PoseidonBaseGas + poseidon.PoseidonPerWordGas,
+		},
+		{
+			name:          "input shorter than prefix",
+			input:         make([]byte, BabyJubJubEdDSAVerifyHashedPrefixSize-1),
+			expectedError: ErrorBabyJubJubCurveEdDSAVerifyInvalidInputLength,
+		},
+		{
+			name: "invalid public key",
+			input: func() []byte {
+				input := prepareHashedInput(privateKey, long, long)
+				copy(input[:utils.BabyJubJubCurveAffinePointSize], make([]byte, utils.BabyJubJubCurveAffinePointSize))
+
+				return input
+			}(),
+			expectedError: ErrorBabyJubJubCurveEdDSAVerifyPublicKeyIsNotOnCurve,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			precompile := BabyJubJubEdDSAVerifyHashed{}
+
+			actual, err := precompile.Run(tt.input)
+			gas := precompile.RequiredGas(tt.input)
+
+			if tt.expectedError != nil {
+				assert.NotNil(t, err)
+				assert.Equal(t, tt.expectedError, err)
+
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, tt.expectedGas, gas)
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func TestHashMessage(t *testing.T) {
+	messages := [][]byte{
+		nil,
+		{0x00},
+		{0x00, 0x00},
+		{0x01},
+		bytes.Repeat([]byte{0x00}, BabyJubJubEdDSAVerifyHashedChunkSize),
+		bytes.Repeat([]byte{0x00}, BabyJubJubEdDSAVerifyHashedChunkSize+1),
+	}
+
+	hashes := make(map[string]bool, len(messages))
+
+	for _, message := range messages {
+		hash, err := HashMessage(message)
+
+		assert.Nil(t, err)
+		assert.False(t, hashes[hash.String()], "collision for message %x", message)
+
+		hashes[hash.String()] = true
+	}
+
+	// A message of one chunk is padded to two sponge words.
+	expected, err := (&sponge.PoseidonSponge{}).Run(append(
+		append(make([]byte, 1), bytes.Repeat([]byte{0xff}, BabyJubJubEdDSAVerifyHashedChunkSize)...),
+		append(make([]byte, 1), append([]byte{0x01}, make([]byte, BabyJubJubEdDSAVerifyHashedChunkSize-1)...)...)...,
+	))
+	assert.Nil(t, err)
+
+	actual, err := HashMessage(bytes.Repeat([]byte{0xff}, BabyJubJubEdDSAVerifyHashedChunkSize))
+	assert.Nil(t, err)
+	assert.Equal(t, new(big.Int).SetBytes(expected), actual)
+}
+
+func TestHashedRunProperties(t *testing.T) {
+	parameters := gopter.DefaultTestParameters()
+	parameters.MinSuccessfulTests = 20
+	properties := gopter.NewProperties(parameters)
+
+	properties.Property("Hashed and fixed-layout verifiers agree on any message", prop.ForAll(
+		func(privateKey babyjub.PrivateKey, message []byte) bool {
+			hash, err := HashMessage(message)
+
+			if err != nil {
+				return false
+			}
+
+			signature := privateKey.SignPoseidon(hash)
+
+			fixed, err1 := (&BabyJubJubCurveEdDSAVerify{}).Run(signatureRecord(privateKey.Public(), signature, hash))
+			hashed, err2 := (&BabyJubJubEdDSAVerifyHashed{}).Run(prepareHashedInput(privateKey, message, message))
+
+			if err1 != nil || err2 != nil {
+				return false
+			}
+
+			return bytes.Equal(fixed, []byte{1}) && bytes.Equal(hashed, fixed)
+		},
+		utils.PrivateKeyGenerator(),
+		gen.SliceOf(gen.UInt8()),
+	))
+
+	properties.TestingRun(t)
+}
+
+// prepareHashedInput signs HashMessage(signed) and encodes the signature
+// followed by the raw message.
+func prepareHashedInput(privateKey babyjub.PrivateKey, signed, message []byte) []byte {
+	hash, _ := HashMessage(signed)
+	signature := privateKey.SignPoseidon(hash)
+
+	input := signatureRecord(privateKey.Public(), signature, hash)[:BabyJubJubEdDSAVerifyHashedPrefixSize]
+
+	return append(input, message...)
+}
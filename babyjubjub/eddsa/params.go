@@ -33,6 +33,11 @@ const (
 	//   6 * utils.BabyJubJubCurveFieldByteSize
 	BabyJubJubCurveEdDSAVerifyInputSize = 6 * utils.BabyJubJubCurveFieldByteSize
 
+	// BabyJubJubCurveEdDSAVerifyOutputSize defines the fixed byte length of
+	// the output produced by the BabyJubJub EdDSA signature verification
+	// precompile: a single boolean byte.
+	BabyJubJubCurveEdDSAVerifyOutputSize = 1
+
 	// BabyJubJubCurveEdDSAVerifyGas defines the fixed gas cost for executing the
 	// BabyJubJub EdDSA signature verification precompile in an
 	// Ethereum-like execution environment.
@@ -48,6 +53,77 @@ const (
 	//
 	// The gas value is constant because the input size is fixed.
 	BabyJubJubCurveEdDSAVerifyGas uint64 = 270000
+
+	// BabyJubJubEdDSABatchVerifyMaxSignatures defines the maximum number of
+	// signature records accepted by the batch verification precompile in a
+	// single invocation.
+	BabyJubJubEdDSABatchVerifyMaxSignatures = 32
+
+	// BabyJubJubEdDSABatchVerifyCoefficientSize defines the byte length of
+	// the coefficients of the random linear combination checked by the
+	// batch verification precompile, which bounds the probability of an
+	// invalid batch being accepted by 2^-128.
+	BabyJubJubEdDSABatchVerifyCoefficientSize = 16
+
+	// BabyJubJubEdDSABatchVerifyBaseGas defines the fixed gas cost of the
+	// batch verification precompile, independent of the number of signatures.
+	BabyJubJubEdDSABatchVerifyBaseGas uint64 = 3000
+
+	// BabyJubJubEdDSABatchVerifyPerSignatureGas defines the gas cost charged
+	// per signature record in the batch verification precompile.
+	//
+	// Each record still pays for its point and subgroup validation and its
+	// Poseidon hash, but not for its own fixed-base multiplication, and its
+	// variable-base multiplications share their doublings with the rest of
+	// the batch. Measured with BenchmarkEdDSABatchVerify on full batches, a
+	// record takes about 70% of the time of BabyJubJubCurveEdDSAVerify, so it
+	// is priced at 200000 gas against 270000, with some margin.
+	//
+	// Total gas cost is calculated as:
+	//
+	//	BabyJubJubEdDSABatchVerifyBaseGas + (number_of_signatures * BabyJubJubEdDSABatchVerifyPerSignatureGas)
+	BabyJubJubEdDSABatchVerifyPerSignatureGas uint64 = 200000
+
+	// BabyJubJubEdDSAVerifyHashedPrefixSize defines the byte length of the
+	// fixed prefix of the hashed-message EdDSA verification input:
+	//
+	//	Ax || Ay || R8x || R8y || S
+	//
+	// The raw message follows the prefix.
+	BabyJubJubEdDSAVerifyHashedPrefixSize = BabyJubJubCurveEdDSAVerifyInputSize - utils.BabyJubJubCurveFieldByteSize
+
+	// BabyJubJubEdDSAVerifyHashedChunkSize defines the number of message
+	// bytes packed into each sponge word by HashMessage. It is one byte
+	// shorter than a field element, so every chunk is inside the field.
+	BabyJubJubEdDSAVerifyHashedChunkSize = utils.BabyJubJubCurveFieldByteSize - 1
+
+	// BabyJubJubEdDSAVerifyCompressedInputSize defines the fixed byte length
+	// of the input to the compressed EdDSA signature verification precompile:
+	//
+	//	A || R8 || S || M
+	//
+	// Where A and R8 are compressed points (see utils.MarshalPointCompressed)
+	// and S and M are encoded as in BabyJubJubCurveEdDSAVerifyInputSize.
+	BabyJubJubEdDSAVerifyCompressedInputSize = 2*utils.BabyJubJubCurveCompressedPointSize + 2*utils.BabyJubJubCurveFieldByteSize
+
+	// BabyJubJubEdDSAVerifyCompressedGas defines the fixed gas cost of the
+	// compressed EdDSA signature verification precompile.
+	//
+	// It extends BabyJubJubCurveEdDSAVerifyGas with the modular square roots
+	// needed to decompress A and R8, priced at 1600 gas each. Subgroup checks
+	// are already part of the verification cost.
+	BabyJubJubEdDSAVerifyCompressedGas uint64 = BabyJubJubCurveEdDSAVerifyGas + 2*1600
+
+	// BabyJubJubEdDSAPublicKeyInputSize defines the fixed byte length of the
+	// input to the public key derivation precompile: a single scalar encoded
+	// as a big-endian field element.
+	BabyJubJubEdDSAPublicKeyInputSize = utils.BabyJubJubCurveFieldByteSize
+
+	// BabyJubJubEdDSAPublicKeyGas defines the fixed gas cost of the public key
+	// derivation precompile. It is dominated by one scalar multiplication of
+	// the base point, so it is priced as a single BabyJubJub scalar
+	// multiplication.
+	BabyJubJubEdDSAPublicKeyGas uint64 = 14400
 )
 
 var (
@@ -56,7 +132,8 @@ var (
 	ErrorBabyJubJubCurveEdDSAVerifyInvalidInputLength = errors.New("invalid input length")
 
 	// ErrorBabyJubJubCurveEdDSAVerifyPublicKeyIsNotOnCurve is returned when the
-	// provided public key point is not a valid BabyJubJub curve point.
+	// provided public key point is not a valid BabyJubJub curve point, is not
+	// in the prime-order subgroup, or is the identity.
 	ErrorBabyJubJubCurveEdDSAVerifyPublicKeyIsNotOnCurve = errors.New("public key is not on curve")
 
 	// ErrorBabyJubJubCurveEdDSAVerifyR8IsNotOnCurve is returned when the R8 point
@@ -0,0 +1,55 @@
+package eddsa
+
+import (
+	"github.com/iden3/go-iden3-crypto/babyjub"
+	"github.com/privacy-ethereum/privacy-precompiles/babyjubjub/utils"
+	"github.com/privacy-ethereum/privacy-precompiles/common"
+	commonUtils "github.com/privacy-ethereum/privacy-precompiles/utils"
+)
+
+// BabyJubJubEdDSAPublicKey implements a precompile deriving a BabyJubJub
+// EdDSA public key from a private key scalar.
+//
+// It satisfies the common.Precompile interface and can be used in a generic
+// precompile execution framework, e.g. by account abstraction contracts that
+// need to recompute A = s*B8.
+type BabyJubJubEdDSAPublicKey struct{}
+
+// Name returns the human-readable name of the precompile.
+func (c *BabyJubJubEdDSAPublicKey) Name() string {
+	return "BabyJubJubEdDSAPublicKey"
+}
+
+// RequiredGas returns the fixed gas cost of executing this precompile.
+//
+// For public key derivation, the gas cost is BabyJubJubEdDSAPublicKeyGas.
+func (c *BabyJubJubEdDSAPublicKey) RequiredGas(input []byte) uint64 {
+	return BabyJubJubEdDSAPublicKeyGas
+}
+
+// Run executes the public key derivation precompile.
+//
+// The input must be exactly BabyJubJubEdDSAPublicKeyInputSize bytes encoding
+// the scalar s as a big-endian field element, e.g. the value returned by
+// babyjub.PrivateKey.Scalar.
+//
+// Run performs the following steps:
+//  1. Parses the scalar using utils.ReadField.
+//  2. Reduces the scalar modulo the BabyJubJub subgroup order.
+//  3. Computes A = s*B8.
+//  4. Returns A serialized with utils.MarshalPoint.
+//
+// Returns an error if the input length is incorrect.
+func (c *BabyJubJubEdDSAPublicKey) Run(input []byte) ([]byte, error) {
+	if len(input) != BabyJubJubEdDSAPublicKeyInputSize {
+		return nil, ErrorBabyJubJubCurveEdDSAVerifyInvalidInputLength
+	}
+
+	scalar, _ := commonUtils.ReadField(input, 0, utils.BabyJubJubCurveFieldByteSize)
+	scalar = utils.ReduceScalar(scalar)
+
+	return utils.MarshalPoint(babyjub.NewPoint().Mul(scalar, babyjub.B8)), nil
+}
+
+// Ensure BabyJubJubEdDSAPublicKey implements the common.Precompile interface.
+var _ common.Precompile = (*BabyJubJubEdDSAPublicKey)(nil)
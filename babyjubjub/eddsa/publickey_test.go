@@ -0,0 +1,117 @@
+package eddsa
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	"github.com/iden3/go-iden3-crypto/babyjub"
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/prop"
+	"github.com/privacy-ethereum/privacy-precompiles/babyjubjub/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBabyJubJubEdDSAPublicKeyName(t *testing.T) {
+	precompile := BabyJubJubEdDSAPublicKey{}
+
+	expected := "BabyJubJubEdDSAPublicKey"
+	actual := precompile.Name()
+
+	assert.Equal(t, expected, actual)
+}
+
+func TestEdDSAPublicKey(t *testing.T) {
+	privateKey := babyjub.PrivateKey{}
+	big.NewInt(1234).FillBytes(privateKey[:])
+
+	tests := []struct {
+		name          string
+		input         []byte
+		expected      []byte
+		expectedError error
+	}{
+		{
+			name:     "scalar of a private key",
+			input:    privateKey.Scalar().BigInt().FillBytes(make([]byte, BabyJubJubEdDSAPublicKeyInputSize)),
+			expected: utils.MarshalPoint(privateKey.Public().Point()),
+		},
+		{
+			name:     "scalar one",
+			input:    big.NewInt(1).FillBytes(make([]byte, BabyJubJubEdDSAPublicKeyInputSize)),
+			expected: utils.MarshalPoint(babyjub.B8),
+		},
+		{
+			name:     "scalar equal to suborder",
+			input:    babyjub.SubOrder.FillBytes(make([]byte, BabyJubJubEdDSAPublicKeyInputSize)),
+			expected: utils.MarshalPoint(babyjub.NewPoint()),
+		},
+		{
+			name:     "scalar above suborder is reduced",
+			input:    new(big.Int).Add(babyjub.SubOrder, big.NewInt(1)).FillBytes(make([]byte, BabyJubJubEdDSAPublicKeyInputSize)),
+			expected: utils.MarshalPoint(babyjub.B8),
+		},
+		{
+			name:          "input too short",
+			input:         make([]byte, BabyJubJubEdDSAPublicKeyInputSize-1),
+			expectedError: ErrorBabyJubJubCurveEdDSAVerifyInvalidInputLength,
+		},
+		{
+			name:          "empty input",
+			input:         []byte{},
+			expectedError: ErrorBabyJubJubCurveEdDSAVerifyInvalidInputLength,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			precompile := BabyJubJubEdDSAPublicKey{}
+
+			actual, err := precompile.Run(tt.input)
+			gas := precompile.RequiredGas(tt.input)
+
+			if tt.expectedError != nil {
+				assert.NotNil(t, err)
+				assert.Equal(t, tt.expectedError, err)
+
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, BabyJubJubEdDSAPublicKeyGas, gas)
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func TestPublicKeyRunProperties(t *testing.T) {
+	parameters := gopter.DefaultTestParameters()
+	properties := gopter.NewProperties(parameters)
+
+	properties.Property("Signatures verify against the derived public key", prop.ForAll(
+		func(privateKey babyjub.PrivateKey, message *big.Int) bool {
+			scalar := privateKey.Scalar().BigInt().FillBytes(make([]byte, BabyJubJubEdDSAPublicKeyInputSize))
+
+			publicKey, err := (&BabyJubJubEdDSAPublicKey{}).Run(scalar)
+
+			if err != nil {
+				return false
+			}
+
+			signature := privateKey.SignPoseidon(message)
+			input := append(append([]byte{}, publicKey...), signatureRecord(privateKey.Public(), signature, message)[utils.BabyJubJubCurveAffinePointSize:]...)
+
+			result, err := (&BabyJubJubCurveEdDSAVerify{}).Run(input)
+
+			if err != nil {
+				return false
+			}
+
+			return bytes.Equal(result, []byte{1})
+		},
+		utils.PrivateKeyGenerator(),
+		utils.ScalarGenerator(),
+	))
+
+	properties.TestingRun(t)
+}
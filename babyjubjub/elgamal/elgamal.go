@@ -0,0 +1,166 @@
+package elgamal
+
+import (
+	"math/big"
+
+	"github.com/iden3/go-iden3-crypto/babyjub"
+	"github.com/iden3/go-iden3-crypto/poseidon"
+	"github.com/privacy-ethereum/privacy-precompiles/babyjubjub/utils"
+	"github.com/privacy-ethereum/privacy-precompiles/common"
+	commonUtils "github.com/privacy-ethereum/privacy-precompiles/utils"
+)
+
+// BabyJubJubElGamalIsZero implements a precompile verifying that an ElGamal
+// ciphertext over BabyJubJub decrypts to the identity point (zero value).
+//
+// It satisfies the common.Precompile interface and can be used in a generic
+// precompile execution framework, e.g. to confirm that a homomorphic sum of
+// encrypted debits and credits is balanced without revealing the secret key.
+//
+// For a ciphertext (C1, C2) = (r*B8, M + r*A) under public key A = sk*B8,
+// the plaintext M is the identity exactly when C2 = sk*C1. The proof is a
+// Chaum-Pedersen proof of discrete log equality:
+//
+//	log_B8(A) == log_C1(C2)
+//
+// Made non-interactive with the Fiat-Shamir challenge:
+//
+//	c = poseidon(Ax, Ay, C1x, C1y, C2x, C2y, T1x, T1y, T2x, T2y) mod SubOrder
+//
+// The prover picks a random k and publishes T1 = k*B8, T2 = k*C1 and
+// z = k + c*sk mod SubOrder. The verifier accepts if:
+//
+//	z*B8 == T1 + c*A
+//	z*C1 == T2 + c*C2
+type BabyJubJubElGamalIsZero struct{}
+
+// Name returns the human-readable name of the precompile.
+func (c *BabyJubJubElGamalIsZero) Name() string {
+	return "BabyJubJubElGamalIsZero"
+}
+
+// RequiredGas returns the fixed gas cost of executing this precompile.
+//
+// For ElGamal zero-plaintext verification, the gas cost is BabyJubJubElGamalIsZeroGas.
+func (c *BabyJubJubElGamalIsZero) RequiredGas(input []byte) uint64 {
+	return BabyJubJubElGamalIsZeroGas
+}
+
+// Run executes the ElGamal zero-plaintext verification precompile.
+//
+// The input must be exactly BabyJubJubElGamalIsZeroInputSize bytes, which encode:
+//
+//	C1x || C1y || C2x || C2y || Ax || Ay || T1x || T1y || T2x || T2y || z
+//
+// Each coordinate or scalar is encoded as a big-endian field element, padded
+// to utils.BabyJubJubCurveFieldByteSize bytes.
+//
+// Run performs the following steps:
+//  1. Validates that the input length equals BabyJubJubElGamalIsZeroInputSize.
+//  2. Parses the ciphertext points and verifies they are in the subgroup.
+//  3. Parses the public key and verifies it is in the subgroup.
+//  4. Parses the proof commitments and verifies they are in the subgroup.
+//  5. Parses z and verifies it is smaller than the subgroup order.
+//  6. Computes the Fiat-Shamir challenge and checks both proof equations.
+//  7. Returns []byte{1} if the proof is valid, []byte{0} otherwise.
+//
+// Returns an error if:
+//   - The input length is invalid.
+//   - Any point is not on the curve or not in the subgroup.
+//   - z is greater than or equal to the subgroup order.
+func (c *BabyJubJubElGamalIsZero) Run(input []byte) ([]byte, error) {
+	if len(input) != BabyJubJubElGamalIsZeroInputSize {
+		return nil, ErrorBabyJubJubElGamalInvalidInputLength
+	}
+
+	c1, err1 := utils.ReadAffinePoint(input, 0)
+	c2, err2 := utils.ReadAffinePoint(input, 1)
+
+	if err1 != nil || err2 != nil {
+		return nil, ErrorBabyJubJubElGamalInvalidInputLength
+	}
+
+	if !c1.InSubGroup() || !c2.InSubGroup() {
+		return nil, ErrorBabyJubJubElGamalInvalidCiphertext
+	}
+
+	publicKey, err := utils.ReadAffinePoint(input, 2)
+
+	if err != nil {
+		return nil, ErrorBabyJubJubElGamalInvalidInputLength
+	}
+
+	if !publicKey.InSubGroup() {
+		return nil, ErrorBabyJubJubElGamalInvalidPublicKey
+	}
+
+	t1, err1 := utils.ReadAffinePoint(input, 3)
+	t2, err2 := utils.ReadAffinePoint(input, 4)
+
+	if err1 != nil || err2 != nil {
+		return nil, ErrorBabyJubJubElGamalInvalidInputLength
+	}
+
+	if !t1.InSubGroup() || !t2.InSubGroup() {
+		return nil, ErrorBabyJubJubElGamalInvalidProof
+	}
+
+	z, _ := commonUtils.ReadField(input, 5*utils.BabyJubJubCurveAffinePointSize, utils.BabyJubJubCurveFieldByteSize)
+
+	if z == nil {
+		return nil, ErrorBabyJubJubElGamalInvalidInputLength
+	}
+
+	if !utils.IsValidScalar(z) {
+		return nil, ErrorBabyJubJubElGamalInvalidProof
+	}
+
+	challenge, err := Challenge(publicKey, c1, c2, t1, t2)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if !verifyEquation(z, babyjub.B8, t1, challenge, publicKey) ||
+		!verifyEquation(z, c1, t2, challenge, c2) {
+		return []byte{0}, nil
+	}
+
+	return []byte{1}, nil
+}
+
+// Challenge returns the Fiat-Shamir challenge of the zero-plaintext proof:
+//
+//	poseidon(Ax, Ay, C1x, C1y, C2x, C2y, T1x, T1y, T2x, T2y) mod SubOrder
+//
+// It is exported so that provers can derive the same challenge as the
+// precompile.
+func Challenge(publicKey, c1, c2, t1, t2 *babyjub.Point) (*big.Int, error) {
+	hash, err := poseidon.Hash([]*big.Int{
+		publicKey.X, publicKey.Y,
+		c1.X, c1.Y,
+		c2.X, c2.Y,
+		t1.X, t1.Y,
+		t2.X, t2.Y,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return utils.ReduceScalar(hash), nil
+}
+
+// verifyEquation returns whether z*base == commitment + challenge*point.
+func verifyEquation(z *big.Int, base, commitment *babyjub.Point, challenge *big.Int, point *babyjub.Point) bool {
+	left := babyjub.NewPoint().Mul(z, base)
+	right := babyjub.NewPoint().Projective().Add(
+		commitment.Projective(),
+		babyjub.NewPoint().Mul(challenge, point).Projective(),
+	).Affine()
+
+	return left.X.Cmp(right.X) == 0 && left.Y.Cmp(right.Y) == 0
+}
+
+// Ensure BabyJubJubElGamalIsZero implements the common.Precompile interface.
+var _ common.Precompile = (*BabyJubJubElGamalIsZero)(nil)
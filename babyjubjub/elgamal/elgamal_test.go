@@ -0,0 +1,181 @@
+package elgamal
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/iden3/go-iden3-crypto/babyjub"
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/prop"
+	"github.com/privacy-ethereum/privacy-precompiles/babyjubjub/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBabyJubJubElGamalIsZeroName(t *testing.T) {
+	precompile := BabyJubJubElGamalIsZero{}
+
+	expected := "BabyJubJubElGamalIsZero"
+	actual := precompile.Name()
+
+	assert.Equal(t, expected, actual)
+}
+
+func TestElGamalIsZero(t *testing.T) {
+	secretKey := big.NewInt(123456789)
+	randomness := big.NewInt(987654321)
+	nonce := big.NewInt(55555)
+
+	publicKey := babyjub.NewPoint().Mul(secretKey, babyjub.B8)
+	offCurve := &babyjub.Point{X: big.NewInt(123), Y: big.NewInt(456)}
+
+	tests := []struct {
+		name          string
+		input         []byte
+		expected      []byte
+		expectedError error
+	}{
+		{
+			name:     "zero plaintext with valid proof",
+			input:    prepareInput(secretKey, publicKey, randomness, big.NewInt(0), nonce),
+			expected: []byte{1},
+		},
+		{
+			name:     "nonzero plaintext",
+			input:    prepareInput(secretKey, publicKey, randomness, big.NewInt(7), nonce),
+			expected: []byte{0},
+		},
+		{
+			name:     "proof made with another secret key",
+			input:    prepareInput(big.NewInt(42), publicKey, randomness, big.NewInt(0), nonce),
+			expected: []byte{0},
+		},
+		{
+			name: "tampered response",
+			input: func() []byte {
+				input := prepareInput(secretKey, publicKey, randomness, big.NewInt(0), nonce)
+				input[len(input)-1] ^= 1
+
+				return input
+			}(),
+			expected: []byte{0},
+		},
+		{
+			name: "invalid ciphertext",
+			input: func() []byte {
+				input := prepareInput(secretKey, publicKey, randomness, big.NewInt(0), nonce)
+				copy(input[0:utils.BabyJubJubCurveAffinePointSize], utils.MarshalPoint(offCurve))
+
+				return input
+			}(),
+			expectedError: ErrorBabyJubJubElGamalInvalidCiphertext,
+		},
+		{
+			name: "invalid public key",
+			input: func() []byte {
+				input := prepareInput(secretKey, publicKey, randomness, big.NewInt(0), nonce)
+				copy(input[2*utils.BabyJubJubCurveAffinePointSize:3*utils.BabyJubJubCurveAffinePointSize], utils.MarshalPoint(offCurve))
+
+				return input
+			}(),
+			expectedError: ErrorBabyJubJubElGamalInvalidPublicKey,
+		},
+		{
+			name: "invalid proof commitment",
+			input: func() []byte {
+				input := prepareInput(secretKey, publicKey, randomness, big.NewInt(0), nonce)
+				copy(input[3*utils.BabyJubJubCurveAffinePointSize:4*utils.BabyJubJubCurveAffinePointSize], utils.MarshalPoint(offCurve))
+
+				return input
+			}(),
+			expectedError: ErrorBabyJubJubElGamalInvalidProof,
+		},
+		{
+			name: "response not below suborder",
+			input: func() []byte {
+				input := prepareInput(secretKey, publicKey, randomness, big.NewInt(0), nonce)
+				babyjub.SubOrder.FillBytes(input[5*utils.BabyJubJubCurveAffinePointSize:])
+
+				return input
+			}(),
+			expectedError: ErrorBabyJubJubElGamalInvalidProof,
+		},
+		{
+			name:          "invalid input length",
+			input:         make([]byte, BabyJubJubElGamalIsZeroInputSize-1),
+			expectedError: ErrorBabyJubJubElGamalInvalidInputLength,
+		},
+		{
+			name:          "empty input",
+			input:         []byte{},
+			expectedError: ErrorBabyJubJubElGamalInvalidInputLength,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			precompile := BabyJubJubElGamalIsZero{}
+
+			actual, err := precompile.Run(tt.input)
+			gas := precompile.RequiredGas(tt.input)
+
+			if tt.expectedError != nil {
+				assert.NotNil(t, err)
+				assert.Equal(t, tt.expectedError, err)
+
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, tt.expected, actual)
+			assert.Equal(t, BabyJubJubElGamalIsZeroGas, gas)
+		})
+	}
+}
+
+func TestRunProperties(t *testing.T) {
+	parameters := gopter.DefaultTestParameters()
+	properties := gopter.NewProperties(parameters)
+
+	properties.Property("Run accepts a valid proof for any encryption of zero", prop.ForAll(
+		func(secretKey, randomness, nonce *big.Int) bool {
+			precompile := BabyJubJubElGamalIsZero{}
+			publicKey := babyjub.NewPoint().Mul(secretKey, babyjub.B8)
+
+			result, err := precompile.Run(prepareInput(secretKey, publicKey, randomness, big.NewInt(0), nonce))
+
+			return err == nil && result[0] == 1
+		},
+		utils.ScalarGenerator(),
+		utils.ScalarGenerator(),
+		utils.ScalarGenerator(),
+	))
+
+	properties.TestingRun(t)
+}
+
+// prepareInput encrypts message*B8 under publicKey with the given randomness
+// and proves, using secretKey and nonce, that the plaintext is the identity.
+func prepareInput(secretKey *big.Int, publicKey *babyjub.Point, randomness, message, nonce *big.Int) []byte {
+	c1 := babyjub.NewPoint().Mul(randomness, babyjub.B8)
+	c2 := babyjub.NewPoint().Projective().Add(
+		babyjub.NewPoint().Mul(message, babyjub.B8).Projective(),
+		babyjub.NewPoint().Mul(randomness, publicKey).Projective(),
+	).Affine()
+
+	t1 := babyjub.NewPoint().Mul(nonce, babyjub.B8)
+	t2 := babyjub.NewPoint().Mul(nonce, c1)
+
+	challenge, _ := Challenge(publicKey, c1, c2, t1, t2)
+
+	z := new(big.Int).Mul(challenge, secretKey)
+	z.Add(z, nonce)
+	z.Mod(z, babyjub.SubOrder)
+
+	input := make([]byte, 0, BabyJubJubElGamalIsZeroInputSize)
+
+	for _, point := range []*babyjub.Point{c1, c2, publicKey, t1, t2} {
+		input = append(input, utils.MarshalPoint(point)...)
+	}
+
+	return append(input, z.FillBytes(make([]byte, utils.BabyJubJubCurveFieldByteSize))...)
+}
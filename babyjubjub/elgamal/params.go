@@ -0,0 +1,55 @@
+package elgamal
+
+import (
+	"errors"
+
+	"github.com/privacy-ethereum/privacy-precompiles/babyjubjub/utils"
+)
+
+// BabyJubJub ElGamal precompile constants
+const (
+	// BabyJubJubElGamalIsZeroInputSize defines the fixed byte length of the input
+	// to the BabyJubJub ElGamal zero-plaintext verification precompile.
+	//
+	// The input consists of:
+	//   - Ciphertext points C1 and C2
+	//   - Public key point A
+	//   - Proof commitments T1 and T2
+	//   - Proof response scalar z
+	//
+	// Total layout:
+	//   C1x || C1y || C2x || C2y || Ax || Ay || T1x || T1y || T2x || T2y || z
+	//
+	// Total size:
+	//   11 * utils.BabyJubJubCurveFieldByteSize
+	BabyJubJubElGamalIsZeroInputSize = 11 * utils.BabyJubJubCurveFieldByteSize
+
+	// BabyJubJubElGamalIsZeroGas defines the fixed gas cost for executing the
+	// BabyJubJub ElGamal zero-plaintext verification precompile.
+	//
+	// This cost reflects:
+	//   - Five curve point validations and subgroup checks
+	//   - One Poseidon hash over ten field elements
+	//   - Four scalar multiplications
+	//   - Two curve additions
+	BabyJubJubElGamalIsZeroGas uint64 = 190000
+)
+
+var (
+	// ErrorBabyJubJubElGamalInvalidInputLength is returned when the input
+	// byte slice does not exactly match BabyJubJubElGamalIsZeroInputSize.
+	ErrorBabyJubJubElGamalInvalidInputLength = errors.New("invalid input length")
+
+	// ErrorBabyJubJubElGamalInvalidCiphertext is returned when C1 or C2 is not
+	// a valid point in the BabyJubJub prime-order subgroup.
+	ErrorBabyJubJubElGamalInvalidCiphertext = errors.New("invalid ciphertext")
+
+	// ErrorBabyJubJubElGamalInvalidPublicKey is returned when the public key
+	// is not a valid point in the BabyJubJub prime-order subgroup.
+	ErrorBabyJubJubElGamalInvalidPublicKey = errors.New("invalid public key")
+
+	// ErrorBabyJubJubElGamalInvalidProof is returned when the proof
+	// commitments are not valid subgroup points or the response scalar is
+	// greater than or equal to the subgroup order.
+	ErrorBabyJubJubElGamalInvalidProof = errors.New("invalid proof")
+)
@@ -0,0 +1,93 @@
+package equal
+
+import (
+	"crypto/subtle"
+	"math/big"
+
+	"github.com/iden3/go-iden3-crypto/babyjub"
+	"github.com/privacy-ethereum/privacy-precompiles/babyjubjub/utils"
+	"github.com/privacy-ethereum/privacy-precompiles/common"
+)
+
+// BabyJubJubCurveEqual implements the BabyJubJub point equality precompile.
+//
+// It satisfies the common.Precompile interface and can be used in a generic
+// precompile execution framework.
+type BabyJubJubCurveEqual struct{}
+
+// Name returns the human-readable name of the precompile.
+func (c *BabyJubJubCurveEqual) Name() string {
+	return "BabyJubJubCurveEqual"
+}
+
+// RequiredGas returns the fixed gas cost of executing this precompile.
+//
+// For BabyJubJub point equality, the gas cost is BabyJubJubCurveEqualGas.
+func (c *BabyJubJubCurveEqual) RequiredGas(input []byte) uint64 {
+	return BabyJubJubCurveEqualGas
+}
+
+// Run executes the BabyJubJub point equality precompile.
+//
+// The input must be exactly BabyJubJubCurveEqualInputSize bytes, which encode
+// two affine points in the format:
+//
+//	x1 || y1 || x2 || y2
+//
+// Each coordinate is a big-endian integer padded to BabyJubJubFieldByteSize
+// bytes. Coordinates do not need to be reduced modulo utils.FieldPrime, so
+// the same curve point may have several encodings.
+//
+// Run performs the following steps:
+//  1. Parses the two points from input using utils.ReadAffinePoint.
+//  2. Validates that both points lie on the BabyJubJub curve.
+//  3. Validates that both points lie in the correct subgroup.
+//  4. Reduces every coordinate modulo utils.FieldPrime.
+//  5. Compares the reduced coordinates in constant time.
+//
+// Return value:
+//   - []byte{1} if both encodings represent the same curve point.
+//   - []byte{0} otherwise.
+//
+// Returns an error if:
+//   - The input length is incorrect.
+//   - Any point is not on the curve (ErrorBabyJubJubCurvePointNotOnCurve).
+//   - Any point is not in the subgroup (ErrorBabyJubJubCurvePointNotInSubgroup).
+func (c *BabyJubJubCurveEqual) Run(input []byte) ([]byte, error) {
+	if len(input) != BabyJubJubCurveEqualInputSize {
+		return nil, utils.ErrorBabyJubJubCurveInvalidInputLength
+	}
+
+	point1, err1 := utils.ReadAffinePoint(input, 0)
+	point2, err2 := utils.ReadAffinePoint(input, 1)
+
+	if err1 != nil || err2 != nil {
+		return nil, utils.ErrorBabyJubJubCurveInvalidInputLength
+	}
+
+	if !point1.InCurve() || !point2.InCurve() {
+		return nil, utils.ErrorBabyJubJubCurvePointNotOnCurve
+	}
+
+	if !point1.InSubGroup() || !point2.InSubGroup() {
+		return nil, utils.ErrorBabyJubJubCurvePointNotInSubgroup
+	}
+
+	if subtle.ConstantTimeCompare(canonicalEncoding(point1), canonicalEncoding(point2)) != 1 {
+		return []byte{0}, nil
+	}
+
+	return []byte{1}, nil
+}
+
+// canonicalEncoding returns the X || Y encoding of point with both
+// coordinates reduced modulo utils.FieldPrime.
+func canonicalEncoding(point *babyjub.Point) []byte {
+	return utils.MarshalPoint(&babyjub.Point{
+		X: new(big.Int).Mod(point.X, utils.FieldPrime),
+		Y: new(big.Int).Mod(point.Y, utils.FieldPrime),
+	})
+}
+
+// Ensure BabyJubJubCurveEqual implements the common.Precompile interface.
+var _ common.Precompile = (*BabyJubJubCurveEqual)(nil)
@@ -0,0 +1,160 @@
+package equal
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/iden3/go-iden3-crypto/babyjub"
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/prop"
+	"github.com/privacy-ethereum/privacy-precompiles/babyjubjub/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBabyJubJubCurveEqualName(t *testing.T) {
+	precompile := BabyJubJubCurveEqual{}
+
+	expected := "BabyJubJubCurveEqual"
+	actual := precompile.Name()
+
+	assert.Equal(t, expected, actual)
+}
+
+func TestBabyJubJubCurveEqual(t *testing.T) {
+	point := babyjub.NewPoint().Mul(big.NewInt(1234), babyjub.B8)
+	unreduced := &babyjub.Point{
+		X: new(big.Int).Add(point.X, utils.FieldPrime),
+		Y: new(big.Int).Add(point.Y, utils.FieldPrime),
+	}
+
+	tests := []struct {
+		name          string
+		input         []byte
+		expected      []byte
+		expectedError error
+	}{
+		{
+			name:     "same encoding",
+			input:    append(utils.MarshalPoint(point), utils.MarshalPoint(point)...),
+			expected: []byte{1},
+		},
+		{
+			name:     "reduced and unreduced encodings",
+			input:    append(utils.MarshalPoint(point), utils.MarshalPoint(unreduced)...),
+			expected: []byte{1},
+		},
+		{
+			name: "only X unreduced",
+			input: append(
+				utils.MarshalPoint(&babyjub.Point{X: unreduced.X, Y: point.Y}),
+				utils.MarshalPoint(point)...,
+			),
+			expected: []byte{1},
+		},
+		{
+			name:     "different points",
+			input:    append(utils.MarshalPoint(point), utils.MarshalPoint(babyjub.B8)...),
+			expected: []byte{0},
+		},
+		{
+			name: "point and its negation",
+			input: append(
+				utils.MarshalPoint(point),
+				utils.MarshalPoint(&babyjub.Point{X: new(big.Int).Sub(utils.FieldPrime, point.X), Y: point.Y})...,
+			),
+			expected: []byte{0},
+		},
+		{
+			name:     "identity",
+			input:    append(utils.MarshalPoint(babyjub.NewPoint()), utils.MarshalPoint(babyjub.NewPoint())...),
+			expected: []byte{1},
+		},
+		{
+			name: "point is not on curve",
+			input: append(
+				utils.MarshalPoint(point),
+				utils.MarshalPoint(&babyjub.Point{X: big.NewInt(123), Y: big.NewInt(456)})...,
+			),
+			expectedError: utils.ErrorBabyJubJubCurvePointNotOnCurve,
+		},
+		{
+			name: "point is not in subgroup",
+			input: append(
+				utils.MarshalPoint(&babyjub.Point{
+					X: big.NewInt(0),
+					Y: new(big.Int).Sub(utils.FieldPrime, big.NewInt(1)), // p - 1 == -1 mod p
+				}),
+				utils.MarshalPoint(point)...,
+			),
+			expectedError: utils.ErrorBabyJubJubCurvePointNotInSubgroup,
+		},
+		{
+			name:          "invalid input length",
+			input:         make([]byte, BabyJubJubCurveEqualInputSize-1),
+			expectedError: utils.ErrorBabyJubJubCurveInvalidInputLength,
+		},
+		{
+			name:          "empty input",
+			input:         []byte{},
+			expectedError: utils.ErrorBabyJubJubCurveInvalidInputLength,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			precompile := BabyJubJubCurveEqual{}
+
+			actual, err := precompile.Run(tt.input)
+			gas := precompile.RequiredGas(tt.input)
+
+			if tt.expectedError != nil {
+				assert.NotNil(t, err)
+				assert.Equal(t, tt.expectedError, err)
+
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, tt.expected, actual)
+			assert.Equal(t, BabyJubJubCurveEqualGas, gas)
+		})
+	}
+}
+
+func TestBabyJubJubCurveEqualProperties(t *testing.T) {
+	parameters := gopter.DefaultTestParameters()
+	properties := gopter.NewProperties(parameters)
+
+	properties.Property("Run accepts the unreduced encoding of a point", prop.ForAll(
+		func(point *babyjub.Point) bool {
+			precompile := BabyJubJubCurveEqual{}
+
+			unreduced := &babyjub.Point{
+				X: new(big.Int).Add(point.X, utils.FieldPrime),
+				Y: new(big.Int).Add(point.Y, utils.FieldPrime),
+			}
+
+			result, err := precompile.Run(append(utils.MarshalPoint(unreduced), utils.MarshalPoint(point)...))
+
+			return err == nil && result[0] == 1
+		},
+		utils.BabyJubJubPointGenerator(),
+	))
+
+	properties.Property("Run distinguishes a point from its double", prop.ForAll(
+		func(point *babyjub.Point) bool {
+			precompile := BabyJubJubCurveEqual{}
+
+			double := babyjub.NewPoint().Mul(big.NewInt(2), point)
+			result, err := precompile.Run(append(utils.MarshalPoint(point), utils.MarshalPoint(double)...))
+
+			return err == nil && result[0] == 0
+		},
+		utils.BabyJubJubPointGenerator().SuchThat(func(point *babyjub.Point) bool {
+			// The identity is its own double.
+			return point.X.Sign() != 0
+		}),
+	))
+
+	properties.TestingRun(t)
+}
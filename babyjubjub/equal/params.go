@@ -0,0 +1,21 @@
+package equal
+
+import (
+	"github.com/privacy-ethereum/privacy-precompiles/babyjubjub/utils"
+	"github.com/privacy-ethereum/privacy-precompiles/babyjubjub/validation"
+)
+
+// BabyJubJub point equality precompile constants
+const (
+	// BabyJubJubCurveEqualInputSize defines the fixed byte length of the input
+	// to the BabyJubJub point equality precompile. The input consists of two
+	// affine points serialized as X || Y || X || Y.
+	BabyJubJubCurveEqualInputSize = 2 * utils.BabyJubJubCurveAffinePointSize
+
+	// BabyJubJubCurveEqualGas is the gas cost estimate for executing the
+	// BabyJubJub point equality precompile in Ethereum.
+	//
+	// It is dominated by the curve and subgroup checks of both points; the
+	// comparison itself is negligible.
+	BabyJubJubCurveEqualGas = 2 * validation.BabyJubJubCurveValidatePointGas
+)
@@ -0,0 +1,118 @@
+package hashtopoint
+
+import (
+	"math/big"
+
+	"github.com/iden3/go-iden3-crypto/babyjub"
+	"github.com/iden3/go-iden3-crypto/poseidon"
+	"github.com/privacy-ethereum/privacy-precompiles/babyjubjub/utils"
+	"github.com/privacy-ethereum/privacy-precompiles/common"
+)
+
+// cofactor is the BabyJubJub cofactor: the curve order is 8 * SubOrder.
+var cofactor = big.NewInt(8)
+
+// BabyJubJubHashToPoint implements the BabyJubJub hash-to-point precompile.
+//
+// It deterministically maps a field element to a point of the prime-order
+// subgroup, e.g. to derive verifiable random points such as VRF nonces.
+type BabyJubJubHashToPoint struct{}
+
+// Name returns the human-readable name of the precompile.
+func (c *BabyJubJubHashToPoint) Name() string {
+	return "BabyJubJubHashToPoint"
+}
+
+// RequiredGas returns the fixed gas cost of executing this precompile.
+//
+// For BabyJubJub hash-to-point, the gas cost is BabyJubJubHashToPointGas,
+// which prices the expected number of attempts rather than the worst case.
+func (c *BabyJubJubHashToPoint) RequiredGas(input []byte) uint64 {
+	return BabyJubJubHashToPointGas
+}
+
+// MaxOutputSize returns the byte length of the affine point returned by Run,
+// which is BabyJubJubHashToPointOutputSize regardless of the input.
+func (c *BabyJubJubHashToPoint) MaxOutputSize(input []byte) int {
+	return BabyJubJubHashToPointOutputSize
+}
+
+// Run executes the BabyJubJub hash-to-point precompile.
+//
+// The input must be exactly BabyJubJubHashToPointInputSize bytes, which
+// encode a field element m as a big-endian integer lower than
+// utils.FieldPrime.
+//
+// The point is computed by HashToPoint and returned serialized with
+// utils.MarshalPoint.
+//
+// Returns an error if:
+//   - The input length is incorrect.
+//   - m is not a field element (ErrorBabyJubJubHashToPointInvalidFieldElement).
+//   - No point is found (ErrorBabyJubJubHashToPointNoPoint).
+func (c *BabyJubJubHashToPoint) Run(input []byte) ([]byte, error) {
+	if len(input) != BabyJubJubHashToPointInputSize {
+		return nil, utils.ErrorBabyJubJubCurveInvalidInputLength
+	}
+
+	m, _ := utils.ReadField(input, 0)
+
+	point, err := HashToPoint(m)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return utils.MarshalPoint(point), nil
+}
+
+// HashToPoint maps the field element m to a point of the BabyJubJub
+// prime-order subgroup using Poseidon-based try-and-increment.
+//
+// For counter = 0, 1, ..., BabyJubJubHashToPointMaxAttempts - 1:
+//  1. Compute y = poseidon(m, counter).
+//  2. Recover the point with Y coordinate y and non-negative X, as
+//     babyjub.PointFromSignAndY does; skip the counter if there is none.
+//  3. Multiply the point by the cofactor 8, which maps it into the
+//     prime-order subgroup.
+//  4. Skip the counter if the result is the identity, otherwise return it.
+//
+// It is exported so that off-chain code can derive the same points as the
+// precompile. Returns ErrorBabyJubJubHashToPointInvalidFieldElement if m is
+// not lower than utils.FieldPrime and ErrorBabyJubJubHashToPointNoPoint if
+// every attempt fails.
+func HashToPoint(m *big.Int) (*babyjub.Point, error) {
+	if m.Sign() < 0 || m.Cmp(utils.FieldPrime) >= 0 {
+		return nil, ErrorBabyJubJubHashToPointInvalidFieldElement
+	}
+
+	for counter := range BabyJubJubHashToPointMaxAttempts {
+		y, err := poseidon.Hash([]*big.Int{m, big.NewInt(int64(counter))})
+
+		if err != nil {
+			return nil, err
+		}
+
+		candidate, err := babyjub.PointFromSignAndY(false, y)
+
+		if err != nil {
+			continue
+		}
+
+		point := babyjub.NewPoint().Mul(cofactor, candidate)
+
+		if utils.IsIdentity(point) {
+			continue
+		}
+
+		return point, nil
+	}
+
+	return nil, ErrorBabyJubJubHashToPointNoPoint
+}
+
+// Ensure BabyJubJubHashToPoint implements the common.Precompile interface.
+var _ common.Precompile = (*BabyJubJubHashToPoint)(nil)
+
+// Ensure BabyJubJubHashToPoint implements the common.OutputSizer interface.
+var _ common.OutputSizer = (*BabyJubJubHashToPoint)(nil)
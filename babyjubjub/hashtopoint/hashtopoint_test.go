@@ -0,0 +1,181 @@
+package hashtopoint
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	"github.com/iden3/go-iden3-crypto/babyjub"
+	"github.com/iden3/go-iden3-crypto/poseidon"
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/prop"
+	"github.com/privacy-ethereum/privacy-precompiles/babyjubjub/utils"
+	"github.com/privacy-ethereum/privacy-precompiles/babyjubjub/validation"
+	"github.com/privacy-ethereum/privacy-precompiles/common"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBabyJubJubHashToPointName(t *testing.T) {
+	precompile := BabyJubJubHashToPoint{}
+
+	expected := "BabyJubJubHashToPoint"
+	actual := precompile.Name()
+
+	assert.Equal(t, expected, actual)
+}
+
+func TestBabyJubJubHashToPointMaxOutputSize(t *testing.T) {
+	precompile := BabyJubJubHashToPoint{}
+
+	var sizer common.OutputSizer = &precompile
+
+	assert.Equal(t, utils.BabyJubJubCurveAffinePointSize, sizer.MaxOutputSize(nil))
+}
+
+func TestBabyJubJubHashToPoint(t *testing.T) {
+	expected := func(x, y string) *babyjub.Point {
+		point := &babyjub.Point{X: new(big.Int), Y: new(big.Int)}
+		point.X.SetString(x, 10)
+		point.Y.SetString(y, 10)
+
+		return point
+	}
+
+	tests := []struct {
+		name          string
+		input         []byte
+		expected      *babyjub.Point
+		expectedError error
+	}{
+		{
+			name:  "known answer",
+			input: big.NewInt(42).FillBytes(make([]byte, BabyJubJubHashToPointInputSize)),
+			expected: expected(
+				"11042189841224179908320918981662875190423681715946001339505338568673512844821",
+				"15548942656028256673899344483314466902317651790678601452727951579172327613754",
+			),
+		},
+		{
+			name:     "first counter without a point",
+			input:    firstCounterFails(t).FillBytes(make([]byte, BabyJubJubHashToPointInputSize)),
+			expected: secondCounterPoint(firstCounterFails(t)),
+		},
+		{
+			name:          "field prime",
+			input:         utils.FieldPrime.FillBytes(make([]byte, BabyJubJubHashToPointInputSize)),
+			expectedError: ErrorBabyJubJubHashToPointInvalidFieldElement,
+		},
+		{
+			name:          "invalid input length",
+			input:         make([]byte, BabyJubJubHashToPointInputSize+1),
+			expectedError: utils.ErrorBabyJubJubCurveInvalidInputLength,
+		},
+		{
+			name:          "empty input",
+			input:         []byte{},
+			expectedError: utils.ErrorBabyJubJubCurveInvalidInputLength,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			precompile := BabyJubJubHashToPoint{}
+
+			actual, err := precompile.Run(tt.input)
+			gas := precompile.RequiredGas(tt.input)
+
+			if tt.expectedError != nil {
+				assert.NotNil(t, err)
+				assert.Equal(t, tt.expectedError, err)
+
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, BabyJubJubHashToPointGas, gas)
+			assert.Equal(t, utils.MarshalPoint(tt.expected), actual)
+		})
+	}
+}
+
+func TestBabyJubJubHashToPointProperties(t *testing.T) {
+	parameters := gopter.DefaultTestParameters()
+	properties := gopter.NewProperties(parameters)
+
+	properties.Property("Run outputs points accepted by BabyJubJubCurveValidatePoint", prop.ForAll(
+		func(m *big.Int) bool {
+			precompile := BabyJubJubHashToPoint{}
+			validator := validation.BabyJubJubCurveValidatePoint{}
+
+			point, err := precompile.Run(m.FillBytes(make([]byte, BabyJubJubHashToPointInputSize)))
+
+			if err != nil {
+				return false
+			}
+
+			valid, err := validator.Run(point)
+
+			return err == nil && bytes.Equal(valid, []byte{1}) && !bytes.Equal(point, utils.MarshalPoint(babyjub.NewPoint()))
+		},
+		utils.ScalarGenerator(),
+	))
+
+	properties.Property("Run is deterministic", prop.ForAll(
+		func(m *big.Int) bool {
+			precompile := BabyJubJubHashToPoint{}
+			input := m.FillBytes(make([]byte, BabyJubJubHashToPointInputSize))
+
+			first, err := precompile.Run(input)
+
+			if err != nil {
+				return false
+			}
+
+			second, err := precompile.Run(input)
+
+			return err == nil && bytes.Equal(first, second)
+		},
+		utils.ScalarGenerator(),
+	))
+
+	properties.Property("Run maps distinct inputs to distinct points", prop.ForAll(
+		func(m *big.Int) bool {
+			precompile := BabyJubJubHashToPoint{}
+			next := new(big.Int).Add(m, big.NewInt(1))
+
+			first, err := precompile.Run(m.FillBytes(make([]byte, BabyJubJubHashToPointInputSize)))
+
+			if err != nil {
+				return false
+			}
+
+			second, err := precompile.Run(next.FillBytes(make([]byte, BabyJubJubHashToPointInputSize)))
+
+			return err == nil && !bytes.Equal(first, second)
+		},
+		utils.ScalarGenerator(),
+	))
+
+	properties.TestingRun(t)
+}
+
+// firstCounterFails returns the smallest field element m for which
+// poseidon(m, 0) is not the Y coordinate of a curve point.
+func firstCounterFails(t *testing.T) *big.Int {
+	for m := int64(0); ; m++ {
+		y, err := poseidon.Hash([]*big.Int{big.NewInt(m), big.NewInt(0)})
+		assert.Nil(t, err)
+
+		if _, err := babyjub.PointFromSignAndY(false, y); err != nil {
+			return big.NewInt(m)
+		}
+	}
+}
+
+// secondCounterPoint returns the point HashToPoint derives from counter 1.
+func secondCounterPoint(m *big.Int) *babyjub.Point {
+	y, _ := poseidon.Hash([]*big.Int{m, big.NewInt(1)})
+	point, _ := babyjub.PointFromSignAndY(false, y)
+
+	return babyjub.NewPoint().Mul(cofactor, point)
+}
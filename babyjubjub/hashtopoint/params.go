@@ -0,0 +1,59 @@
+package hashtopoint
+
+import (
+	"errors"
+
+	"github.com/privacy-ethereum/privacy-precompiles/babyjubjub/mul"
+	"github.com/privacy-ethereum/privacy-precompiles/babyjubjub/utils"
+	"github.com/privacy-ethereum/privacy-precompiles/babyjubjub/validation"
+	"github.com/privacy-ethereum/privacy-precompiles/poseidon"
+)
+
+// BabyJubJub hash-to-point precompile constants
+const (
+	// BabyJubJubHashToPointInputSize defines the fixed byte length of the
+	// input to the BabyJubJub hash-to-point precompile. The input is a single
+	// big-endian field element.
+	BabyJubJubHashToPointInputSize = utils.BabyJubJubCurveFieldByteSize
+
+	// BabyJubJubHashToPointOutputSize defines the fixed byte length of the
+	// output of the BabyJubJub hash-to-point precompile.
+	//
+	// The output is a single affine point serialized as:
+	//   X || Y
+	BabyJubJubHashToPointOutputSize = utils.BabyJubJubCurveAffinePointSize
+
+	// BabyJubJubHashToPointMaxAttempts defines the number of counters tried
+	// before HashToPoint gives up. Each attempt succeeds with probability
+	// about 1/2, so exhausting them is practically impossible.
+	BabyJubJubHashToPointMaxAttempts = 64
+
+	// BabyJubJubHashToPointExpectedAttempts defines the expected number of
+	// attempts HashToPoint needs to find a point.
+	BabyJubJubHashToPointExpectedAttempts = 2
+
+	// BabyJubJubHashToPointAttemptGas defines the gas cost of one attempt:
+	// a Poseidon hash over two field elements followed by the modular
+	// square root used to recover X.
+	BabyJubJubHashToPointAttemptGas = poseidon.PoseidonBaseGas + 2*poseidon.PoseidonPerWordGas +
+		(mul.BabyJubJubCurveMulCompressedGas - mul.BabyJubJubCurveMulGas)
+
+	// BabyJubJubHashToPointGas defines the fixed gas cost of the BabyJubJub
+	// hash-to-point precompile.
+	//
+	// This cost reflects:
+	//   - BabyJubJubHashToPointExpectedAttempts attempts
+	//   - Cofactor clearing and the identity check of the result
+	BabyJubJubHashToPointGas = BabyJubJubHashToPointExpectedAttempts*BabyJubJubHashToPointAttemptGas +
+		validation.BabyJubJubCurveValidatePointGas
+)
+
+var (
+	// ErrorBabyJubJubHashToPointInvalidFieldElement is returned when the
+	// input is not lower than utils.FieldPrime.
+	ErrorBabyJubJubHashToPointInvalidFieldElement = errors.New("invalid field element")
+
+	// ErrorBabyJubJubHashToPointNoPoint is returned when none of the
+	// BabyJubJubHashToPointMaxAttempts attempts yields a subgroup point.
+	ErrorBabyJubJubHashToPointNoPoint = errors.New("no point found")
+)
@@ -0,0 +1,147 @@
+package liabilities
+
+import (
+	"math/big"
+
+	"github.com/iden3/go-iden3-crypto/babyjub"
+	"github.com/privacy-ethereum/privacy-precompiles/babyjubjub/utils"
+	"github.com/privacy-ethereum/privacy-precompiles/common"
+	"github.com/privacy-ethereum/privacy-precompiles/poseidon/merkle"
+	commonUtils "github.com/privacy-ethereum/privacy-precompiles/utils"
+)
+
+// BabyJubJubProofOfLiabilities implements a proof of liabilities precompile
+// over a Poseidon Merkle tree of BabyJubJub balance commitments.
+//
+// It satisfies the common.Precompile interface and can be used in a generic
+// precompile execution framework, e.g. as the liabilities half of an
+// exchange proof-of-reserves.
+//
+// Each leaf is an additively homomorphic commitment C_i to a user balance
+// (e.g. a Pedersen commitment v_i*G + r_i*H) and is inserted in the tree as
+// merkle.PointLeaf(C_i). The proof consists of the full list of leaf
+// commitments: the precompile checks that they hash to the published root and
+// that their sum equals the claimed total commitment T:
+//
+//	C_0 + C_1 + ... + C_{n-1} == T
+//
+// Since the tree size is bounded, the proof is linear in the number of users.
+type BabyJubJubProofOfLiabilities struct{}
+
+// Name returns the human-readable name of the precompile.
+func (c *BabyJubJubProofOfLiabilities) Name() string {
+	return "BabyJubJubProofOfLiabilities"
+}
+
+// RequiredGas returns the gas cost of executing this precompile.
+//
+// Gas is calculated as:
+//
+//	BabyJubJubProofOfLiabilitiesBaseGas + (number_of_leaves * BabyJubJubProofOfLiabilitiesPerLeafGas)
+func (c *BabyJubJubProofOfLiabilities) RequiredGas(input []byte) uint64 {
+	if len(input) < BabyJubJubProofOfLiabilitiesHeaderSize {
+		return BabyJubJubProofOfLiabilitiesBaseGas
+	}
+
+	numberOfLeaves := (len(input) - BabyJubJubProofOfLiabilitiesHeaderSize) / utils.BabyJubJubCurveAffinePointSize
+
+	return BabyJubJubProofOfLiabilitiesBaseGas + uint64(numberOfLeaves)*BabyJubJubProofOfLiabilitiesPerLeafGas
+}
+
+// Run executes the proof of liabilities precompile.
+//
+// The input is encoded as:
+//
+//	root || Tx || Ty || C_0x || C_0y || ... || C_{n-1}x || C_{n-1}y
+//
+// Where:
+//   - root is the liabilities Merkle root.
+//   - (Tx, Ty) is the claimed total commitment.
+//   - (C_ix, C_iy) is the balance commitment of leaf i.
+//   - n is a power of two and 1 <= n <= BabyJubJubProofOfLiabilitiesMaxLeaves.
+//
+// Each element is a big-endian field element padded to
+// utils.BabyJubJubCurveFieldByteSize bytes.
+//
+// Run performs the following steps:
+//  1. Validates the input length and number of leaves.
+//  2. Validates that the total commitment is in the subgroup.
+//  3. Validates that each leaf commitment is in the subgroup, hashes it
+//     and accumulates it into the running sum.
+//  4. Computes the Merkle root over the leaf hashes.
+//  5. Returns []byte{1} if the root matches and the sum equals the total,
+//     []byte{0} otherwise.
+//
+// Returns an error if:
+//   - The input length is invalid.
+//   - The total or any leaf commitment is not on the curve or not in the subgroup.
+func (c *BabyJubJubProofOfLiabilities) Run(input []byte) ([]byte, error) {
+	if len(input) < BabyJubJubProofOfLiabilitiesHeaderSize ||
+		(len(input)-BabyJubJubProofOfLiabilitiesHeaderSize)%utils.BabyJubJubCurveAffinePointSize != 0 {
+		return nil, ErrorBabyJubJubProofOfLiabilitiesInvalidInputLength
+	}
+
+	numberOfLeaves := (len(input) - BabyJubJubProofOfLiabilitiesHeaderSize) / utils.BabyJubJubCurveAffinePointSize
+
+	if numberOfLeaves == 0 ||
+		numberOfLeaves > BabyJubJubProofOfLiabilitiesMaxLeaves ||
+		numberOfLeaves&(numberOfLeaves-1) != 0 {
+		return nil, ErrorBabyJubJubProofOfLiabilitiesInvalidInputLength
+	}
+
+	root, offset := commonUtils.ReadField(input, 0, utils.BabyJubJubCurveFieldByteSize)
+	commitments, _ := commonUtils.SafeSlice(input, offset, len(input))
+
+	total, err := utils.ReadAffinePoint(commitments, 0)
+
+	if err != nil {
+		return nil, ErrorBabyJubJubProofOfLiabilitiesInvalidInputLength
+	}
+
+	if !total.InSubGroup() {
+		return nil, ErrorBabyJubJubProofOfLiabilitiesInvalidTotal
+	}
+
+	leaves := make([]*big.Int, numberOfLeaves)
+	sum := babyjub.NewPoint().Projective()
+
+	for index := range numberOfLeaves {
+		commitment, err := utils.ReadAffinePoint(commitments, index+1)
+
+		if err != nil {
+			return nil, ErrorBabyJubJubProofOfLiabilitiesInvalidInputLength
+		}
+
+		if !commitment.InSubGroup() {
+			return nil, ErrorBabyJubJubProofOfLiabilitiesInvalidLeaf
+		}
+
+		leaf, err := merkle.PointLeaf(commitment)
+
+		if err != nil {
+			return nil, err
+		}
+
+		leaves[index] = leaf
+		sum.Add(sum, commitment.Projective())
+	}
+
+	computedRoot, err := merkle.ComputeRoot(leaves)
+
+	if err != nil {
+		return nil, err
+	}
+
+	computedTotal := sum.Affine()
+
+	if computedRoot.Cmp(root) != 0 ||
+		computedTotal.X.Cmp(total.X) != 0 ||
+		computedTotal.Y.Cmp(total.Y) != 0 {
+		return []byte{0}, nil
+	}
+
+	return []byte{1}, nil
+}
+
+// Ensure BabyJubJubProofOfLiabilities implements the common.Precompile interface.
+var _ common.Precompile = (*BabyJubJubProofOfLiabilities)(nil)
@@ -0,0 +1,197 @@
+package liabilities
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/iden3/go-iden3-crypto/babyjub"
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/gen"
+	"github.com/leanovate/gopter/prop"
+	"github.com/privacy-ethereum/privacy-precompiles/babyjubjub/utils"
+	"github.com/privacy-ethereum/privacy-precompiles/poseidon/merkle"
+	"github.com/stretchr/testify/assert"
+)
+
+// blindingBase is the second generator used for Pedersen commitments in tests.
+var blindingBase = babyjub.NewPoint().Mul(big.NewInt(7919), babyjub.B8)
+
+func TestBabyJubJubProofOfLiabilitiesName(t *testing.T) {
+	precompile := BabyJubJubProofOfLiabilities{}
+
+	expected := "BabyJubJubProofOfLiabilities"
+	actual := precompile.Name()
+
+	assert.Equal(t, expected, actual)
+}
+
+func TestProofOfLiabilities(t *testing.T) {
+	balances := []int64{100, 250, 0, 75}
+	commitments := make([]*babyjub.Point, len(balances))
+
+	for index, balance := range balances {
+		commitments[index] = commit(big.NewInt(balance), big.NewInt(int64(index+11)))
+	}
+
+	root, total := buildLiabilities(commitments)
+	inflated := babyjub.NewPoint().Projective().Add(total.Projective(), babyjub.B8.Projective()).Affine()
+	offCurve := &babyjub.Point{X: big.NewInt(123), Y: big.NewInt(456)}
+
+	tests := []struct {
+		name          string
+		input         []byte
+		expected      []byte
+		expectedGas   uint64
+		expectedError error
+	}{
+		{
+			name:        "correct total",
+			input:       prepareInput(root, total, commitments),
+			expected:    []byte{1},
+			expectedGas: BabyJubJubProofOfLiabilitiesBaseGas + 4*BabyJubJubProofOfLiabilitiesPerLeafGas,
+		},
+		{
+			name:        "inflated total",
+			input:       prepareInput(root, inflated, commitments),
+			expected:    []byte{0},
+			expectedGas: BabyJubJubProofOfLiabilitiesBaseGas + 4*BabyJubJubProofOfLiabilitiesPerLeafGas,
+		},
+		{
+			name:        "wrong root",
+			input:       prepareInput(new(big.Int).Add(root, big.NewInt(1)), total, commitments),
+			expected:    []byte{0},
+			expectedGas: BabyJubJubProofOfLiabilitiesBaseGas + 4*BabyJubJubProofOfLiabilitiesPerLeafGas,
+		},
+		{
+			name: "leaves reordered",
+			input: prepareInput(root, total, []*babyjub.Point{
+				commitments[1], commitments[0], commitments[2], commitments[3],
+			}),
+			expected:    []byte{0},
+			expectedGas: BabyJubJubProofOfLiabilitiesBaseGas + 4*BabyJubJubProofOfLiabilitiesPerLeafGas,
+		},
+		{
+			name:          "invalid total commitment",
+			input:         prepareInput(root, offCurve, commitments),
+			expectedError: ErrorBabyJubJubProofOfLiabilitiesInvalidTotal,
+		},
+		{
+			name: "invalid leaf commitment",
+			input: prepareInput(root, total, []*babyjub.Point{
+				commitments[0], commitments[1], offCurve, commitments[3],
+			}),
+			expectedError: ErrorBabyJubJubProofOfLiabilitiesInvalidLeaf,
+		},
+		{
+			name:          "number of leaves not a power of two",
+			input:         prepareInput(root, total, commitments[:3]),
+			expectedError: ErrorBabyJubJubProofOfLiabilitiesInvalidInputLength,
+		},
+		{
+			name:          "no leaves",
+			input:         prepareInput(root, total, nil),
+			expectedError: ErrorBabyJubJubProofOfLiabilitiesInvalidInputLength,
+		},
+		{
+			name: "too many leaves",
+			input: func() []byte {
+				leaves := make([]*babyjub.Point, 2*BabyJubJubProofOfLiabilitiesMaxLeaves)
+
+				for index := range leaves {
+					leaves[index] = babyjub.NewPoint()
+				}
+
+				return prepareInput(root, total, leaves)
+			}(),
+			expectedError: ErrorBabyJubJubProofOfLiabilitiesInvalidInputLength,
+		},
+		{
+			name:          "unaligned input",
+			input:         prepareInput(root, total, commitments)[1:],
+			expectedError: ErrorBabyJubJubProofOfLiabilitiesInvalidInputLength,
+		},
+		{
+			name:          "empty input",
+			input:         []byte{},
+			expectedError: ErrorBabyJubJubProofOfLiabilitiesInvalidInputLength,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			precompile := BabyJubJubProofOfLiabilities{}
+
+			actual, err := precompile.Run(tt.input)
+			gas := precompile.RequiredGas(tt.input)
+
+			if tt.expectedError != nil {
+				assert.NotNil(t, err)
+				assert.Equal(t, tt.expectedError, err)
+
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, tt.expected, actual)
+			assert.Equal(t, tt.expectedGas, gas)
+		})
+	}
+}
+
+func TestRunProperties(t *testing.T) {
+	parameters := gopter.DefaultTestParameters()
+	properties := gopter.NewProperties(parameters)
+
+	properties.Property("Run accepts the honest total of any liabilities tree", prop.ForAll(
+		func(balances []uint32, blindings []*big.Int) bool {
+			commitments := make([]*babyjub.Point, len(balances))
+
+			for index, balance := range balances {
+				commitments[index] = commit(big.NewInt(int64(balance)), blindings[index])
+			}
+
+			root, total := buildLiabilities(commitments)
+
+			precompile := BabyJubJubProofOfLiabilities{}
+			result, err := precompile.Run(prepareInput(root, total, commitments))
+
+			return err == nil && result[0] == 1
+		},
+		gen.SliceOfN(8, gen.UInt32()),
+		gen.SliceOfN(8, utils.ScalarGenerator()),
+	))
+
+	properties.TestingRun(t)
+}
+
+func commit(value, blinding *big.Int) *babyjub.Point {
+	return babyjub.NewPoint().Projective().Add(
+		babyjub.NewPoint().Mul(value, babyjub.B8).Projective(),
+		babyjub.NewPoint().Mul(blinding, blindingBase).Projective(),
+	).Affine()
+}
+
+func buildLiabilities(commitments []*babyjub.Point) (*big.Int, *babyjub.Point) {
+	leaves := make([]*big.Int, len(commitments))
+	sum := babyjub.NewPoint().Projective()
+
+	for index, commitment := range commitments {
+		leaves[index], _ = merkle.PointLeaf(commitment)
+		sum.Add(sum, commitment.Projective())
+	}
+
+	root, _ := merkle.ComputeRoot(leaves)
+
+	return root, sum.Affine()
+}
+
+func prepareInput(root *big.Int, total *babyjub.Point, commitments []*babyjub.Point) []byte {
+	input := root.FillBytes(make([]byte, utils.BabyJubJubCurveFieldByteSize))
+	input = append(input, utils.MarshalPoint(total)...)
+
+	for _, commitment := range commitments {
+		input = append(input, utils.MarshalPoint(commitment)...)
+	}
+
+	return input
+}
@@ -0,0 +1,54 @@
+package liabilities
+
+import (
+	"errors"
+
+	"github.com/privacy-ethereum/privacy-precompiles/babyjubjub/add"
+	"github.com/privacy-ethereum/privacy-precompiles/babyjubjub/utils"
+	"github.com/privacy-ethereum/privacy-precompiles/babyjubjub/validation"
+	"github.com/privacy-ethereum/privacy-precompiles/poseidon/merkle"
+)
+
+// BabyJubJub proof of liabilities precompile constants
+const (
+	// BabyJubJubProofOfLiabilitiesHeaderSize defines the byte length of the
+	// fixed prefix of the proof of liabilities input:
+	//
+	//	root || Tx || Ty
+	BabyJubJubProofOfLiabilitiesHeaderSize = utils.BabyJubJubCurveFieldByteSize + utils.BabyJubJubCurveAffinePointSize
+
+	// BabyJubJubProofOfLiabilitiesMaxLeaves defines the maximum number of
+	// leaf commitments accepted by the proof of liabilities precompile.
+	BabyJubJubProofOfLiabilitiesMaxLeaves = 64
+
+	// BabyJubJubProofOfLiabilitiesBaseGas defines the fixed gas cost of the
+	// proof of liabilities precompile. It covers the validation of the total
+	// commitment.
+	BabyJubJubProofOfLiabilitiesBaseGas = validation.BabyJubJubCurveValidatePointGas
+
+	// BabyJubJubProofOfLiabilitiesPerLeafGas defines the gas cost charged per
+	// leaf commitment.
+	//
+	// This cost reflects:
+	//   - Validation of the leaf commitment
+	//   - Hashing the leaf and (amortized) one internal node
+	//   - One curve addition into the running sum
+	BabyJubJubProofOfLiabilitiesPerLeafGas = validation.BabyJubJubCurveValidatePointGas +
+		2*merkle.PoseidonMerkleHashGas +
+		add.BabyJubJubCurveAddGas
+)
+
+var (
+	// ErrorBabyJubJubProofOfLiabilitiesInvalidInputLength is returned when the
+	// input is not a header followed by a power-of-two number of leaf
+	// commitments, between 1 and BabyJubJubProofOfLiabilitiesMaxLeaves.
+	ErrorBabyJubJubProofOfLiabilitiesInvalidInputLength = errors.New("invalid input length")
+
+	// ErrorBabyJubJubProofOfLiabilitiesInvalidTotal is returned when the total
+	// commitment is not a valid point in the BabyJubJub prime-order subgroup.
+	ErrorBabyJubJubProofOfLiabilitiesInvalidTotal = errors.New("invalid total commitment")
+
+	// ErrorBabyJubJubProofOfLiabilitiesInvalidLeaf is returned when a leaf
+	// commitment is not a valid point in the BabyJubJub prime-order subgroup.
+	ErrorBabyJubJubProofOfLiabilitiesInvalidLeaf = errors.New("invalid leaf commitment")
+)
@@ -0,0 +1,125 @@
+package montgomery
+
+import (
+	"math/big"
+
+	"github.com/iden3/go-iden3-crypto/babyjub"
+	"github.com/privacy-ethereum/privacy-precompiles/babyjubjub/utils"
+	"github.com/privacy-ethereum/privacy-precompiles/common"
+	commonUtils "github.com/privacy-ethereum/privacy-precompiles/utils"
+)
+
+// montgomeryA is the coefficient A of the Montgomery form of BabyJubJub,
+// v^2 = u^3 + A*u^2 + u.
+var montgomeryA = big.NewInt(168698)
+
+// BabyJubJubFromMontgomery implements the precompile converting a point of
+// the Montgomery form of BabyJubJub back to twisted Edwards coordinates.
+//
+// It is the inverse of BabyJubJubToMontgomery.
+type BabyJubJubFromMontgomery struct{}
+
+// Name returns the human-readable name of the precompile.
+func (c *BabyJubJubFromMontgomery) Name() string {
+	return "BabyJubJubFromMontgomery"
+}
+
+// RequiredGas returns the fixed gas cost of executing this precompile.
+//
+// For the conversion from Montgomery form, the gas cost is
+// BabyJubJubFromMontgomeryGas.
+func (c *BabyJubJubFromMontgomery) RequiredGas(input []byte) uint64 {
+	return BabyJubJubFromMontgomeryGas
+}
+
+// MaxOutputSize returns the byte length of the affine point returned by Run,
+// which is BabyJubJubFromMontgomeryOutputSize regardless of the input.
+func (c *BabyJubJubFromMontgomery) MaxOutputSize(input []byte) int {
+	return BabyJubJubFromMontgomeryOutputSize
+}
+
+// Run executes the Montgomery to BabyJubJub conversion precompile.
+//
+// The input must be exactly BabyJubJubFromMontgomeryInputSize bytes, which
+// encode a single Montgomery affine point in the format:
+//
+//	u || v
+//
+// Each coordinate is a big-endian field element padded to
+// utils.BabyJubJubCurveFieldByteSize bytes.
+//
+// Run performs the following steps:
+//  1. Parses u and v and checks they are smaller than the field prime.
+//  2. Validates that v^2 = u^3 + A*u^2 + u.
+//  3. Rejects the exceptional points v = 0 and u = -1, where the map is
+//     undefined.
+//  4. Computes x = u / v and y = (u - 1) / (u + 1).
+//  5. Validates that (x, y) lies in the correct subgroup.
+//  6. Returns the resulting affine point serialized with utils.MarshalPoint.
+//
+// Returns an error if:
+//   - The input length is incorrect.
+//   - The point is not on the Montgomery curve
+//     (ErrorBabyJubJubMontgomeryPointNotOnCurve).
+//   - The point is exceptional (ErrorBabyJubJubMontgomeryExceptionalPoint).
+//   - The resulting point is not in the subgroup
+//     (ErrorBabyJubJubCurvePointNotInSubgroup).
+func (c *BabyJubJubFromMontgomery) Run(input []byte) ([]byte, error) {
+	if len(input) != BabyJubJubFromMontgomeryInputSize {
+		return nil, utils.ErrorBabyJubJubCurveInvalidInputLength
+	}
+
+	u, offset := commonUtils.ReadField(input, 0, utils.BabyJubJubCurveFieldByteSize)
+	v, _ := commonUtils.ReadField(input, offset, utils.BabyJubJubCurveFieldByteSize)
+
+	if u == nil || v == nil {
+		return nil, utils.ErrorBabyJubJubCurveInvalidInputLength
+	}
+
+	if u.Cmp(utils.FieldPrime) >= 0 || v.Cmp(utils.FieldPrime) >= 0 || !inMontgomeryCurve(u, v) {
+		return nil, ErrorBabyJubJubMontgomeryPointNotOnCurve
+	}
+
+	uPlusOne := new(big.Int).Add(u, big.NewInt(1))
+	uPlusOne.Mod(uPlusOne, utils.FieldPrime)
+
+	if v.Sign() == 0 || uPlusOne.Sign() == 0 {
+		return nil, ErrorBabyJubJubMontgomeryExceptionalPoint
+	}
+
+	x := new(big.Int).Mul(u, new(big.Int).ModInverse(v, utils.FieldPrime))
+	x.Mod(x, utils.FieldPrime)
+
+	y := new(big.Int).Sub(u, big.NewInt(1))
+	y.Mul(y, uPlusOne.ModInverse(uPlusOne, utils.FieldPrime))
+	y.Mod(y, utils.FieldPrime)
+
+	point := &babyjub.Point{X: x, Y: y}
+
+	if !point.InSubGroup() {
+		return nil, utils.ErrorBabyJubJubCurvePointNotInSubgroup
+	}
+
+	return utils.MarshalPoint(point), nil
+}
+
+// inMontgomeryCurve reports whether (u, v) satisfies v^2 = u^3 + A*u^2 + u
+// modulo the field prime.
+func inMontgomeryCurve(u, v *big.Int) bool {
+	left := new(big.Int).Mul(v, v)
+	left.Mod(left, utils.FieldPrime)
+
+	right := new(big.Int).Add(u, montgomeryA)
+	right.Mul(right, u)
+	right.Add(right, big.NewInt(1))
+	right.Mul(right, u)
+	right.Mod(right, utils.FieldPrime)
+
+	return left.Cmp(right) == 0
+}
+
+// Ensure BabyJubJubFromMontgomery implements the common.Precompile interface.
+var _ common.Precompile = (*BabyJubJubFromMontgomery)(nil)
+
+// Ensure BabyJubJubFromMontgomery implements the common.OutputSizer interface.
+var _ common.OutputSizer = (*BabyJubJubFromMontgomery)(nil)
@@ -0,0 +1,176 @@
+package montgomery
+
+import (
+	"bytes"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/iden3/go-iden3-crypto/babyjub"
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/prop"
+	"github.com/privacy-ethereum/privacy-precompiles/babyjubjub/utils"
+	"github.com/privacy-ethereum/privacy-precompiles/common"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBabyJubJubFromMontgomeryName(t *testing.T) {
+	precompile := BabyJubJubFromMontgomery{}
+
+	expected := "BabyJubJubFromMontgomery"
+	actual := precompile.Name()
+
+	assert.Equal(t, expected, actual)
+}
+
+func TestBabyJubJubFromMontgomeryMaxOutputSize(t *testing.T) {
+	precompile := BabyJubJubFromMontgomery{}
+
+	var sizer common.OutputSizer = &precompile
+
+	assert.Equal(t, utils.BabyJubJubCurveAffinePointSize, sizer.MaxOutputSize(nil))
+}
+
+func TestFromMontgomery(t *testing.T) {
+	tests := []struct {
+		name          string
+		input         []byte
+		expected      *babyjub.Point
+		expectedError error
+	}{
+		{
+			name:     "base point",
+			input:    utils.MarshalPoint(toMontgomery(babyjub.B8)),
+			expected: babyjub.B8,
+		},
+		{
+			name:          "point of order 2 is an exceptional point",
+			input:         make([]byte, utils.BabyJubJubCurveAffinePointSize),
+			expectedError: ErrorBabyJubJubMontgomeryExceptionalPoint,
+		},
+		{
+			name:          "point not in subgroup",
+			input:         utils.MarshalPoint(toMontgomery(generator())),
+			expectedError: utils.ErrorBabyJubJubCurvePointNotInSubgroup,
+		},
+		{
+			name:          "point not on curve",
+			input:         utils.MarshalPoint(&babyjub.Point{X: big.NewInt(1), Y: big.NewInt(1)}),
+			expectedError: ErrorBabyJubJubMontgomeryPointNotOnCurve,
+		},
+		{
+			name: "coordinate outside of the field",
+			input: func() []byte {
+				point := toMontgomery(babyjub.B8)
+
+				return utils.MarshalPoint(&babyjub.Point{
+					X: new(big.Int).Add(point.X, utils.FieldPrime),
+					Y: point.Y,
+				})
+			}(),
+			expectedError: ErrorBabyJubJubMontgomeryPointNotOnCurve,
+		},
+		{
+			name:          "invalid input length",
+			input:         []byte{0x00},
+			expectedError: utils.ErrorBabyJubJubCurveInvalidInputLength,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			precompile := BabyJubJubFromMontgomery{}
+
+			actual, err := precompile.Run(tt.input)
+			gas := precompile.RequiredGas(tt.input)
+
+			if tt.expectedError != nil {
+				assert.NotNil(t, err)
+				assert.True(t, errors.Is(err, tt.expectedError))
+
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, BabyJubJubFromMontgomeryGas, gas)
+			assert.Equal(t, utils.MarshalPoint(tt.expected), actual)
+		})
+	}
+}
+
+func TestMontgomeryRoundTripProperties(t *testing.T) {
+	parameters := gopter.DefaultTestParameters()
+	properties := gopter.NewProperties(parameters)
+
+	properties.Property("FromMontgomery inverts ToMontgomery", prop.ForAll(
+		func(point *babyjub.Point) bool {
+			if utils.IsIdentity(point) {
+				return true
+			}
+
+			to := BabyJubJubToMontgomery{}
+			from := BabyJubJubFromMontgomery{}
+
+			montgomery, err := to.Run(utils.MarshalPoint(point))
+
+			if err != nil {
+				return false
+			}
+
+			result, err := from.Run(montgomery)
+
+			return err == nil && bytes.Equal(result, utils.MarshalPoint(point))
+		},
+		utils.BabyJubJubPointGenerator(),
+	))
+
+	properties.Property("ToMontgomery inverts FromMontgomery", prop.ForAll(
+		func(point *babyjub.Point) bool {
+			if utils.IsIdentity(point) {
+				return true
+			}
+
+			to := BabyJubJubToMontgomery{}
+			from := BabyJubJubFromMontgomery{}
+
+			montgomery := utils.MarshalPoint(toMontgomery(point))
+			edwards, err := from.Run(montgomery)
+
+			if err != nil {
+				return false
+			}
+
+			result, err := to.Run(edwards)
+
+			return err == nil && bytes.Equal(result, montgomery)
+		},
+		utils.BabyJubJubPointGenerator(),
+	))
+
+	properties.TestingRun(t)
+}
+
+// toMontgomery maps a twisted Edwards point other than (0, 1) and (0, -1)
+// to Montgomery coordinates, without any subgroup check.
+func toMontgomery(point *babyjub.Point) *babyjub.Point {
+	p := utils.FieldPrime
+
+	denominator := new(big.Int).Sub(big.NewInt(1), point.Y)
+	u := new(big.Int).Add(big.NewInt(1), point.Y)
+	u.Mul(u, denominator.ModInverse(denominator.Mod(denominator, p), p))
+	u.Mod(u, p)
+
+	v := new(big.Int).Mul(u, new(big.Int).ModInverse(point.X, p))
+	v.Mod(v, p)
+
+	return &babyjub.Point{X: u, Y: v}
+}
+
+// generator returns the generator of the full BabyJubJub group, of order
+// 8 * babyjub.SubOrder.
+func generator() *babyjub.Point {
+	x, _ := new(big.Int).SetString("995203441582195749578291179787384436505546430278305826713579947235728471134", 10)
+	y, _ := new(big.Int).SetString("5472060717959818805561601436314318772137091100104008585924551046643952123905", 10)
+
+	return &babyjub.Point{X: x, Y: y}
+}
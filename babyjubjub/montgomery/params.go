@@ -0,0 +1,54 @@
+package montgomery
+
+import (
+	"errors"
+
+	"github.com/privacy-ethereum/privacy-precompiles/babyjubjub/utils"
+)
+
+// BabyJubJub Montgomery conversion precompile constants
+const (
+	// BabyJubJubToMontgomeryInputSize defines the fixed byte length of the
+	// input to the BabyJubJub to Montgomery conversion precompile. The input
+	// consists of a single twisted Edwards affine point serialized as X || Y.
+	BabyJubJubToMontgomeryInputSize = utils.BabyJubJubCurveAffinePointSize
+
+	// BabyJubJubToMontgomeryOutputSize defines the fixed byte length of the
+	// output of the BabyJubJub to Montgomery conversion precompile. The
+	// output is a single Montgomery affine point serialized as U || V.
+	BabyJubJubToMontgomeryOutputSize = utils.BabyJubJubCurveAffinePointSize
+
+	// BabyJubJubToMontgomeryGas is the gas cost estimate for executing the
+	// BabyJubJub to Montgomery conversion precompile in Ethereum.
+	//
+	// The cost is dominated by the subgroup check, so it extends the point
+	// validation cost with the two field inversions of the map.
+	BabyJubJubToMontgomeryGas uint64 = 10000 + 800
+
+	// BabyJubJubFromMontgomeryInputSize defines the fixed byte length of the
+	// input to the Montgomery to BabyJubJub conversion precompile. The input
+	// consists of a single Montgomery affine point serialized as U || V.
+	BabyJubJubFromMontgomeryInputSize = utils.BabyJubJubCurveAffinePointSize
+
+	// BabyJubJubFromMontgomeryOutputSize defines the fixed byte length of the
+	// output of the Montgomery to BabyJubJub conversion precompile. The
+	// output is a single twisted Edwards affine point serialized as X || Y.
+	BabyJubJubFromMontgomeryOutputSize = utils.BabyJubJubCurveAffinePointSize
+
+	// BabyJubJubFromMontgomeryGas is the gas cost estimate for executing the
+	// Montgomery to BabyJubJub conversion precompile in Ethereum. It matches
+	// BabyJubJubToMontgomeryGas, since the inverse map has the same cost.
+	BabyJubJubFromMontgomeryGas uint64 = BabyJubJubToMontgomeryGas
+)
+
+var (
+	// ErrorBabyJubJubMontgomeryExceptionalPoint is returned when the
+	// birational map between the twisted Edwards and Montgomery forms is
+	// undefined at the input point.
+	ErrorBabyJubJubMontgomeryExceptionalPoint = errors.New("birational map undefined at exceptional point")
+
+	// ErrorBabyJubJubMontgomeryPointNotOnCurve is returned when a Montgomery
+	// point has a coordinate outside of the field or does not satisfy the
+	// Montgomery curve equation.
+	ErrorBabyJubJubMontgomeryPointNotOnCurve = errors.New("point is not on Montgomery curve")
+)
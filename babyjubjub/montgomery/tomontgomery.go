@@ -0,0 +1,110 @@
+package montgomery
+
+import (
+	"math/big"
+
+	"github.com/iden3/go-iden3-crypto/babyjub"
+	"github.com/privacy-ethereum/privacy-precompiles/babyjubjub/utils"
+	"github.com/privacy-ethereum/privacy-precompiles/common"
+)
+
+// BabyJubJubToMontgomery implements the precompile converting a BabyJubJub
+// point from twisted Edwards to Montgomery coordinates.
+//
+// BabyJubJub a*x^2 + y^2 = 1 + d*x^2*y^2, with a = 168700 and d = 168696, is
+// birationally equivalent to the Montgomery curve
+//
+//	v^2 = u^3 + A*u^2 + u
+//
+// with A = 2*(a + d)/(a - d) = 168698 and B = 4/(a - d) = 1. Circuits
+// using Montgomery ladders can consume the converted points directly.
+type BabyJubJubToMontgomery struct{}
+
+// Name returns the human-readable name of the precompile.
+func (c *BabyJubJubToMontgomery) Name() string {
+	return "BabyJubJubToMontgomery"
+}
+
+// RequiredGas returns the fixed gas cost of executing this precompile.
+//
+// For the conversion to Montgomery form, the gas cost is
+// BabyJubJubToMontgomeryGas.
+func (c *BabyJubJubToMontgomery) RequiredGas(input []byte) uint64 {
+	return BabyJubJubToMontgomeryGas
+}
+
+// MaxOutputSize returns the byte length of the Montgomery point returned by
+// Run, which is BabyJubJubToMontgomeryOutputSize regardless of the input.
+func (c *BabyJubJubToMontgomery) MaxOutputSize(input []byte) int {
+	return BabyJubJubToMontgomeryOutputSize
+}
+
+// Run executes the BabyJubJub to Montgomery conversion precompile.
+//
+// The input must be exactly BabyJubJubToMontgomeryInputSize bytes, which
+// encode a single twisted Edwards affine point in the format:
+//
+//	x || y
+//
+// Each coordinate is a big-endian field element padded to
+// utils.BabyJubJubCurveFieldByteSize bytes.
+//
+// Run performs the following steps:
+//  1. Parses the point from input using utils.ReadAffinePoint.
+//  2. Validates that the point lies on the BabyJubJub curve and in the
+//     correct subgroup.
+//  3. Rejects the identity (0, 1), where the map is undefined.
+//  4. Computes u = (1 + y) / (1 - y) and v = u / x.
+//  5. Returns u || v, each encoded as a 32-byte big-endian field element.
+//
+// Returns an error if:
+//   - The input length is incorrect.
+//   - The point is not on the curve (ErrorBabyJubJubCurvePointNotOnCurve).
+//   - The point is not in the subgroup (ErrorBabyJubJubCurvePointNotInSubgroup).
+//   - The point is the identity (ErrorBabyJubJubMontgomeryExceptionalPoint).
+func (c *BabyJubJubToMontgomery) Run(input []byte) ([]byte, error) {
+	if len(input) != BabyJubJubToMontgomeryInputSize {
+		return nil, utils.ErrorBabyJubJubCurveInvalidInputLength
+	}
+
+	point, err := utils.ReadAffinePoint(input, 0)
+
+	if err != nil {
+		return nil, utils.ErrorBabyJubJubCurveInvalidInputLength
+	}
+
+	if !point.InCurve() {
+		return nil, utils.ErrorBabyJubJubCurvePointNotOnCurve
+	}
+
+	if !point.InSubGroup() {
+		return nil, utils.ErrorBabyJubJubCurvePointNotInSubgroup
+	}
+
+	x := new(big.Int).Mod(point.X, utils.FieldPrime)
+	y := new(big.Int).Mod(point.Y, utils.FieldPrime)
+
+	// On the curve, x = 0 implies y = 1 or y = -1, and the only such point
+	// of the subgroup is the identity, which maps to the point at infinity.
+	if x.Sign() == 0 {
+		return nil, ErrorBabyJubJubMontgomeryExceptionalPoint
+	}
+
+	numerator := new(big.Int).Add(big.NewInt(1), y)
+	denominator := new(big.Int).Sub(big.NewInt(1), y)
+	denominator.Mod(denominator, utils.FieldPrime)
+
+	u := new(big.Int).Mul(numerator, denominator.ModInverse(denominator, utils.FieldPrime))
+	u.Mod(u, utils.FieldPrime)
+
+	v := new(big.Int).Mul(u, x.ModInverse(x, utils.FieldPrime))
+	v.Mod(v, utils.FieldPrime)
+
+	return utils.MarshalPoint(&babyjub.Point{X: u, Y: v}), nil
+}
+
+// Ensure BabyJubJubToMontgomery implements the common.Precompile interface.
+var _ common.Precompile = (*BabyJubJubToMontgomery)(nil)
+
+// Ensure BabyJubJubToMontgomery implements the common.OutputSizer interface.
+var _ common.OutputSizer = (*BabyJubJubToMontgomery)(nil)
@@ -0,0 +1,123 @@
+package montgomery
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/iden3/go-iden3-crypto/babyjub"
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/prop"
+	"github.com/privacy-ethereum/privacy-precompiles/babyjubjub/utils"
+	"github.com/privacy-ethereum/privacy-precompiles/common"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBabyJubJubToMontgomeryName(t *testing.T) {
+	precompile := BabyJubJubToMontgomery{}
+
+	expected := "BabyJubJubToMontgomery"
+	actual := precompile.Name()
+
+	assert.Equal(t, expected, actual)
+}
+
+func TestBabyJubJubToMontgomeryMaxOutputSize(t *testing.T) {
+	precompile := BabyJubJubToMontgomery{}
+
+	var sizer common.OutputSizer = &precompile
+
+	assert.Equal(t, utils.BabyJubJubCurveAffinePointSize, sizer.MaxOutputSize(nil))
+}
+
+func TestToMontgomery(t *testing.T) {
+	tests := []struct {
+		name          string
+		input         []byte
+		expectedError error
+	}{
+		{
+			name:  "base point",
+			input: utils.MarshalPoint(babyjub.B8),
+		},
+		{
+			name:  "negated base point",
+			input: utils.MarshalPoint(&babyjub.Point{X: new(big.Int).Sub(utils.FieldPrime, babyjub.B8.X), Y: babyjub.B8.Y}),
+		},
+		{
+			name:          "identity is an exceptional point",
+			input:         utils.MarshalPoint(babyjub.NewPoint()),
+			expectedError: ErrorBabyJubJubMontgomeryExceptionalPoint,
+		},
+		{
+			name: "point of order 2 is not in subgroup",
+			input: utils.MarshalPoint(&babyjub.Point{
+				X: big.NewInt(0),
+				Y: new(big.Int).Sub(utils.FieldPrime, big.NewInt(1)),
+			}),
+			expectedError: utils.ErrorBabyJubJubCurvePointNotInSubgroup,
+		},
+		{
+			name:          "point not on curve",
+			input:         utils.MarshalPoint(&babyjub.Point{X: big.NewInt(123), Y: big.NewInt(456)}),
+			expectedError: utils.ErrorBabyJubJubCurvePointNotOnCurve,
+		},
+		{
+			name:          "invalid input length",
+			input:         []byte{0x00},
+			expectedError: utils.ErrorBabyJubJubCurveInvalidInputLength,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			precompile := BabyJubJubToMontgomery{}
+
+			actual, err := precompile.Run(tt.input)
+			gas := precompile.RequiredGas(tt.input)
+
+			if tt.expectedError != nil {
+				assert.NotNil(t, err)
+				assert.True(t, errors.Is(err, tt.expectedError))
+
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, BabyJubJubToMontgomeryGas, gas)
+
+			montgomery, err := utils.UnmarshalPoint(actual)
+
+			assert.Nil(t, err)
+			assert.True(t, inMontgomeryCurve(montgomery.X, montgomery.Y))
+		})
+	}
+}
+
+func TestToMontgomeryProperties(t *testing.T) {
+	parameters := gopter.DefaultTestParameters()
+	properties := gopter.NewProperties(parameters)
+
+	properties.Property("Run returns a point on the Montgomery curve", prop.ForAll(
+		func(point *babyjub.Point) bool {
+			if utils.IsIdentity(point) {
+				return true
+			}
+
+			precompile := BabyJubJubToMontgomery{}
+
+			result, err := precompile.Run(utils.MarshalPoint(point))
+
+			if err != nil {
+				return false
+			}
+
+			montgomery, err := utils.UnmarshalPoint(result)
+
+			return err == nil && inMontgomeryCurve(montgomery.X, montgomery.Y)
+		},
+		utils.BabyJubJubPointGenerator(),
+	))
+
+	properties.TestingRun(t)
+}
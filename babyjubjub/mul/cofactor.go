@@ -0,0 +1,78 @@
+package mul
+
+import (
+	"math/big"
+
+	"github.com/iden3/go-iden3-crypto/babyjub"
+	"github.com/privacy-ethereum/privacy-precompiles/babyjubjub/utils"
+	"github.com/privacy-ethereum/privacy-precompiles/common"
+)
+
+// BabyJubJubClearCofactor implements the BabyJubJub cofactor clearing
+// precompile.
+//
+// It maps any point of the curve into the prime-order subgroup by
+// multiplying it by BabyJubJubCofactor, which sanitizes untrusted points
+// that protocols prefer to project rather than reject. Small-order points
+// map to the identity.
+type BabyJubJubClearCofactor struct{}
+
+// Name returns the human-readable name of the precompile.
+func (c *BabyJubJubClearCofactor) Name() string {
+	return "BabyJubJubClearCofactor"
+}
+
+// RequiredGas returns the fixed gas cost of executing this precompile.
+//
+// For BabyJubJub cofactor clearing, the gas cost is BabyJubJubClearCofactorGas.
+func (c *BabyJubJubClearCofactor) RequiredGas(input []byte) uint64 {
+	return BabyJubJubClearCofactorGas
+}
+
+// MaxOutputSize returns the byte length of the affine point returned by Run,
+// which is BabyJubJubClearCofactorOutputSize regardless of the input.
+func (c *BabyJubJubClearCofactor) MaxOutputSize(input []byte) int {
+	return BabyJubJubClearCofactorOutputSize
+}
+
+// Run executes the BabyJubJub cofactor clearing precompile.
+//
+// The input must be exactly BabyJubJubClearCofactorInputSize bytes, which
+// encode a single affine point in the format:
+//
+//	x || y
+//
+// Run performs the following steps:
+//  1. Parses the point from input using utils.ReadAffinePoint.
+//  2. Validates that the point lies on the BabyJubJub curve. Subgroup
+//     membership is not required.
+//  3. Computes [BabyJubJubCofactor]P, which always lies in the prime-order
+//     subgroup.
+//  4. Returns the resulting affine point serialized with utils.MarshalPoint.
+//
+// Returns an error if:
+//   - The input length is incorrect.
+//   - The point is not on the curve (ErrorBabyJubJubCurvePointNotOnCurve).
+func (c *BabyJubJubClearCofactor) Run(input []byte) ([]byte, error) {
+	if len(input) != BabyJubJubClearCofactorInputSize {
+		return nil, utils.ErrorBabyJubJubCurveInvalidInputLength
+	}
+
+	point, err := utils.ReadAffinePoint(input, 0)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if !point.InCurve() {
+		return nil, utils.ErrorBabyJubJubCurvePointNotOnCurve
+	}
+
+	return utils.MarshalPoint(babyjub.NewPoint().Mul(big.NewInt(BabyJubJubCofactor), point)), nil
+}
+
+// Ensure BabyJubJubClearCofactor implements the common.Precompile interface.
+var _ common.Precompile = (*BabyJubJubClearCofactor)(nil)
+
+// Ensure BabyJubJubClearCofactor implements the common.OutputSizer interface.
+var _ common.OutputSizer = (*BabyJubJubClearCofactor)(nil)
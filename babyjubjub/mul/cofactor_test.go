@@ -0,0 +1,179 @@
+package mul
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/iden3/go-iden3-crypto/babyjub"
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/gen"
+	"github.com/leanovate/gopter/prop"
+	"github.com/privacy-ethereum/privacy-precompiles/babyjubjub/add"
+	"github.com/privacy-ethereum/privacy-precompiles/babyjubjub/utils"
+	"github.com/privacy-ethereum/privacy-precompiles/common"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBabyJubJubClearCofactorName(t *testing.T) {
+	precompile := BabyJubJubClearCofactor{}
+
+	expected := "BabyJubJubClearCofactor"
+	actual := precompile.Name()
+
+	assert.Equal(t, expected, actual)
+}
+
+func TestBabyJubJubClearCofactorMaxOutputSize(t *testing.T) {
+	precompile := BabyJubJubClearCofactor{}
+
+	var sizer common.OutputSizer = &precompile
+
+	assert.Equal(t, utils.BabyJubJubCurveAffinePointSize, sizer.MaxOutputSize(nil))
+}
+
+func TestClearCofactor(t *testing.T) {
+	tests := []struct {
+		name          string
+		input         []byte
+		expected      *babyjub.Point
+		expectedError error
+	}{
+		{
+			name:     "identity",
+			input:    utils.MarshalPoint(babyjub.NewPoint()),
+			expected: babyjub.NewPoint(),
+		},
+		{
+			name:     "point of order 2",
+			input:    utils.MarshalPoint(torsionPoint(4)),
+			expected: babyjub.NewPoint(),
+		},
+		{
+			name:     "point of order 4",
+			input:    utils.MarshalPoint(torsionPoint(2)),
+			expected: babyjub.NewPoint(),
+		},
+		{
+			name:     "point of order 8",
+			input:    utils.MarshalPoint(torsionPoint(1)),
+			expected: babyjub.NewPoint(),
+		},
+		{
+			name:     "B8",
+			input:    utils.MarshalPoint(babyjub.B8),
+			expected: babyjub.NewPoint().Mul(big.NewInt(8), babyjub.B8),
+		},
+		{
+			name:     "full group generator",
+			input:    utils.MarshalPoint(fullGroupGenerator()),
+			expected: babyjub.B8,
+		},
+		{
+			name:     "B8 shifted by a torsion point",
+			input:    utils.MarshalPoint(babyjub.NewPoint().Projective().Add(babyjub.B8.Projective(), torsionPoint(3).Projective()).Affine()),
+			expected: babyjub.NewPoint().Mul(big.NewInt(8), babyjub.B8),
+		},
+		{
+			name: "point is not on curve",
+			input: utils.MarshalPoint(&babyjub.Point{
+				X: big.NewInt(1),
+				Y: big.NewInt(1),
+			}),
+			expectedError: utils.ErrorBabyJubJubCurvePointNotOnCurve,
+		},
+		{
+			name:          "empty input",
+			input:         []byte{},
+			expectedError: utils.ErrorBabyJubJubCurveInvalidInputLength,
+		},
+		{
+			name:          "invalid input length",
+			input:         append(utils.MarshalPoint(babyjub.B8), 0),
+			expectedError: utils.ErrorBabyJubJubCurveInvalidInputLength,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			precompile := BabyJubJubClearCofactor{}
+
+			actual, err := precompile.Run(tt.input)
+			gas := precompile.RequiredGas(tt.input)
+
+			if tt.expectedError != nil {
+				assert.NotNil(t, err)
+				assert.Equal(t, tt.expectedError, err)
+
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, BabyJubJubClearCofactorGas, gas)
+			assert.Equal(t, utils.MarshalPoint(tt.expected), actual)
+		})
+	}
+}
+
+func TestClearCofactorProperties(t *testing.T) {
+	parameters := gopter.DefaultTestParameters()
+	properties := gopter.NewProperties(parameters)
+
+	properties.Property("subgroup points map to [8]P", prop.ForAll(
+		func(point *babyjub.Point) bool {
+			precompile := BabyJubJubClearCofactor{}
+
+			actual, err := precompile.Run(utils.MarshalPoint(point))
+
+			if err != nil {
+				return false
+			}
+
+			doubled := utils.MarshalPoint(point)
+
+			for range 3 {
+				doubled, _ = (&add.BabyJubJubCurveAdd{}).Run(append(doubled, doubled...))
+			}
+
+			return string(actual) == string(doubled)
+		},
+		utils.BabyJubJubPointGenerator(),
+	))
+
+	properties.Property("any curve point maps into the subgroup", prop.ForAll(
+		func(point *babyjub.Point, k int64) bool {
+			shifted := babyjub.NewPoint().Projective().Add(point.Projective(), torsionPoint(k).Projective()).Affine()
+
+			actual, err := (&BabyJubJubClearCofactor{}).Run(utils.MarshalPoint(shifted))
+
+			if err != nil {
+				return false
+			}
+
+			cleared, _ := utils.UnmarshalPoint(actual)
+
+			return cleared.InSubGroup() &&
+				string(actual) == string(utils.MarshalPoint(babyjub.NewPoint().Mul(big.NewInt(8), point)))
+		},
+		utils.BabyJubJubPointGenerator(),
+		gen.Int64Range(0, 7),
+	))
+
+	properties.TestingRun(t)
+}
+
+// fullGroupGenerator returns the generator G of the full BabyJubJub group,
+// of order 8 * SubOrder, for which B8 = [8]G.
+func fullGroupGenerator() *babyjub.Point {
+	x, _ := new(big.Int).SetString("995203441582195749578291179787384436505546430278305826713579947235728471134", 10)
+	y, _ := new(big.Int).SetString("5472060717959818805561601436314318772137091100104008585924551046643952123905", 10)
+
+	return &babyjub.Point{X: x, Y: y}
+}
+
+// torsionPoint returns k*T, where T = SubOrder*G is a point of order 8.
+// Its order is 8/gcd(k, 8).
+func torsionPoint(k int64) *babyjub.Point {
+	scalar := new(big.Int).Mul(babyjub.SubOrder, big.NewInt(k))
+
+	return babyjub.NewPoint().Mul(scalar, fullGroupGenerator())
+}
@@ -0,0 +1,83 @@
+package mul
+
+import (
+	"github.com/iden3/go-iden3-crypto/babyjub"
+	"github.com/privacy-ethereum/privacy-precompiles/babyjubjub/utils"
+	"github.com/privacy-ethereum/privacy-precompiles/common"
+	commonUtils "github.com/privacy-ethereum/privacy-precompiles/utils"
+)
+
+// BabyJubJubCurveMulCompressed implements the BabyJubJub scalar multiplication
+// precompile over compressed point encodings.
+//
+// It behaves like BabyJubJubCurveMul but takes and returns 32-byte compressed
+// points, halving the calldata for scalar-multiplication heavy contracts.
+type BabyJubJubCurveMulCompressed struct{}
+
+// Name returns the human-readable name of the precompile.
+func (c *BabyJubJubCurveMulCompressed) Name() string {
+	return "BabyJubJubMulCompressed"
+}
+
+// RequiredGas returns the fixed gas cost of executing this precompile.
+//
+// For compressed BabyJubJub scalar multiplication, the gas cost is
+// BabyJubJubCurveMulCompressedGas.
+func (c *BabyJubJubCurveMulCompressed) RequiredGas(input []byte) uint64 {
+	return BabyJubJubCurveMulCompressedGas
+}
+
+// MaxOutputSize returns the byte length of the compressed product returned by Run,
+// which is BabyJubJubCurveMulCompressedOutputSize regardless of the input.
+func (c *BabyJubJubCurveMulCompressed) MaxOutputSize(input []byte) int {
+	return BabyJubJubCurveMulCompressedOutputSize
+}
+
+// Run executes the compressed BabyJubJub scalar multiplication precompile.
+//
+// The input must be exactly BabyJubJubCurveMulCompressedInputSize bytes,
+// which encode:
+//
+//	compressed point || scalar
+//
+// Where:
+//   - the compressed point is encoded as in utils.MarshalPointCompressed.
+//   - scalar is a big-endian integer padded to BabyJubJubCurveFieldByteSize bytes.
+//
+// Run performs the following steps:
+//  1. Decompresses the point using utils.UnmarshalPointCompressed.
+//  2. Validates that the point lies in the correct subgroup.
+//  3. Parses the scalar and reduces it modulo the BabyJubJub subgroup order.
+//  4. Computes scalar multiplication.
+//  5. Returns the resulting point serialized with utils.MarshalPointCompressed.
+//
+// Returns an error if:
+//   - The input length is incorrect.
+//   - The point cannot be decompressed.
+//   - The point is not in the subgroup (ErrorBabyJubJubCurvePointNotInSubgroup).
+func (c *BabyJubJubCurveMulCompressed) Run(input []byte) ([]byte, error) {
+	if len(input) != BabyJubJubCurveMulCompressedInputSize {
+		return nil, utils.ErrorBabyJubJubCurveInvalidInputLength
+	}
+
+	point, err := utils.UnmarshalPointCompressed(input[:utils.BabyJubJubCurveCompressedPointSize])
+
+	if err != nil {
+		return nil, err
+	}
+
+	if !point.InSubGroup() {
+		return nil, utils.ErrorBabyJubJubCurvePointNotInSubgroup
+	}
+
+	scalar, _ := commonUtils.ReadField(input, utils.BabyJubJubCurveCompressedPointSize, utils.BabyJubJubCurveFieldByteSize)
+	scalar = utils.ReduceScalar(scalar)
+
+	return utils.MarshalPointCompressed(babyjub.NewPoint().Mul(scalar, point)), nil
+}
+
+// Ensure BabyJubJubCurveMulCompressed implements the common.Precompile interface.
+var _ common.Precompile = (*BabyJubJubCurveMulCompressed)(nil)
+
+// Ensure BabyJubJubCurveMulCompressed implements the common.OutputSizer interface.
+var _ common.OutputSizer = (*BabyJubJubCurveMulCompressed)(nil)
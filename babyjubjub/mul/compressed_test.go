@@ -0,0 +1,155 @@
+package mul
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	"github.com/iden3/go-iden3-crypto/babyjub"
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/prop"
+	"github.com/privacy-ethereum/privacy-precompiles/babyjubjub/utils"
+	"github.com/privacy-ethereum/privacy-precompiles/common"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBabyJubJubCurveMulCompressedName(t *testing.T) {
+	precompile := BabyJubJubCurveMulCompressed{}
+
+	expected := "BabyJubJubMulCompressed"
+	actual := precompile.Name()
+
+	assert.Equal(t, expected, actual)
+}
+
+func TestBabyJubJubCurveMulCompressedMaxOutputSize(t *testing.T) {
+	precompile := BabyJubJubCurveMulCompressed{}
+
+	var sizer common.OutputSizer = &precompile
+
+	assert.Equal(t, utils.BabyJubJubCurveCompressedPointSize, sizer.MaxOutputSize(nil))
+}
+
+func TestScalarMulCompressed(t *testing.T) {
+	tests := []struct {
+		name          string
+		input         []byte
+		expected      *babyjub.Point
+		expectedError error
+	}{
+		{
+			name: "B8 scalar multiplication with 0",
+			input: append(
+				utils.MarshalPointCompressed(babyjub.B8),
+				big.NewInt(0).FillBytes(make([]byte, utils.BabyJubJubCurveFieldByteSize))...,
+			),
+			expected: babyjub.NewPoint(),
+		},
+		{
+			name: "B8 scalar multiplication with 1",
+			input: append(
+				utils.MarshalPointCompressed(babyjub.B8),
+				big.NewInt(1).FillBytes(make([]byte, utils.BabyJubJubCurveFieldByteSize))...,
+			),
+			expected: babyjub.B8,
+		},
+		{
+			name: "B8 scalar multiplication with non-zero scalar",
+			input: append(
+				utils.MarshalPointCompressed(babyjub.B8),
+				big.NewInt(1234).FillBytes(make([]byte, utils.BabyJubJubCurveFieldByteSize))...,
+			),
+			expected: babyjub.NewPoint().Mul(big.NewInt(1234), babyjub.B8),
+		},
+		{
+			name:          "uncompressed input",
+			input:         append(utils.MarshalPoint(babyjub.B8), make([]byte, utils.BabyJubJubCurveFieldByteSize)...),
+			expectedError: utils.ErrorBabyJubJubCurveInvalidInputLength,
+		},
+		{
+			name: "point cannot be decompressed",
+			input: func() []byte {
+				input := make([]byte, BabyJubJubCurveMulCompressedInputSize)
+				input[0] = 2
+
+				return input
+			}(),
+			expectedError: utils.ErrorBabyJubJubCurvePointInvalid,
+		},
+		{
+			name: "point is not in subgroup",
+			input: append(
+				utils.MarshalPointCompressed(&babyjub.Point{
+					X: big.NewInt(0),
+					Y: new(big.Int).Sub(utils.FieldPrime, big.NewInt(1)), // p - 1 == -1 mod p
+				}),
+				big.NewInt(9000).FillBytes(make([]byte, utils.BabyJubJubCurveFieldByteSize))...,
+			),
+			expectedError: utils.ErrorBabyJubJubCurvePointNotInSubgroup,
+		},
+		{
+			name:          "empty input",
+			input:         []byte{},
+			expectedError: utils.ErrorBabyJubJubCurveInvalidInputLength,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			precompile := BabyJubJubCurveMulCompressed{}
+
+			actual, err := precompile.Run(tt.input)
+			gas := precompile.RequiredGas(tt.input)
+
+			if tt.expectedError != nil {
+				assert.NotNil(t, err)
+				assert.Equal(t, tt.expectedError, err)
+
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Len(t, actual, BabyJubJubCurveMulCompressedOutputSize)
+			assert.Equal(t, BabyJubJubCurveMulCompressedGas, gas)
+			assert.Equal(t, utils.MarshalPointCompressed(tt.expected), actual)
+		})
+	}
+}
+
+func TestCompressedRunProperties(t *testing.T) {
+	parameters := gopter.DefaultTestParameters()
+	properties := gopter.NewProperties(parameters)
+
+	properties.Property("Run matches the uncompressed BabyJubJubCurveMul", prop.ForAll(
+		func(point *babyjub.Point, scalar *big.Int) bool {
+			compressedPrecompile := BabyJubJubCurveMulCompressed{}
+			precompile := BabyJubJubCurveMul{}
+
+			scalarBytes := scalar.FillBytes(make([]byte, utils.BabyJubJubCurveFieldByteSize))
+
+			compressed, err := compressedPrecompile.Run(append(utils.MarshalPointCompressed(point), scalarBytes...))
+
+			if err != nil {
+				return false
+			}
+
+			uncompressed, err := precompile.Run(append(utils.MarshalPoint(point), scalarBytes...))
+
+			if err != nil {
+				return false
+			}
+
+			result, err := utils.UnmarshalPointCompressed(compressed)
+
+			if err != nil {
+				return false
+			}
+
+			return bytes.Equal(utils.MarshalPoint(result), uncompressed)
+		},
+		utils.BabyJubJubPointGenerator(),
+		utils.ScalarGenerator(),
+	))
+
+	properties.TestingRun(t)
+}
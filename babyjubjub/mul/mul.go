@@ -25,6 +25,12 @@ func (c *BabyJubJubCurveMul) RequiredGas(input []byte) uint64 {
 	return BabyJubJubCurveMulGas
 }
 
+// MaxOutputSize returns the byte length of the affine product returned by Run,
+// which is BabyJubJubCurveMulOutputSize regardless of the input.
+func (c *BabyJubJubCurveMul) MaxOutputSize(input []byte) int {
+	return BabyJubJubCurveMulOutputSize
+}
+
 // Run executes the BabyJubJub scalar multiplication precompile.
 //
 // The input must be exactly BabyJubJubMulInputSize bytes, which encode:
@@ -37,34 +43,63 @@ func (c *BabyJubJubCurveMul) RequiredGas(input []byte) uint64 {
 //     to BabyJubJubFieldByteSize bytes.
 //
 // Run performs the following steps:
-//  1. Parses the affine point from input using utils.ReadAffinePoint.
+//  1. Parses the affine point from input using utils.ReadAffinePoint,
+//     returning its error if the point cannot be read.
 //  2. Validates that the point lies on the BabyJubJub curve and in the
 //     correct subgroup.
 //  3. Parses the scalar using utils.ReadField.
-//  4. Reduces the scalar modulo the BabyJubJub subgroup order.
+//  4. Reduces the scalar modulo the BabyJubJub subgroup order using
+//     utils.ReduceScalar.
 //  5. Computes scalar multiplication in projective coordinates.
 //  6. Returns the resulting affine point serialized with utils.MarshalPoint.
 //
 // Returns an error if:
 //   - The input length is incorrect.
-//   - The point is invalid, not on the curve, or not in the subgroup.
+//   - The point cannot be read (ErrorBabyJubJubCurvePointInvalid).
+//   - The point is not on the curve (ErrorBabyJubJubCurvePointNotOnCurve).
+//   - The point is not in the subgroup (ErrorBabyJubJubCurvePointNotInSubgroup).
 func (c *BabyJubJubCurveMul) Run(input []byte) ([]byte, error) {
 	if len(input) != BabyJubJubCurveMulInputSize {
 		return nil, utils.ErrorBabyJubJubCurveInvalidInputLength
 	}
 
-	point, _ := utils.ReadAffinePoint(input, 0)
+	return c.run(input)
+}
+
+// run multiplies the point encoded at the start of input by the scalar that
+// follows it.
+//
+// It does not rely on Run's length check: a point or scalar that cannot be
+// read from input is reported as an error rather than dereferenced.
+func (c *BabyJubJubCurveMul) run(input []byte) ([]byte, error) {
+	point, err := utils.ReadAffinePoint(input, 0)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if !point.InCurve() {
+		return nil, utils.ErrorBabyJubJubCurvePointNotOnCurve
+	}
 
 	if !point.InSubGroup() {
-		return nil, utils.ErrorBabyJubJubCurveInvalidPoint
+		return nil, utils.ErrorBabyJubJubCurvePointNotInSubgroup
 	}
 
 	offset := utils.BabyJubJubCurveAffinePointSize
 	scalar, _ := commonUtils.ReadField(input, offset, utils.BabyJubJubCurveFieldByteSize)
-	scalar = scalar.Mod(scalar, babyjub.SubOrder)
+
+	if scalar == nil {
+		return nil, utils.ErrorBabyJubJubCurveInvalidInputLength
+	}
+
+	scalar = utils.ReduceScalar(scalar)
 
 	return utils.MarshalPoint(babyjub.NewPoint().Mul(scalar, point)), nil
 }
 
 // Ensure BabyJubJubCurveMul implements the common.Precompile interface.
 var _ common.Precompile = (*BabyJubJubCurveMul)(nil)
+
+// Ensure BabyJubJubCurveMul implements the common.OutputSizer interface.
+var _ common.OutputSizer = (*BabyJubJubCurveMul)(nil)
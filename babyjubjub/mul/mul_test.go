@@ -2,6 +2,7 @@ package mul
 
 import (
 	"bytes"
+	"errors"
 	"math/big"
 	"testing"
 
@@ -9,6 +10,8 @@ import (
 	"github.com/leanovate/gopter"
 	"github.com/leanovate/gopter/prop"
 	"github.com/privacy-ethereum/privacy-precompiles/babyjubjub/utils"
+	"github.com/privacy-ethereum/privacy-precompiles/common"
+	"github.com/privacy-ethereum/privacy-precompiles/internal/commontest"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -21,6 +24,14 @@ func TestBabyJubJubCurveMulName(t *testing.T) {
 	assert.Equal(t, expected, actual)
 }
 
+func TestBabyJubJubCurveMulMaxOutputSize(t *testing.T) {
+	precompile := BabyJubJubCurveMul{}
+
+	var sizer common.OutputSizer = &precompile
+
+	assert.Equal(t, utils.BabyJubJubCurveAffinePointSize, sizer.MaxOutputSize(nil))
+}
+
 func TestScalarMul(t *testing.T) {
 	tests := []struct {
 		name          string
@@ -66,6 +77,22 @@ func TestScalarMul(t *testing.T) {
 				}(),
 			},
 		},
+		{
+			name: "B8 scalar multiplication with the subgroup order",
+			input: append(
+				utils.MarshalPoint(babyjub.B8),
+				babyjub.SubOrder.FillBytes(make([]byte, utils.BabyJubJubCurveFieldByteSize))...,
+			),
+			expected: babyjub.NewPoint(),
+		},
+		{
+			name: "B8 scalar multiplication with the subgroup order minus 1",
+			input: append(
+				utils.MarshalPoint(babyjub.B8),
+				new(big.Int).Sub(babyjub.SubOrder, big.NewInt(1)).FillBytes(make([]byte, utils.BabyJubJubCurveFieldByteSize))...,
+			),
+			expected: &babyjub.Point{X: new(big.Int).Sub(utils.FieldPrime, babyjub.B8.X), Y: babyjub.B8.Y},
+		},
 		{
 			name:          "invalid input length",
 			input:         []byte{0x00},
@@ -85,7 +112,7 @@ func TestScalarMul(t *testing.T) {
 				utils.MarshalPoint(&babyjub.Point{X: big.NewInt(123), Y: big.NewInt(456)}),
 				big.NewInt(9000).FillBytes(make([]byte, utils.BabyJubJubCurveFieldByteSize))...,
 			),
-			expectedError: utils.ErrorBabyJubJubCurveInvalidPoint,
+			expectedError: utils.ErrorBabyJubJubCurvePointNotOnCurve,
 		},
 		{
 			name: "point is not in subgroup",
@@ -100,7 +127,7 @@ func TestScalarMul(t *testing.T) {
 					big.NewInt(9000).FillBytes(make([]byte, utils.BabyJubJubCurveFieldByteSize))...,
 				)
 			}(),
-			expectedError: utils.ErrorBabyJubJubCurveInvalidPoint,
+			expectedError: utils.ErrorBabyJubJubCurvePointNotInSubgroup,
 		},
 		{
 			name:          "empty input",
@@ -134,6 +161,51 @@ func TestScalarMul(t *testing.T) {
 	}
 }
 
+func TestScalarMulTruncatedInput(t *testing.T) {
+	input := append(
+		utils.MarshalPoint(babyjub.B8),
+		big.NewInt(1234).FillBytes(make([]byte, utils.BabyJubJubCurveFieldByteSize))...,
+	)
+
+	tests := []struct {
+		name          string
+		input         []byte
+		expectedError error
+	}{
+		{
+			name:          "empty input",
+			input:         []byte{},
+			expectedError: utils.ErrorBabyJubJubCurvePointInvalid,
+		},
+		{
+			name:          "truncated y coordinate",
+			input:         input[:utils.BabyJubJubCurveFieldByteSize+1],
+			expectedError: utils.ErrorBabyJubJubCurvePointInvalid,
+		},
+		{
+			name:          "missing scalar",
+			input:         input[:utils.BabyJubJubCurveAffinePointSize],
+			expectedError: utils.ErrorBabyJubJubCurveInvalidInputLength,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			precompile := BabyJubJubCurveMul{}
+
+			var actual []byte
+			var err error
+
+			assert.NotPanics(t, func() {
+				actual, err = precompile.run(tt.input)
+			})
+
+			assert.Nil(t, actual)
+			assert.True(t, errors.Is(err, tt.expectedError))
+		})
+	}
+}
+
 func TestRunProperties(t *testing.T) {
 	parameters := gopter.DefaultTestParameters()
 	properties := gopter.NewProperties(parameters)
@@ -159,3 +231,29 @@ func TestRunProperties(t *testing.T) {
 
 	properties.TestingRun(t)
 }
+
+func BenchmarkMul(b *testing.B) {
+	point, _ := utils.BabyJubJubPointGenerator().Sample()
+	scalar, _ := utils.ScalarGenerator().Sample()
+	input := append(
+		utils.MarshalPoint(point.(*babyjub.Point)),
+		scalar.(*big.Int).FillBytes(make([]byte, utils.BabyJubJubCurveFieldByteSize))...,
+	)
+
+	commontest.BenchmarkGasRatio(b, &BabyJubJubCurveMul{}, input)
+}
+
+func FuzzBabyJubJubMul(f *testing.F) {
+	f.Add(append(utils.MarshalPoint(babyjub.B8), big.NewInt(1234).FillBytes(make([]byte, utils.BabyJubJubCurveFieldByteSize))...))
+	f.Add([]byte{0x00})
+
+	f.Fuzz(func(t *testing.T, input []byte) {
+		precompile := BabyJubJubCurveMul{}
+
+		actual, err := precompile.Run(input)
+
+		if err == nil {
+			assert.LessOrEqual(t, len(actual), precompile.MaxOutputSize(input))
+		}
+	})
+}
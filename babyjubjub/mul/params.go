@@ -28,4 +28,50 @@ const (
 	// BabyJubJubCurveMulGas is the gas cost estimate for executing the
 	// BabyJubJub scalar multiplication precompile in Ethereum.
 	BabyJubJubCurveMulGas uint64 = 14400
+
+	// BabyJubJubCurveMulCompressedInputSize defines the fixed byte length of the
+	// input to the compressed BabyJubJub scalar multiplication precompile.
+	//
+	// The input consists of:
+	//   - One compressed point (see utils.MarshalPointCompressed)
+	//   - One scalar field element
+	//
+	// Total layout:
+	//   compressed point || scalar
+	BabyJubJubCurveMulCompressedInputSize = utils.BabyJubJubCurveCompressedPointSize + utils.BabyJubJubCurveFieldByteSize
+
+	// BabyJubJubCurveMulCompressedOutputSize defines the fixed byte length of
+	// the output of the compressed BabyJubJub scalar multiplication precompile.
+	//
+	// The output is a single compressed point.
+	BabyJubJubCurveMulCompressedOutputSize = utils.BabyJubJubCurveCompressedPointSize
+
+	// BabyJubJubCurveMulCompressedGas is the gas cost estimate for executing
+	// the compressed BabyJubJub scalar multiplication precompile in Ethereum.
+	//
+	// It extends BabyJubJubCurveMulGas with the modular square root needed
+	// to decompress the input point.
+	BabyJubJubCurveMulCompressedGas uint64 = BabyJubJubCurveMulGas + 1600
+
+	// BabyJubJubCofactor is the cofactor h of the BabyJubJub curve: the full
+	// group has order h * SubOrder.
+	BabyJubJubCofactor = 8
+
+	// BabyJubJubClearCofactorInputSize defines the fixed byte length of the
+	// input to the cofactor clearing precompile. The input consists of a
+	// single affine point serialized as X || Y.
+	BabyJubJubClearCofactorInputSize = utils.BabyJubJubCurveAffinePointSize
+
+	// BabyJubJubClearCofactorOutputSize defines the fixed byte length of the
+	// output of the cofactor clearing precompile. The output is a single
+	// affine point serialized as X || Y.
+	BabyJubJubClearCofactorOutputSize = utils.BabyJubJubCurveAffinePointSize
+
+	// BabyJubJubClearCofactorGas is the gas cost estimate for executing the
+	// cofactor clearing precompile in Ethereum.
+	//
+	// Multiplying by the cofactor takes three point doublings, and no
+	// subgroup check is performed, so the cost is far below
+	// BabyJubJubCurveMulGas.
+	BabyJubJubClearCofactorGas uint64 = 3000
 )
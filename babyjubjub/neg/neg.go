@@ -0,0 +1,80 @@
+package neg
+
+import (
+	"math/big"
+
+	"github.com/iden3/go-iden3-crypto/babyjub"
+	"github.com/privacy-ethereum/privacy-precompiles/babyjubjub/utils"
+	"github.com/privacy-ethereum/privacy-precompiles/common"
+)
+
+// BabyJubJubCurveNeg implements the BabyJubJub point negation precompile.
+//
+// It satisfies the common.Precompile interface and can be used in a generic
+// precompile execution framework.
+type BabyJubJubCurveNeg struct{}
+
+// Name returns the human-readable name of the precompile.
+func (c *BabyJubJubCurveNeg) Name() string {
+	return "BabyJubJubCurveNeg"
+}
+
+// RequiredGas returns the fixed gas cost of executing this precompile.
+//
+// For BabyJubJub point negation, the gas cost is BabyJubJubCurveNegGas.
+func (c *BabyJubJubCurveNeg) RequiredGas(input []byte) uint64 {
+	return BabyJubJubCurveNegGas
+}
+
+// MaxOutputSize returns the byte length of the negated affine point returned by Run,
+// which is BabyJubJubCurveNegOutputSize regardless of the input.
+func (c *BabyJubJubCurveNeg) MaxOutputSize(input []byte) int {
+	return BabyJubJubCurveNegOutputSize
+}
+
+// Run executes the BabyJubJub point negation precompile.
+//
+// The input must be exactly BabyJubJubCurveNegInputSize bytes, which encode
+// a single affine point in the format:
+//
+//	x || y
+//
+// Each coordinate is a big-endian field element padded to BabyJubJubFieldByteSize bytes.
+//
+// On the twisted Edwards BabyJubJub curve the negation of (x, y) is (-x, y).
+//
+// Run performs the following steps:
+//  1. Parses the point from input using utils.ReadAffinePoint.
+//  2. Validates that the point lies on the BabyJubJub curve.
+//  3. Computes x' = (FieldPrime - x) mod FieldPrime, leaving y unchanged.
+//  4. Returns the resulting affine point serialized with utils.MarshalPoint.
+//
+// Returns an error if:
+//   - The input length is incorrect.
+//   - The point is not on the curve.
+func (c *BabyJubJubCurveNeg) Run(input []byte) ([]byte, error) {
+	if len(input) != BabyJubJubCurveNegInputSize {
+		return nil, utils.ErrorBabyJubJubCurveInvalidInputLength
+	}
+
+	point, err := utils.ReadAffinePoint(input, 0)
+
+	if err != nil {
+		return nil, utils.ErrorBabyJubJubCurveInvalidInputLength
+	}
+
+	if !point.InCurve() {
+		return nil, utils.ErrorBabyJubJubCurvePointNotOnCurve
+	}
+
+	x := new(big.Int).Sub(utils.FieldPrime, point.X)
+	x.Mod(x, utils.FieldPrime)
+
+	return utils.MarshalPoint(&babyjub.Point{X: x, Y: point.Y}), nil
+}
+
+// Ensure BabyJubJubCurveNeg implements the common.Precompile interface.
+var _ common.Precompile = (*BabyJubJubCurveNeg)(nil)
+
+// Ensure BabyJubJubCurveNeg implements the common.OutputSizer interface.
+var _ common.OutputSizer = (*BabyJubJubCurveNeg)(nil)
@@ -0,0 +1,153 @@
+package neg
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	"github.com/iden3/go-iden3-crypto/babyjub"
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/prop"
+	"github.com/privacy-ethereum/privacy-precompiles/babyjubjub/add"
+	"github.com/privacy-ethereum/privacy-precompiles/babyjubjub/utils"
+	"github.com/privacy-ethereum/privacy-precompiles/common"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBabyJubJubCurveNegName(t *testing.T) {
+	precompile := BabyJubJubCurveNeg{}
+
+	expected := "BabyJubJubCurveNeg"
+	actual := precompile.Name()
+
+	assert.Equal(t, expected, actual)
+}
+
+func TestBabyJubJubCurveNegMaxOutputSize(t *testing.T) {
+	precompile := BabyJubJubCurveNeg{}
+
+	var sizer common.OutputSizer = &precompile
+
+	assert.Equal(t, utils.BabyJubJubCurveAffinePointSize, sizer.MaxOutputSize(nil))
+}
+
+func TestNegPoint(t *testing.T) {
+	tests := []struct {
+		name          string
+		input         []byte
+		expected      *babyjub.Point
+		expectedError error
+	}{
+		{
+			name:     "identity negates to itself",
+			input:    utils.MarshalPoint(babyjub.NewPoint()),
+			expected: babyjub.NewPoint(),
+		},
+		{
+			name:  "base point",
+			input: utils.MarshalPoint(babyjub.B8),
+			expected: &babyjub.Point{
+				X: new(big.Int).Sub(utils.FieldPrime, babyjub.B8.X),
+				Y: babyjub.B8.Y,
+			},
+		},
+		{
+			name: "point not in subgroup",
+			input: utils.MarshalPoint(&babyjub.Point{
+				X: big.NewInt(0),
+				Y: new(big.Int).Sub(utils.FieldPrime, big.NewInt(1)),
+			}),
+			expected: &babyjub.Point{
+				X: big.NewInt(0),
+				Y: new(big.Int).Sub(utils.FieldPrime, big.NewInt(1)),
+			},
+		},
+		{
+			name:          "point not on curve",
+			input:         utils.MarshalPoint(&babyjub.Point{X: big.NewInt(123), Y: big.NewInt(456)}),
+			expectedError: utils.ErrorBabyJubJubCurvePointNotOnCurve,
+		},
+		{
+			name:          "input too short",
+			input:         make([]byte, BabyJubJubCurveNegInputSize-1),
+			expectedError: utils.ErrorBabyJubJubCurveInvalidInputLength,
+		},
+		{
+			name:          "empty input",
+			input:         []byte{},
+			expectedError: utils.ErrorBabyJubJubCurveInvalidInputLength,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			precompile := BabyJubJubCurveNeg{}
+
+			actual, err := precompile.Run(tt.input)
+			gas := precompile.RequiredGas(tt.input)
+
+			if tt.expectedError != nil {
+				assert.Equal(t, tt.expectedError, err)
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			assert.Equal(t, utils.MarshalPoint(tt.expected), actual)
+			assert.Equal(t, BabyJubJubCurveNegGas, gas)
+		})
+	}
+}
+
+func TestRunProperties(t *testing.T) {
+	parameters := gopter.DefaultTestParameters()
+	properties := gopter.NewProperties(parameters)
+
+	properties.Property("Add(P, Neg(P)) is the identity", prop.ForAll(
+		func(point *babyjub.Point) bool {
+			negPrecompile := BabyJubJubCurveNeg{}
+			addPrecompile := add.BabyJubJubCurveAdd{}
+
+			negated, err := negPrecompile.Run(utils.MarshalPoint(point))
+
+			if err != nil {
+				return false
+			}
+
+			result, err := addPrecompile.Run(append(utils.MarshalPoint(point), negated...))
+
+			if err != nil {
+				return false
+			}
+
+			return bytes.Equal(result, utils.MarshalPoint(babyjub.NewPoint()))
+		},
+		utils.BabyJubJubPointGenerator(),
+	))
+
+	properties.Property("Neg(Neg(P)) is P", prop.ForAll(
+		func(point *babyjub.Point) bool {
+			precompile := BabyJubJubCurveNeg{}
+
+			negated, err := precompile.Run(utils.MarshalPoint(point))
+
+			if err != nil {
+				return false
+			}
+
+			result, err := precompile.Run(negated)
+
+			if err != nil {
+				return false
+			}
+
+			return bytes.Equal(result, utils.MarshalPoint(point))
+		},
+		utils.BabyJubJubPointGenerator(),
+	))
+
+	properties.TestingRun(t)
+}
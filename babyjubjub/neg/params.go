@@ -0,0 +1,23 @@
+package neg
+
+import "github.com/privacy-ethereum/privacy-precompiles/babyjubjub/utils"
+
+// BabyJubJub neg precompile constants
+const (
+	// BabyJubJubCurveNegInputSize defines the fixed byte length of the input
+	// to the BabyJubJub point negation precompile. The input consists of a
+	// single affine point serialized as X || Y.
+	BabyJubJubCurveNegInputSize = utils.BabyJubJubCurveAffinePointSize
+
+	// BabyJubJubCurveNegOutputSize defines the fixed byte length of the output
+	// of the BabyJubJub point negation precompile. The output is a single
+	// affine point serialized as X || Y.
+	BabyJubJubCurveNegOutputSize = utils.BabyJubJubCurveAffinePointSize
+
+	// BabyJubJubCurveNegGas is the gas cost estimate for executing the
+	// BabyJubJub negation precompile in Ethereum.
+	//
+	// Negation is a single field subtraction, so the cost is dominated by
+	// the curve equation check.
+	BabyJubJubCurveNegGas uint64 = 3000
+)
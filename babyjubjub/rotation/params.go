@@ -0,0 +1,49 @@
+package rotation
+
+import (
+	"errors"
+
+	"github.com/privacy-ethereum/privacy-precompiles/babyjubjub/eddsa"
+	"github.com/privacy-ethereum/privacy-precompiles/babyjubjub/utils"
+	"github.com/privacy-ethereum/privacy-precompiles/babyjubjub/validation"
+	"github.com/privacy-ethereum/privacy-precompiles/poseidon"
+)
+
+// BabyJubJub key rotation precompile constants
+const (
+	// BabyJubJubKeyRotationVerifyInputSize defines the fixed byte length of the
+	// input to the BabyJubJub key rotation verification precompile.
+	//
+	// The input consists of:
+	//   - Old public key point serialized as OldAx || OldAy
+	//   - New public key point serialized as NewAx || NewAy
+	//   - Signature by the old key serialized as R8x || R8y || S
+	//
+	// Total layout:
+	//   OldAx || OldAy || NewAx || NewAy || R8x || R8y || S
+	//
+	// Total size:
+	//   7 * utils.BabyJubJubCurveFieldByteSize
+	BabyJubJubKeyRotationVerifyInputSize = 7 * utils.BabyJubJubCurveFieldByteSize
+
+	// BabyJubJubKeyRotationVerifyGas defines the fixed gas cost for executing
+	// the BabyJubJub key rotation verification precompile.
+	//
+	// This cost reflects:
+	//   - Validation of the new public key
+	//   - One Poseidon hash of the new public key
+	//   - One EdDSA signature verification by the old public key
+	BabyJubJubKeyRotationVerifyGas = validation.BabyJubJubCurveValidatePointGas +
+		poseidon.PoseidonBaseGas + 2*poseidon.PoseidonPerWordGas +
+		eddsa.BabyJubJubCurveEdDSAVerifyGas
+)
+
+var (
+	// ErrorBabyJubJubKeyRotationVerifyInvalidInputLength is returned when the
+	// input byte slice does not exactly match BabyJubJubKeyRotationVerifyInputSize.
+	ErrorBabyJubJubKeyRotationVerifyInvalidInputLength = errors.New("invalid input length")
+
+	// ErrorBabyJubJubKeyRotationVerifyInvalidNewPublicKey is returned when the
+	// new public key is not on the curve or not in the prime-order subgroup.
+	ErrorBabyJubJubKeyRotationVerifyInvalidNewPublicKey = errors.New("invalid new public key")
+)
@@ -0,0 +1,97 @@
+package rotation
+
+import (
+	"math/big"
+
+	"github.com/iden3/go-iden3-crypto/poseidon"
+	"github.com/privacy-ethereum/privacy-precompiles/babyjubjub/eddsa"
+	"github.com/privacy-ethereum/privacy-precompiles/babyjubjub/utils"
+	"github.com/privacy-ethereum/privacy-precompiles/common"
+)
+
+// BabyJubJubKeyRotationVerify implements a precompile verifying that a
+// BabyJubJub key rotation was authorized by the old key.
+//
+// It satisfies the common.Precompile interface and can be used in a generic
+// precompile execution framework, e.g. by account-abstraction wallets that
+// rotate their signing key.
+//
+// The authorization message signed by the old key is:
+//
+//	M = poseidon(NewAx, NewAy)
+//
+// and the signature is a standard Poseidon-based BabyJubJub EdDSA signature,
+// verified with eddsa.BabyJubJubCurveEdDSAVerify.
+type BabyJubJubKeyRotationVerify struct{}
+
+// Name returns the human-readable name of the precompile.
+func (c *BabyJubJubKeyRotationVerify) Name() string {
+	return "BabyJubJubKeyRotationVerify"
+}
+
+// RequiredGas returns the fixed gas cost of executing this precompile.
+//
+// For key rotation verification, the gas cost is BabyJubJubKeyRotationVerifyGas.
+func (c *BabyJubJubKeyRotationVerify) RequiredGas(input []byte) uint64 {
+	return BabyJubJubKeyRotationVerifyGas
+}
+
+// Run executes the key rotation verification precompile.
+//
+// The input must be exactly BabyJubJubKeyRotationVerifyInputSize bytes, which encode:
+//
+//	OldAx || OldAy || NewAx || NewAy || R8x || R8y || S
+//
+// Each coordinate or scalar is encoded as a big-endian field element, padded
+// to utils.BabyJubJubCurveFieldByteSize bytes.
+//
+// Run performs the following steps:
+//  1. Validates that the input length equals BabyJubJubKeyRotationVerifyInputSize.
+//  2. Parses the new public key and verifies it is in the subgroup.
+//  3. Computes the authorization message M = poseidon(NewAx, NewAy).
+//  4. Verifies the signature (R8, S) over M against the old public key.
+//  5. Returns []byte{1} if the rotation is authorized, []byte{0} otherwise.
+//
+// Returns an error if:
+//   - The input length is invalid.
+//   - The new public key is not on the curve or not in the subgroup.
+//   - The old public key, R8 or S are rejected by the EdDSA precompile.
+func (c *BabyJubJubKeyRotationVerify) Run(input []byte) ([]byte, error) {
+	if len(input) != BabyJubJubKeyRotationVerifyInputSize {
+		return nil, ErrorBabyJubJubKeyRotationVerifyInvalidInputLength
+	}
+
+	newPublicKey, err := utils.ReadAffinePoint(input, 1)
+
+	if err != nil {
+		return nil, ErrorBabyJubJubKeyRotationVerifyInvalidInputLength
+	}
+
+	if !newPublicKey.InSubGroup() {
+		return nil, ErrorBabyJubJubKeyRotationVerifyInvalidNewPublicKey
+	}
+
+	message, err := AuthorizationMessage(newPublicKey.X, newPublicKey.Y)
+
+	if err != nil {
+		return nil, err
+	}
+
+	eddsaInput := make([]byte, 0, eddsa.BabyJubJubCurveEdDSAVerifyInputSize)
+	eddsaInput = append(eddsaInput, input[:utils.BabyJubJubCurveAffinePointSize]...)
+	eddsaInput = append(eddsaInput, input[2*utils.BabyJubJubCurveAffinePointSize:]...)
+	eddsaInput = append(eddsaInput, message.FillBytes(make([]byte, utils.BabyJubJubCurveFieldByteSize))...)
+
+	return (&eddsa.BabyJubJubCurveEdDSAVerify{}).Run(eddsaInput)
+}
+
+// AuthorizationMessage returns the message that the old key must sign to
+// authorize a rotation to the new public key (x, y):
+//
+//	poseidon(x, y)
+func AuthorizationMessage(x, y *big.Int) (*big.Int, error) {
+	return poseidon.Hash([]*big.Int{x, y})
+}
+
+// Ensure BabyJubJubKeyRotationVerify implements the common.Precompile interface.
+var _ common.Precompile = (*BabyJubJubKeyRotationVerify)(nil)
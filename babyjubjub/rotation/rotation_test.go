@@ -0,0 +1,152 @@
+package rotation
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	"github.com/iden3/go-iden3-crypto/babyjub"
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/prop"
+	"github.com/privacy-ethereum/privacy-precompiles/babyjubjub/eddsa"
+	"github.com/privacy-ethereum/privacy-precompiles/babyjubjub/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBabyJubJubKeyRotationVerifyName(t *testing.T) {
+	precompile := BabyJubJubKeyRotationVerify{}
+
+	expected := "BabyJubJubKeyRotationVerify"
+	actual := precompile.Name()
+
+	assert.Equal(t, expected, actual)
+}
+
+func TestKeyRotationVerify(t *testing.T) {
+	oldKey := privateKey(1234)
+	newKey := privateKey(5678)
+	otherKey := privateKey(9999)
+
+	tests := []struct {
+		name          string
+		input         []byte
+		expected      []byte
+		expectedError error
+	}{
+		{
+			name:     "rotation authorized by old key",
+			input:    prepareInput(oldKey.Public(), newKey.Public(), &oldKey),
+			expected: []byte{1},
+		},
+		{
+			name:     "rotation signed by another key",
+			input:    prepareInput(oldKey.Public(), newKey.Public(), &otherKey),
+			expected: []byte{0},
+		},
+		{
+			name: "signature authorizes a different new key",
+			input: func() []byte {
+				input := prepareInput(oldKey.Public(), otherKey.Public(), &oldKey)
+				copy(input[utils.BabyJubJubCurveAffinePointSize:], utils.MarshalPoint(newKey.Public().Point()))
+
+				return input
+			}(),
+			expected: []byte{0},
+		},
+		{
+			name: "new public key not in subgroup",
+			input: func() []byte {
+				input := prepareInput(oldKey.Public(), newKey.Public(), &oldKey)
+				copy(input[utils.BabyJubJubCurveAffinePointSize:], utils.MarshalPoint(&babyjub.Point{
+					X: big.NewInt(0),
+					Y: new(big.Int).Sub(utils.FieldPrime, big.NewInt(1)),
+				}))
+
+				return input
+			}(),
+			expectedError: ErrorBabyJubJubKeyRotationVerifyInvalidNewPublicKey,
+		},
+		{
+			name: "old public key not on curve",
+			input: func() []byte {
+				input := prepareInput(oldKey.Public(), newKey.Public(), &oldKey)
+				copy(input, utils.MarshalPoint(&babyjub.Point{X: big.NewInt(123), Y: big.NewInt(456)}))
+
+				return input
+			}(),
+			expectedError: eddsa.ErrorBabyJubJubCurveEdDSAVerifyPublicKeyIsNotOnCurve,
+		},
+		{
+			name:          "invalid input length",
+			input:         make([]byte, BabyJubJubKeyRotationVerifyInputSize-1),
+			expectedError: ErrorBabyJubJubKeyRotationVerifyInvalidInputLength,
+		},
+		{
+			name:          "empty input",
+			input:         []byte{},
+			expectedError: ErrorBabyJubJubKeyRotationVerifyInvalidInputLength,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			precompile := BabyJubJubKeyRotationVerify{}
+
+			actual, err := precompile.Run(tt.input)
+			gas := precompile.RequiredGas(tt.input)
+
+			if tt.expectedError != nil {
+				assert.NotNil(t, err)
+				assert.Equal(t, tt.expectedError, err)
+
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, BabyJubJubKeyRotationVerifyGas, gas)
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func TestRunProperties(t *testing.T) {
+	parameters := gopter.DefaultTestParameters()
+	properties := gopter.NewProperties(parameters)
+
+	properties.Property("Run accepts any rotation signed by the old key", prop.ForAll(
+		func(oldKey, newKey babyjub.PrivateKey) bool {
+			precompile := BabyJubJubKeyRotationVerify{}
+
+			result, err := precompile.Run(prepareInput(oldKey.Public(), newKey.Public(), &oldKey))
+
+			if err != nil {
+				return false
+			}
+
+			return bytes.Equal(result, []byte{1})
+		},
+		utils.PrivateKeyGenerator(),
+		utils.PrivateKeyGenerator(),
+	))
+
+	properties.TestingRun(t)
+}
+
+func privateKey(value int64) babyjub.PrivateKey {
+	var key babyjub.PrivateKey
+	big.NewInt(value).FillBytes(key[:])
+
+	return key
+}
+
+func prepareInput(oldPublicKey, newPublicKey *babyjub.PublicKey, signer *babyjub.PrivateKey) []byte {
+	message, _ := AuthorizationMessage(newPublicKey.X, newPublicKey.Y)
+	signature := signer.SignPoseidon(message)
+
+	input := make([]byte, 0, BabyJubJubKeyRotationVerifyInputSize)
+	input = append(input, utils.MarshalPoint(oldPublicKey.Point())...)
+	input = append(input, utils.MarshalPoint(newPublicKey.Point())...)
+	input = append(input, utils.MarshalPoint(signature.R8)...)
+
+	return append(input, signature.S.FillBytes(make([]byte, utils.BabyJubJubCurveFieldByteSize))...)
+}
@@ -0,0 +1,26 @@
+package scalar
+
+import "github.com/privacy-ethereum/privacy-precompiles/babyjubjub/utils"
+
+// BabyJubJub scalar reduction precompile constants
+const (
+	// BabyJubJubReduceScalarInputSize defines the fixed byte length of the
+	// input to the scalar reduction precompile: a 512-bit big-endian integer,
+	// typically the concatenation of two 32-byte hash outputs.
+	//
+	// Reducing a value twice as wide as the subgroup order keeps the bias of
+	// the result below 2^-250, so hash output maps to near-uniform scalars.
+	BabyJubJubReduceScalarInputSize = 2 * utils.BabyJubJubCurveFieldByteSize
+
+	// BabyJubJubReduceScalarOutputSize defines the fixed byte length of the
+	// output of the scalar reduction precompile: a single scalar encoded as
+	// a big-endian field element.
+	BabyJubJubReduceScalarOutputSize = utils.BabyJubJubCurveFieldByteSize
+
+	// BabyJubJubReduceScalarGas is the gas cost estimate for executing the
+	// scalar reduction precompile in Ethereum.
+	//
+	// The precompile performs a single modular reduction and no curve
+	// arithmetic.
+	BabyJubJubReduceScalarGas uint64 = 100
+)
@@ -0,0 +1,61 @@
+package scalar
+
+import (
+	"math/big"
+
+	"github.com/privacy-ethereum/privacy-precompiles/babyjubjub/utils"
+	"github.com/privacy-ethereum/privacy-precompiles/common"
+)
+
+// BabyJubJubReduceScalar implements the BabyJubJub scalar reduction
+// precompile.
+//
+// It maps a wide value, such as hash output used for nonce derivation, to a
+// scalar of the prime-order subgroup.
+type BabyJubJubReduceScalar struct{}
+
+// Name returns the human-readable name of the precompile.
+func (c *BabyJubJubReduceScalar) Name() string {
+	return "BabyJubJubReduceScalar"
+}
+
+// RequiredGas returns the fixed gas cost of executing this precompile.
+//
+// For BabyJubJub scalar reduction, the gas cost is BabyJubJubReduceScalarGas.
+func (c *BabyJubJubReduceScalar) RequiredGas(input []byte) uint64 {
+	return BabyJubJubReduceScalarGas
+}
+
+// MaxOutputSize returns the byte length of the scalar returned by Run,
+// which is BabyJubJubReduceScalarOutputSize regardless of the input.
+func (c *BabyJubJubReduceScalar) MaxOutputSize(input []byte) int {
+	return BabyJubJubReduceScalarOutputSize
+}
+
+// Run executes the BabyJubJub scalar reduction precompile.
+//
+// The input must be exactly BabyJubJubReduceScalarInputSize bytes holding a
+// 512-bit big-endian integer v.
+//
+// Run returns v mod SubOrder encoded as a big-endian field element padded
+// to BabyJubJubReduceScalarOutputSize bytes. Inputs below SubOrder are
+// returned unchanged.
+//
+// Returns an error if:
+//   - The input length is incorrect (ErrorBabyJubJubCurveInvalidInputLength).
+func (c *BabyJubJubReduceScalar) Run(input []byte) ([]byte, error) {
+	if len(input) != BabyJubJubReduceScalarInputSize {
+		return nil, utils.ErrorBabyJubJubCurveInvalidInputLength
+	}
+
+	value := new(big.Int).SetBytes(input)
+	value.Mod(value, utils.SubOrder)
+
+	return value.FillBytes(make([]byte, BabyJubJubReduceScalarOutputSize)), nil
+}
+
+// Ensure BabyJubJubReduceScalar implements the common.Precompile interface.
+var _ common.Precompile = (*BabyJubJubReduceScalar)(nil)
+
+// Ensure BabyJubJubReduceScalar implements the common.OutputSizer interface.
+var _ common.OutputSizer = (*BabyJubJubReduceScalar)(nil)
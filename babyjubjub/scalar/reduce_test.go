@@ -0,0 +1,142 @@
+package scalar
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/gen"
+	"github.com/leanovate/gopter/prop"
+	"github.com/privacy-ethereum/privacy-precompiles/babyjubjub/utils"
+	"github.com/privacy-ethereum/privacy-precompiles/common"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBabyJubJubReduceScalarName(t *testing.T) {
+	precompile := BabyJubJubReduceScalar{}
+
+	expected := "BabyJubJubReduceScalar"
+	actual := precompile.Name()
+
+	assert.Equal(t, expected, actual)
+}
+
+func TestBabyJubJubReduceScalarMaxOutputSize(t *testing.T) {
+	precompile := BabyJubJubReduceScalar{}
+
+	var sizer common.OutputSizer = &precompile
+
+	assert.Equal(t, utils.BabyJubJubCurveFieldByteSize, sizer.MaxOutputSize(nil))
+}
+
+func TestReduceScalar(t *testing.T) {
+	wide := func(value *big.Int) []byte {
+		return value.FillBytes(make([]byte, BabyJubJubReduceScalarInputSize))
+	}
+
+	scalar := func(value *big.Int) []byte {
+		return value.FillBytes(make([]byte, BabyJubJubReduceScalarOutputSize))
+	}
+
+	maxValue := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 8*BabyJubJubReduceScalarInputSize), big.NewInt(1))
+
+	tests := []struct {
+		name          string
+		input         []byte
+		expected      []byte
+		expectedError error
+	}{
+		{
+			name:     "zero",
+			input:    wide(big.NewInt(0)),
+			expected: scalar(big.NewInt(0)),
+		},
+		{
+			name:     "largest canonical scalar passes through",
+			input:    wide(new(big.Int).Sub(utils.SubOrder, big.NewInt(1))),
+			expected: scalar(new(big.Int).Sub(utils.SubOrder, big.NewInt(1))),
+		},
+		{
+			name:     "subgroup order reduces to zero",
+			input:    wide(utils.SubOrder),
+			expected: scalar(big.NewInt(0)),
+		},
+		{
+			name:     "subgroup order plus one reduces to one",
+			input:    wide(new(big.Int).Add(utils.SubOrder, big.NewInt(1))),
+			expected: scalar(big.NewInt(1)),
+		},
+		{
+			name:     "largest 512-bit value",
+			input:    wide(maxValue),
+			expected: scalar(new(big.Int).Mod(maxValue, utils.SubOrder)),
+		},
+		{
+			name:          "empty input",
+			input:         []byte{},
+			expectedError: utils.ErrorBabyJubJubCurveInvalidInputLength,
+		},
+		{
+			name:          "32-byte input",
+			input:         make([]byte, utils.BabyJubJubCurveFieldByteSize),
+			expectedError: utils.ErrorBabyJubJubCurveInvalidInputLength,
+		},
+		{
+			name:          "65-byte input",
+			input:         make([]byte, BabyJubJubReduceScalarInputSize+1),
+			expectedError: utils.ErrorBabyJubJubCurveInvalidInputLength,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			precompile := BabyJubJubReduceScalar{}
+
+			actual, err := precompile.Run(tt.input)
+			gas := precompile.RequiredGas(tt.input)
+
+			if tt.expectedError != nil {
+				assert.NotNil(t, err)
+				assert.Equal(t, tt.expectedError, err)
+
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, tt.expected, actual)
+			assert.Equal(t, BabyJubJubReduceScalarGas, gas)
+		})
+	}
+}
+
+func TestReduceScalarProperties(t *testing.T) {
+	parameters := gopter.DefaultTestParameters()
+	properties := gopter.NewProperties(parameters)
+
+	properties.Property("output is always below SubOrder", prop.ForAll(
+		func(input []byte) bool {
+			precompile := BabyJubJubReduceScalar{}
+
+			actual, err := precompile.Run(input)
+
+			return err == nil &&
+				len(actual) == BabyJubJubReduceScalarOutputSize &&
+				new(big.Int).SetBytes(actual).Cmp(utils.SubOrder) < 0
+		},
+		gen.SliceOfN(BabyJubJubReduceScalarInputSize, gen.UInt8()),
+	))
+
+	properties.Property("inputs below SubOrder pass through unchanged", prop.ForAll(
+		func(value *big.Int) bool {
+			precompile := BabyJubJubReduceScalar{}
+
+			actual, err := precompile.Run(value.FillBytes(make([]byte, BabyJubJubReduceScalarInputSize)))
+
+			return err == nil && bytes.Equal(actual, value.FillBytes(make([]byte, BabyJubJubReduceScalarOutputSize)))
+		},
+		utils.ScalarGenerator(),
+	))
+
+	properties.TestingRun(t)
+}
@@ -0,0 +1,61 @@
+package schnorr
+
+import (
+	"errors"
+
+	"github.com/privacy-ethereum/privacy-precompiles/babyjubjub/eddsa"
+	"github.com/privacy-ethereum/privacy-precompiles/babyjubjub/utils"
+)
+
+// BabyJubJub Schnorr precompile constants
+const (
+	// BabyJubJubSchnorrVerifyInputSize defines the fixed byte length of the
+	// input to the BabyJubJub Schnorr signature verification precompile.
+	//
+	// The input consists of:
+	//   - Public key point A serialized as Ax || Ay
+	//   - Commitment point R serialized as Rx || Ry
+	//   - Signature scalar s
+	//   - Message (field element)
+	//
+	// Total layout:
+	//   Ax || Ay || Rx || Ry || s || M
+	//
+	// Total size:
+	//   6 * utils.BabyJubJubCurveFieldByteSize
+	BabyJubJubSchnorrVerifyInputSize = 6 * utils.BabyJubJubCurveFieldByteSize
+
+	// BabyJubJubSchnorrVerifyOutputSize defines the fixed byte length of the
+	// output produced by the BabyJubJub Schnorr signature verification
+	// precompile: a single boolean byte.
+	BabyJubJubSchnorrVerifyOutputSize = 1
+
+	// BabyJubJubSchnorrVerifyGas defines the fixed gas cost of the BabyJubJub
+	// Schnorr signature verification precompile.
+	//
+	// Verification performs the same point validations, Poseidon hash and
+	// scalar multiplications as EdDSA verification, so it is priced the same
+	// as eddsa.BabyJubJubCurveEdDSAVerifyGas.
+	BabyJubJubSchnorrVerifyGas = eddsa.BabyJubJubCurveEdDSAVerifyGas
+)
+
+var (
+	// ErrorBabyJubJubSchnorrVerifyPublicKeyIsNotOnCurve is returned when the
+	// provided public key point is not a valid BabyJubJub curve point, is not
+	// in the prime-order subgroup, or is the identity.
+	ErrorBabyJubJubSchnorrVerifyPublicKeyIsNotOnCurve = errors.New("public key is not on curve")
+
+	// ErrorBabyJubJubSchnorrVerifyRIsNotOnCurve is returned when the
+	// commitment point R is not a valid BabyJubJub curve point or is not in
+	// the prime-order subgroup.
+	ErrorBabyJubJubSchnorrVerifyRIsNotOnCurve = errors.New("r is not on curve")
+
+	// ErrorBabyJubJubSchnorrVerifyInvalidS is returned when the signature
+	// scalar s is greater than or equal to the BabyJubJub subgroup order.
+	ErrorBabyJubJubSchnorrVerifyInvalidS = errors.New("s is greater than suborder")
+
+	// ErrorBabyJubJubSchnorrVerifyMessageNotInField is returned when the
+	// message is greater than or equal to the BN254 scalar field modulus and
+	// therefore cannot be hashed with Poseidon.
+	ErrorBabyJubJubSchnorrVerifyMessageNotInField = errors.New("message is not in field")
+)
@@ -0,0 +1,147 @@
+package schnorr
+
+import (
+	"math/big"
+
+	"github.com/iden3/go-iden3-crypto/babyjub"
+	"github.com/iden3/go-iden3-crypto/poseidon"
+	"github.com/privacy-ethereum/privacy-precompiles/babyjubjub/utils"
+	"github.com/privacy-ethereum/privacy-precompiles/common"
+	commonUtils "github.com/privacy-ethereum/privacy-precompiles/utils"
+)
+
+// BabyJubJubSchnorrVerify implements the BabyJubJub Schnorr signature
+// verification precompile with Poseidon challenges.
+//
+// Unlike BabyJubJubCurveEdDSAVerify, the signature is a plain Schnorr
+// signature (R, s) over the base point B8, with the challenge
+//
+//	e = Poseidon(Rx, Ry, Ax, Ay, M)
+//
+// and the verification equation
+//
+//	[s]B8 == R + [e]A
+//
+// A signer holding the private scalar k, with A = [k]B8, produces a
+// signature by picking a nonce r, setting R = [r]B8 and
+// s = (r + e*k) mod SubOrder.
+type BabyJubJubSchnorrVerify struct{}
+
+// Name returns the human-readable name of the precompile.
+func (c *BabyJubJubSchnorrVerify) Name() string {
+	return "BabyJubJubSchnorrVerify"
+}
+
+// RequiredGas returns the fixed gas cost of executing this precompile.
+//
+// For BabyJubJub Schnorr verification, the gas cost is
+// BabyJubJubSchnorrVerifyGas.
+func (c *BabyJubJubSchnorrVerify) RequiredGas(input []byte) uint64 {
+	return BabyJubJubSchnorrVerifyGas
+}
+
+// MaxOutputSize returns the byte length of the boolean result returned by Run,
+// which is BabyJubJubSchnorrVerifyOutputSize regardless of the input.
+func (c *BabyJubJubSchnorrVerify) MaxOutputSize(input []byte) int {
+	return BabyJubJubSchnorrVerifyOutputSize
+}
+
+// Run executes the Schnorr signature verification precompile.
+//
+// The input must be exactly BabyJubJubSchnorrVerifyInputSize bytes, which encode:
+//
+//	Ax || Ay || Rx || Ry || s || M
+//
+// Where:
+//   - (Ax, Ay) is the public key point A.
+//   - (Rx, Ry) is the commitment point R.
+//   - s is the signature scalar.
+//   - M is the message (field element).
+//
+// Each coordinate or scalar is encoded as a big-endian field element, padded
+// to utils.BabyJubJubCurveFieldByteSize bytes.
+//
+// Run performs the following steps:
+//  1. Validates that the input length equals BabyJubJubSchnorrVerifyInputSize.
+//  2. Parses the public key point and verifies it lies on the curve, in the
+//     prime-order subgroup, and is not the identity.
+//  3. Parses R and verifies it lies on the curve and in the subgroup.
+//  4. Parses s and verifies it is smaller than the subgroup order.
+//  5. Parses M and verifies it is inside the BN254 scalar field.
+//  6. Computes e = Poseidon(Rx, Ry, Ax, Ay, M) over the coordinates reduced
+//     modulo FieldPrime, and reduces e modulo the subgroup order.
+//  7. Returns []byte{1} if [s]B8 == R + [e]A, []byte{0} otherwise.
+//
+// Returns an error if:
+//   - The input length is invalid (utils.ErrorBabyJubJubCurveInvalidInputLength).
+//   - The public key is not a valid subgroup point or is the identity
+//     (ErrorBabyJubJubSchnorrVerifyPublicKeyIsNotOnCurve).
+//   - R is not a valid subgroup point (ErrorBabyJubJubSchnorrVerifyRIsNotOnCurve).
+//   - s is invalid (ErrorBabyJubJubSchnorrVerifyInvalidS).
+//   - M is not in the field (ErrorBabyJubJubSchnorrVerifyMessageNotInField).
+func (c *BabyJubJubSchnorrVerify) Run(input []byte) ([]byte, error) {
+	if len(input) != BabyJubJubSchnorrVerifyInputSize {
+		return nil, utils.ErrorBabyJubJubCurveInvalidInputLength
+	}
+
+	publicKey, err := utils.ReadAffinePoint(input, 0)
+
+	if err != nil {
+		return nil, utils.ErrorBabyJubJubCurveInvalidInputLength
+	}
+
+	// The identity is rejected since any R = [s]B8 verifies against it.
+	if !publicKey.InCurve() || !publicKey.InSubGroup() || utils.IsIdentity(publicKey) {
+		return nil, ErrorBabyJubJubSchnorrVerifyPublicKeyIsNotOnCurve
+	}
+
+	R, err := utils.ReadAffinePoint(input, 1)
+
+	if err != nil {
+		return nil, utils.ErrorBabyJubJubCurveInvalidInputLength
+	}
+
+	if !R.InCurve() || !R.InSubGroup() {
+		return nil, ErrorBabyJubJubSchnorrVerifyRIsNotOnCurve
+	}
+
+	offset := 2 * utils.BabyJubJubCurveAffinePointSize
+
+	s, offset := commonUtils.ReadField(input, offset, utils.BabyJubJubCurveFieldByteSize)
+
+	if !utils.IsValidScalar(s) {
+		return nil, ErrorBabyJubJubSchnorrVerifyInvalidS
+	}
+
+	message, _ := commonUtils.ReadField(input, offset, utils.BabyJubJubCurveFieldByteSize)
+
+	if message.Cmp(utils.FieldPrime) >= 0 {
+		return nil, ErrorBabyJubJubSchnorrVerifyMessageNotInField
+	}
+
+	e, _ := poseidon.Hash([]*big.Int{
+		new(big.Int).Mod(R.X, utils.FieldPrime),
+		new(big.Int).Mod(R.Y, utils.FieldPrime),
+		new(big.Int).Mod(publicKey.X, utils.FieldPrime),
+		new(big.Int).Mod(publicKey.Y, utils.FieldPrime),
+		message,
+	})
+
+	left := babyjub.NewPoint().Mul(s, babyjub.B8)
+	right := babyjub.NewPoint().Projective().Add(
+		R.Projective(),
+		babyjub.NewPoint().Mul(utils.ReduceScalar(e), publicKey).Projective(),
+	).Affine()
+
+	if left.X.Cmp(right.X) == 0 && left.Y.Cmp(right.Y) == 0 {
+		return []byte{1}, nil
+	}
+
+	return []byte{0}, nil
+}
+
+// Ensure BabyJubJubSchnorrVerify implements the common.Precompile interface.
+var _ common.Precompile = (*BabyJubJubSchnorrVerify)(nil)
+
+// Ensure BabyJubJubSchnorrVerify implements the common.OutputSizer interface.
+var _ common.OutputSizer = (*BabyJubJubSchnorrVerify)(nil)
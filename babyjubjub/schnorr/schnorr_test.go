@@ -0,0 +1,210 @@
+package schnorr
+
+import (
+	"math/big"
+	"slices"
+	"testing"
+
+	"github.com/iden3/go-iden3-crypto/babyjub"
+	"github.com/iden3/go-iden3-crypto/poseidon"
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/prop"
+	"github.com/privacy-ethereum/privacy-precompiles/babyjubjub/utils"
+	"github.com/privacy-ethereum/privacy-precompiles/common"
+	"github.com/stretchr/testify/assert"
+)
+
+// sign produces the Schnorr signature input of BabyJubJubSchnorrVerify for
+// the private scalar key, the nonce and the message.
+func sign(key, nonce, message *big.Int) []byte {
+	publicKey := babyjub.NewPoint().Mul(key, babyjub.B8)
+	R := babyjub.NewPoint().Mul(nonce, babyjub.B8)
+
+	e, _ := poseidon.Hash([]*big.Int{R.X, R.Y, publicKey.X, publicKey.Y, message})
+
+	s := new(big.Int).Mul(utils.ReduceScalar(e), key)
+	s.Add(s, nonce)
+	s.Mod(s, utils.SubOrder)
+
+	return slices.Concat(
+		utils.MarshalPoint(publicKey),
+		utils.MarshalPoint(R),
+		s.FillBytes(make([]byte, utils.BabyJubJubCurveFieldByteSize)),
+		message.FillBytes(make([]byte, utils.BabyJubJubCurveFieldByteSize)),
+	)
+}
+
+func TestBabyJubJubSchnorrVerifyName(t *testing.T) {
+	precompile := BabyJubJubSchnorrVerify{}
+
+	expected := "BabyJubJubSchnorrVerify"
+	actual := precompile.Name()
+
+	assert.Equal(t, expected, actual)
+}
+
+func TestBabyJubJubSchnorrVerifyMaxOutputSize(t *testing.T) {
+	precompile := BabyJubJubSchnorrVerify{}
+
+	var sizer common.OutputSizer = &precompile
+
+	assert.Equal(t, 1, sizer.MaxOutputSize(nil))
+}
+
+func TestSchnorrVerify(t *testing.T) {
+	valid := sign(big.NewInt(1234), big.NewInt(5678), big.NewInt(42))
+
+	// with replaces the field at index in a copy of valid.
+	with := func(index int, value []byte) []byte {
+		input := slices.Clone(valid)
+		copy(input[index*utils.BabyJubJubCurveFieldByteSize:], value)
+
+		return input
+	}
+
+	field := func(value *big.Int) []byte {
+		return value.FillBytes(make([]byte, utils.BabyJubJubCurveFieldByteSize))
+	}
+
+	notOnCurve := utils.MarshalPoint(&babyjub.Point{X: big.NewInt(1), Y: big.NewInt(1)})
+	notInSubgroup := utils.MarshalPoint(&babyjub.Point{
+		X: big.NewInt(0),
+		Y: new(big.Int).Sub(utils.FieldPrime, big.NewInt(1)), // p - 1 == -1 mod p
+	})
+
+	tests := []struct {
+		name          string
+		input         []byte
+		expected      []byte
+		expectedError error
+	}{
+		{
+			name:     "valid signature",
+			input:    valid,
+			expected: []byte{1},
+		},
+		{
+			name:     "wrong message",
+			input:    with(5, field(big.NewInt(43))),
+			expected: []byte{0},
+		},
+		{
+			name:     "wrong s",
+			input:    with(4, field(big.NewInt(1))),
+			expected: []byte{0},
+		},
+		{
+			name:     "wrong R",
+			input:    with(2, utils.MarshalPoint(babyjub.B8)),
+			expected: []byte{0},
+		},
+		{
+			name:     "wrong public key",
+			input:    with(0, utils.MarshalPoint(babyjub.B8)),
+			expected: []byte{0},
+		},
+		{
+			name:          "public key is not on curve",
+			input:         with(0, notOnCurve),
+			expectedError: ErrorBabyJubJubSchnorrVerifyPublicKeyIsNotOnCurve,
+		},
+		{
+			name:          "public key is not in subgroup",
+			input:         with(0, notInSubgroup),
+			expectedError: ErrorBabyJubJubSchnorrVerifyPublicKeyIsNotOnCurve,
+		},
+		{
+			name:          "public key is the identity",
+			input:         with(0, utils.MarshalPoint(babyjub.NewPoint())),
+			expectedError: ErrorBabyJubJubSchnorrVerifyPublicKeyIsNotOnCurve,
+		},
+		{
+			name:          "R is not on curve",
+			input:         with(2, notOnCurve),
+			expectedError: ErrorBabyJubJubSchnorrVerifyRIsNotOnCurve,
+		},
+		{
+			name:          "R is not in subgroup",
+			input:         with(2, notInSubgroup),
+			expectedError: ErrorBabyJubJubSchnorrVerifyRIsNotOnCurve,
+		},
+		{
+			name:          "s equal to the subgroup order",
+			input:         with(4, field(utils.SubOrder)),
+			expectedError: ErrorBabyJubJubSchnorrVerifyInvalidS,
+		},
+		{
+			name:          "message equal to the field modulus",
+			input:         with(5, field(utils.FieldPrime)),
+			expectedError: ErrorBabyJubJubSchnorrVerifyMessageNotInField,
+		},
+		{
+			name:          "truncated input",
+			input:         valid[:BabyJubJubSchnorrVerifyInputSize-1],
+			expectedError: utils.ErrorBabyJubJubCurveInvalidInputLength,
+		},
+		{
+			name:          "empty input",
+			input:         []byte{},
+			expectedError: utils.ErrorBabyJubJubCurveInvalidInputLength,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			precompile := BabyJubJubSchnorrVerify{}
+
+			actual, err := precompile.Run(tt.input)
+			gas := precompile.RequiredGas(tt.input)
+
+			if tt.expectedError != nil {
+				assert.NotNil(t, err)
+				assert.Equal(t, tt.expectedError, err)
+
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, tt.expected, actual)
+			assert.Equal(t, BabyJubJubSchnorrVerifyGas, gas)
+		})
+	}
+}
+
+func TestRunProperties(t *testing.T) {
+	parameters := gopter.DefaultTestParameters()
+	properties := gopter.NewProperties(parameters)
+
+	nonZeroScalar := utils.ScalarGenerator().SuchThat(func(value *big.Int) bool {
+		return value.Sign() != 0
+	})
+
+	properties.Property("Run accepts signatures made with a generated key", prop.ForAll(
+		func(key, nonce, message *big.Int) bool {
+			actual, err := (&BabyJubJubSchnorrVerify{}).Run(sign(key, nonce, message))
+
+			return err == nil && actual[0] == 1
+		},
+		nonZeroScalar,
+		nonZeroScalar,
+		utils.ScalarGenerator(),
+	))
+
+	properties.Property("Run rejects signatures over another message", prop.ForAll(
+		func(key, nonce, message *big.Int) bool {
+			input := sign(key, nonce, message)
+
+			other := new(big.Int).Add(message, big.NewInt(1))
+			copy(input[5*utils.BabyJubJubCurveFieldByteSize:], other.FillBytes(make([]byte, utils.BabyJubJubCurveFieldByteSize)))
+
+			actual, err := (&BabyJubJubSchnorrVerify{}).Run(input)
+
+			return err == nil && actual[0] == 0
+		},
+		nonZeroScalar,
+		nonZeroScalar,
+		utils.ScalarGenerator(),
+	))
+
+	properties.TestingRun(t)
+}
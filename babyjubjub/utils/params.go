@@ -13,6 +13,12 @@ const (
 	// point on the BabyJubJub curve. It is simply two field elements concatenated:
 	// X || Y.
 	BabyJubJubCurveAffinePointSize = 2 * BabyJubJubCurveFieldByteSize
+
+	// BabyJubJubCurveCompressedPointSize defines the byte length of a compressed
+	// point on the BabyJubJub curve. It is the little-endian Y coordinate with
+	// the sign of X packed into the most significant bit, as produced by
+	// babyjub.Point.Compress.
+	BabyJubJubCurveCompressedPointSize = BabyJubJubCurveFieldByteSize
 )
 
 // Predefined errors used for BabyJubJub curve operations.
@@ -29,5 +35,17 @@ var (
 	// fails validation on the BabyJubJub curve. This includes cases
 	// where the point is not on the curve or is not in the correct
 	// prime-order subgroup.
+	//
+	// It is kept for backward compatibility. The add and mul precompiles
+	// return the more specific ErrorBabyJubJubCurvePointNotOnCurve and
+	// ErrorBabyJubJubCurvePointNotInSubgroup instead.
 	ErrorBabyJubJubCurveInvalidPoint = errors.New("invalid point")
+
+	// ErrorBabyJubJubCurvePointNotOnCurve is returned when a point does not
+	// satisfy the BabyJubJub twisted Edwards curve equation.
+	ErrorBabyJubJubCurvePointNotOnCurve = errors.New("point is not on curve")
+
+	// ErrorBabyJubJubCurvePointNotInSubgroup is returned when a point lies on
+	// the BabyJubJub curve but not in the prime-order subgroup.
+	ErrorBabyJubJubCurvePointNotInSubgroup = errors.New("point is not in subgroup")
 )
@@ -1,6 +1,7 @@
 package utils
 
 import (
+	"fmt"
 	"math/big"
 
 	"github.com/iden3/go-iden3-crypto/babyjub"
@@ -9,6 +10,15 @@ import (
 	"github.com/privacy-ethereum/privacy-precompiles/utils"
 )
 
+// ReadField reads a BabyJubJub field element of BabyJubJubCurveFieldByteSize
+// bytes at offset and returns it together with the offset of the next byte.
+//
+// It is a shorthand for utils.ReadField with the BabyJubJub field size and
+// returns nil if the element is out of bounds.
+func ReadField(input []byte, offset int) (*big.Int, int) {
+	return utils.ReadField(input, offset, BabyJubJubCurveFieldByteSize)
+}
+
 // readAffinePoint returns the affine BabyJubJub curve point at the given index
 // from the precompile input buffer.
 //
@@ -24,16 +34,25 @@ import (
 //	index = 0 → first point  (bytes [0 : babyJubJubAffinePointSize])
 //	index = 1 → second point (bytes [babyJubJubAffinePointSize : 2*babyJubJubAffinePointSize])
 //
+// If either coordinate is out of bounds, the returned error wraps
+// ErrorBabyJubJubCurvePointInvalid with the failing coordinate, so callers can
+// still match it with errors.Is.
+//
 // readAffinePoint does not validate that the returned point lies on the curve
 // or in the correct subgroup. Callers must perform any required validation.
 func ReadAffinePoint(input []byte, index int) (*babyjub.Point, error) {
 	offset := index * BabyJubJubCurveAffinePointSize
 
-	x, offset := utils.ReadField(input, offset, BabyJubJubCurveFieldByteSize)
-	y, _ := utils.ReadField(input, offset, BabyJubJubCurveFieldByteSize)
+	x, offset := ReadField(input, offset)
 
-	if x == nil || y == nil {
-		return nil, ErrorBabyJubJubCurvePointInvalid
+	if x == nil {
+		return nil, fmt.Errorf("x coordinate out of bounds: %w", ErrorBabyJubJubCurvePointInvalid)
+	}
+
+	y, _ := ReadField(input, offset)
+
+	if y == nil {
+		return nil, fmt.Errorf("y coordinate out of bounds: %w", ErrorBabyJubJubCurvePointInvalid)
 	}
 
 	return &babyjub.Point{
@@ -91,6 +110,53 @@ func UnmarshalPoint(input []byte) (*babyjub.Point, error) {
 	}, nil
 }
 
+// MarshalPointCompressed serializes an affine BabyJubJub curve point into the
+// 32-byte compressed encoding used by iden3 tooling.
+//
+// The output is the Y coordinate in little-endian order with the sign of the
+// X coordinate stored in the most significant bit, matching
+// babyjub.Point.Compress. The returned slice is always exactly
+// BabyJubJubCurveCompressedPointSize bytes long.
+//
+// The caller must ensure that point is non-nil and in affine coordinates.
+func MarshalPointCompressed(point *babyjub.Point) []byte {
+	compressed := point.Compress()
+
+	return compressed[:]
+}
+
+// UnmarshalPointCompressed deserializes a 32-byte compressed encoding into a
+// BabyJubJub affine point, matching babyjub.Point.Decompress.
+//
+// Returns ErrorBabyJubJubCurvePointInvalid if the input is not exactly
+// BabyJubJubCurveCompressedPointSize bytes, if Y is not a field element
+// for which a curve point exists, or if the sign bit is set while X is zero.
+// The last check keeps the encoding canonical: -0 == 0, so both sign bits
+// would otherwise decode to the same point.
+//
+// The returned point lies on the curve but is not checked for subgroup
+// membership. Callers must perform any required validation.
+func UnmarshalPointCompressed(data []byte) (*babyjub.Point, error) {
+	if len(data) != BabyJubJubCurveCompressedPointSize {
+		return nil, ErrorBabyJubJubCurvePointInvalid
+	}
+
+	var compressed [BabyJubJubCurveCompressedPointSize]byte
+	copy(compressed[:], data)
+
+	point, err := babyjub.NewPoint().Decompress(compressed)
+
+	if err != nil {
+		return nil, ErrorBabyJubJubCurvePointInvalid
+	}
+
+	if compressed[BabyJubJubCurveCompressedPointSize-1]&0x80 != 0 && point.X.Sign() == 0 {
+		return nil, ErrorBabyJubJubCurvePointInvalid
+	}
+
+	return point, nil
+}
+
 // FieldPrime is the prime modulus p of the finite field Fp over which
 // the BabyJubJub curve is defined.
 // This is the same prime used by the BN254 (alt_bn128) curve and defines
@@ -100,6 +166,46 @@ var FieldPrime, _ = new(big.Int).SetString(
 	10,
 )
 
+// SubOrder is the order l of the prime-order subgroup generated by B8.
+//
+// It is a copy of babyjub.SubOrder, so callers of this package can reduce
+// scalars without importing babyjub directly.
+var SubOrder = new(big.Int).Set(babyjub.SubOrder)
+
+// ReduceScalar returns s modulo SubOrder as a new big.Int, leaving s
+// untouched.
+//
+// Precompiles that treat the scalar as an exponent of a subgroup point,
+// such as mul, reduce it: [s]P and [s mod SubOrder]P are the same point.
+func ReduceScalar(s *big.Int) *big.Int {
+	return new(big.Int).Mod(s, SubOrder)
+}
+
+// IsValidScalar reports whether s is a canonical scalar, i.e. 0 <= s <
+// SubOrder.
+//
+// Precompiles that verify a scalar produced by a signer or prover, such as
+// the EdDSA S value, reject non-canonical scalars instead of reducing them,
+// so that every valid proof has a single encoding.
+func IsValidScalar(s *big.Int) bool {
+	return s.Sign() >= 0 && s.Cmp(SubOrder) < 0
+}
+
+// IsIdentity reports whether point is the BabyJubJub neutral element
+// (0, 1).
+//
+// The identity lies on the curve and in the prime-order subgroup, so
+// BabyJubJubCurveValidatePoint accepts it. It must not be confused with the
+// all-zero encoding (0, 0), which does not satisfy the curve equation.
+// Coordinates are compared modulo FieldPrime, so unreduced encodings of the
+// identity are recognized as well.
+func IsIdentity(point *babyjub.Point) bool {
+	x := new(big.Int).Mod(point.X, FieldPrime)
+	y := new(big.Int).Mod(point.Y, FieldPrime)
+
+	return x.Sign() == 0 && y.Cmp(big.NewInt(1)) == 0
+}
+
 // BabyJubJubPointGenerator returns a gopter generator for valid BabyJubJub affine points.
 //
 // Each generated point is computed by multiplying a small random scalar `n`
@@ -2,6 +2,7 @@ package utils
 
 import (
 	"bytes"
+	"errors"
 	"math/big"
 	"testing"
 
@@ -9,6 +10,7 @@ import (
 	"github.com/leanovate/gopter"
 	"github.com/leanovate/gopter/gen"
 	"github.com/leanovate/gopter/prop"
+	"github.com/privacy-ethereum/privacy-precompiles/utils"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -136,6 +138,115 @@ func TestMarshalProperties(t *testing.T) {
 	properties.TestingRun(t)
 }
 
+func TestUnmarshalPointCompressedInvalidInput(t *testing.T) {
+	tests := []struct {
+		name string
+		data []byte
+	}{
+		{"empty slice", []byte{}},
+		{"too short", make([]byte, BabyJubJubCurveCompressedPointSize-1)},
+		{"too long", make([]byte, BabyJubJubCurveCompressedPointSize+1)},
+		{"uncompressed point", MarshalPoint(babyjub.B8)},
+		{
+			"y is not a valid coordinate",
+			func() []byte {
+				data := make([]byte, BabyJubJubCurveCompressedPointSize)
+				data[0] = 2
+
+				return data
+			}(),
+		},
+		{
+			"y is not a field element",
+			func() []byte {
+				data := bytes.Repeat([]byte{0xff}, BabyJubJubCurveCompressedPointSize)
+				data[BabyJubJubCurveCompressedPointSize-1] = 0x7f
+
+				return data
+			}(),
+		},
+		{
+			"sign bit set on the identity",
+			func() []byte {
+				data := MarshalPointCompressed(babyjub.NewPoint())
+				data[BabyJubJubCurveCompressedPointSize-1] |= 0x80
+
+				return data
+			}(),
+		},
+		{
+			"sign bit set on (0, -1)",
+			func() []byte {
+				point := &babyjub.Point{X: big.NewInt(0), Y: new(big.Int).Sub(FieldPrime, big.NewInt(1))}
+				data := MarshalPointCompressed(point)
+				data[BabyJubJubCurveCompressedPointSize-1] |= 0x80
+
+				return data
+			}(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := UnmarshalPointCompressed(tt.data)
+
+			assert.Equal(t, ErrorBabyJubJubCurvePointInvalid, err)
+		})
+	}
+}
+
+func TestMarshalCompressedProperties(t *testing.T) {
+	parameters := gopter.DefaultTestParameters()
+	properties := gopter.NewProperties(parameters)
+
+	properties.Property("MarshalCompressed and UnmarshalCompressed are inverse operations", prop.ForAll(
+		func(point *babyjub.Point) bool {
+			data := MarshalPointCompressed(point)
+			actual, err := UnmarshalPointCompressed(data)
+
+			if err != nil || len(data) != BabyJubJubCurveCompressedPointSize {
+				return false
+			}
+
+			return actual.X.Cmp(point.X) == 0 && actual.Y.Cmp(point.Y) == 0
+		},
+		BabyJubJubPointGenerator(),
+	))
+
+	properties.Property("MarshalCompressed matches babyjub.Point.Compress", prop.ForAll(
+		func(point *babyjub.Point) bool {
+			expected := point.Compress()
+
+			return bytes.Equal(MarshalPointCompressed(point), expected[:])
+		},
+		BabyJubJubPointGenerator(),
+	))
+
+	properties.TestingRun(t)
+}
+
+func TestReadFieldProperties(t *testing.T) {
+	parameters := gopter.DefaultTestParameters()
+	properties := gopter.NewProperties(parameters)
+
+	properties.Property("ReadField matches the generic helper with the field size", prop.ForAll(
+		func(input []byte, offset int) bool {
+			expected, expectedOffset := utils.ReadField(input, offset, BabyJubJubCurveFieldByteSize)
+			actual, actualOffset := ReadField(input, offset)
+
+			if expected == nil || actual == nil {
+				return expected == nil && actual == nil && expectedOffset == actualOffset
+			}
+
+			return expected.Cmp(actual) == 0 && expectedOffset == actualOffset
+		},
+		gen.SliceOf(gen.UInt8()),
+		gen.IntRange(-1, 3*BabyJubJubCurveFieldByteSize),
+	))
+
+	properties.TestingRun(t)
+}
+
 func TestReadAffinePoint(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -143,6 +254,7 @@ func TestReadAffinePoint(t *testing.T) {
 		index       int
 		expected    *babyjub.Point
 		expectError bool
+		failedField string
 	}{
 		{
 			name:        "normal read zero",
@@ -187,18 +299,28 @@ func TestReadAffinePoint(t *testing.T) {
 			data:        make([]byte, BabyJubJubCurveAffinePointSize),
 			index:       -1,
 			expectError: true,
+			failedField: "x coordinate",
 		},
 		{
 			name:        "slice too short",
 			data:        make([]byte, BabyJubJubCurveAffinePointSize-1),
 			index:       0,
 			expectError: true,
+			failedField: "y coordinate",
+		},
+		{
+			name:        "x coordinate truncated",
+			data:        make([]byte, BabyJubJubCurveFieldByteSize-1),
+			index:       0,
+			expectError: true,
+			failedField: "x coordinate",
 		},
 		{
 			name:        "index beyond slice",
 			data:        make([]byte, BabyJubJubCurveAffinePointSize),
 			index:       1,
 			expectError: true,
+			failedField: "x coordinate",
 		},
 	}
 
@@ -207,7 +329,8 @@ func TestReadAffinePoint(t *testing.T) {
 			actual, err := ReadAffinePoint(tt.data, tt.index)
 
 			if tt.expectError {
-				assert.NotNil(t, err)
+				assert.True(t, errors.Is(err, ErrorBabyJubJubCurvePointInvalid))
+				assert.Contains(t, err.Error(), tt.failedField)
 
 				return
 			}
@@ -218,6 +341,153 @@ func TestReadAffinePoint(t *testing.T) {
 	}
 }
 
+func TestFieldPrime(t *testing.T) {
+	expected, _ := new(big.Int).SetString(
+		"21888242871839275222246405745257275088548364400416034343698204186575808495617",
+		10,
+	)
+
+	assert.Equal(t, 0, FieldPrime.Cmp(expected))
+	assert.True(t, FieldPrime.ProbablyPrime(20))
+
+	minusOne := new(big.Int).Sub(FieldPrime, big.NewInt(1))
+	assert.Equal(t, -1, minusOne.Cmp(FieldPrime))
+
+	// (0, p - 1) is the point of order 2: on the curve, outside the subgroup.
+	point := &babyjub.Point{X: big.NewInt(0), Y: minusOne}
+	assert.True(t, point.InCurve())
+	assert.False(t, point.InSubGroup())
+}
+
+func TestSubOrder(t *testing.T) {
+	expected, _ := new(big.Int).SetString(
+		"2736030358979909402780800718157159386076813972158567259200215660948447373041",
+		10,
+	)
+
+	assert.Equal(t, 0, SubOrder.Cmp(expected))
+	assert.Equal(t, 0, SubOrder.Cmp(babyjub.SubOrder))
+	assert.NotSame(t, babyjub.SubOrder, SubOrder)
+
+	identity := babyjub.NewPoint().Mul(SubOrder, babyjub.B8)
+	assert.Equal(t, 0, identity.X.Sign())
+	assert.Equal(t, 0, identity.Y.Cmp(big.NewInt(1)))
+}
+
+func TestReduceScalar(t *testing.T) {
+	tests := []struct {
+		name     string
+		scalar   *big.Int
+		expected *big.Int
+	}{
+		{
+			name:     "zero",
+			scalar:   big.NewInt(0),
+			expected: big.NewInt(0),
+		},
+		{
+			name:     "subgroup order minus 1",
+			scalar:   new(big.Int).Sub(SubOrder, big.NewInt(1)),
+			expected: new(big.Int).Sub(SubOrder, big.NewInt(1)),
+		},
+		{
+			name:     "subgroup order",
+			scalar:   new(big.Int).Set(SubOrder),
+			expected: big.NewInt(0),
+		},
+		{
+			name:     "subgroup order plus 1",
+			scalar:   new(big.Int).Add(SubOrder, big.NewInt(1)),
+			expected: big.NewInt(1),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			original := new(big.Int).Set(tt.scalar)
+			actual := ReduceScalar(tt.scalar)
+
+			assert.Equal(t, 0, tt.expected.Cmp(actual))
+			assert.Equal(t, 0, original.Cmp(tt.scalar))
+		})
+	}
+}
+
+func TestIsValidScalar(t *testing.T) {
+	tests := []struct {
+		name     string
+		scalar   *big.Int
+		expected bool
+	}{
+		{
+			name:     "zero",
+			scalar:   big.NewInt(0),
+			expected: true,
+		},
+		{
+			name:     "subgroup order minus 1",
+			scalar:   new(big.Int).Sub(SubOrder, big.NewInt(1)),
+			expected: true,
+		},
+		{
+			name:     "subgroup order",
+			scalar:   new(big.Int).Set(SubOrder),
+			expected: false,
+		},
+		{
+			name:     "negative",
+			scalar:   big.NewInt(-1),
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, IsValidScalar(tt.scalar))
+		})
+	}
+}
+
+func TestIsIdentity(t *testing.T) {
+	tests := []struct {
+		name     string
+		point    *babyjub.Point
+		expected bool
+	}{
+		{
+			name:     "identity",
+			point:    babyjub.NewPoint(),
+			expected: true,
+		},
+		{
+			name:     "unreduced identity",
+			point:    &babyjub.Point{X: new(big.Int).Set(FieldPrime), Y: new(big.Int).Add(FieldPrime, big.NewInt(1))},
+			expected: true,
+		},
+		{
+			name:     "all-zero point",
+			point:    &babyjub.Point{X: big.NewInt(0), Y: big.NewInt(0)},
+			expected: false,
+		},
+		{
+			name:     "point of order 2",
+			point:    &babyjub.Point{X: big.NewInt(0), Y: new(big.Int).Sub(FieldPrime, big.NewInt(1))},
+			expected: false,
+		},
+		{
+			name:     "base point",
+			point:    babyjub.B8,
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, IsIdentity(tt.point))
+		})
+	}
+}
+
 func TestGeneratePoint(t *testing.T) {
 	parameters := gopter.DefaultTestParameters()
 	properties := gopter.NewProperties(parameters)
@@ -0,0 +1,80 @@
+package validation
+
+import (
+	"github.com/privacy-ethereum/privacy-precompiles/babyjubjub/utils"
+	"github.com/privacy-ethereum/privacy-precompiles/common"
+)
+
+// BabyJubJubCurveValidatePoints implements a precompile validating a list
+// of BabyJubJub points in a single call.
+//
+// It satisfies the common.Precompile interface and can be used in a generic
+// precompile execution framework, e.g. by contracts checking every point of
+// a key or commitment list at once.
+type BabyJubJubCurveValidatePoints struct{}
+
+// Name returns the human-readable name of the precompile.
+func (c *BabyJubJubCurveValidatePoints) Name() string {
+	return "BabyJubJubCurveValidatePoints"
+}
+
+// RequiredGas returns the gas cost of executing this precompile.
+//
+// Gas is calculated as:
+//
+//	BabyJubJubCurveValidatePointsBaseGas + (number_of_points * BabyJubJubCurveValidatePointsPerPointGas)
+//
+// Where the number of points is the number of complete points in input.
+func (c *BabyJubJubCurveValidatePoints) RequiredGas(input []byte) uint64 {
+	return BabyJubJubCurveValidatePointsBaseGas +
+		uint64(len(input)/utils.BabyJubJubCurveAffinePointSize)*BabyJubJubCurveValidatePointsPerPointGas
+}
+
+// Run executes the batch point validation precompile.
+//
+// The input must consist of N affine points encoded as:
+//
+//	x_0 || y_0 || x_1 || y_1 || ... || x_{N-1} || y_{N-1}
+//
+// Where:
+//   - Each coordinate is a big-endian field element padded to
+//     utils.BabyJubJubCurveFieldByteSize bytes.
+//   - 1 <= N <= BabyJubJubCurveValidatePointsMaxPoints.
+//
+// Run returns an N-byte result whose byte i is 1 if point i lies on the
+// BabyJubJub curve and in the prime-order subgroup, and 0 otherwise, as
+// BabyJubJubCurveValidatePoint would report for that point alone.
+//
+// Returns an error if:
+//   - The input length is zero or not a multiple of utils.BabyJubJubCurveAffinePointSize.
+//   - The number of points exceeds BabyJubJubCurveValidatePointsMaxPoints.
+func (c *BabyJubJubCurveValidatePoints) Run(input []byte) ([]byte, error) {
+	if len(input) == 0 || len(input)%utils.BabyJubJubCurveAffinePointSize != 0 {
+		return nil, utils.ErrorBabyJubJubCurveInvalidInputLength
+	}
+
+	numberOfPoints := len(input) / utils.BabyJubJubCurveAffinePointSize
+
+	if numberOfPoints > BabyJubJubCurveValidatePointsMaxPoints {
+		return nil, utils.ErrorBabyJubJubCurveInvalidInputLength
+	}
+
+	result := make([]byte, numberOfPoints)
+
+	for index := range numberOfPoints {
+		point, err := utils.ReadAffinePoint(input, index)
+
+		if err != nil {
+			return nil, utils.ErrorBabyJubJubCurveInvalidInputLength
+		}
+
+		if point.InCurve() && point.InSubGroup() {
+			result[index] = 1
+		}
+	}
+
+	return result, nil
+}
+
+// Ensure BabyJubJubCurveValidatePoints implements the common.Precompile interface.
+var _ common.Precompile = (*BabyJubJubCurveValidatePoints)(nil)
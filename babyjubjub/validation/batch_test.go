@@ -0,0 +1,140 @@
+package validation
+
+import (
+	"bytes"
+	"math/big"
+	"slices"
+	"testing"
+
+	"github.com/iden3/go-iden3-crypto/babyjub"
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/gen"
+	"github.com/leanovate/gopter/prop"
+	"github.com/privacy-ethereum/privacy-precompiles/babyjubjub/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBabyJubJubCurveValidatePointsName(t *testing.T) {
+	precompile := BabyJubJubCurveValidatePoints{}
+
+	expected := "BabyJubJubCurveValidatePoints"
+
+	assert.Equal(t, expected, precompile.Name())
+}
+
+func TestValidatePoints(t *testing.T) {
+	base := utils.MarshalPoint(babyjub.B8)
+	offCurve := utils.MarshalPoint(&babyjub.Point{X: big.NewInt(123), Y: big.NewInt(456)})
+	smallOrder := utils.MarshalPoint(&babyjub.Point{
+		X: big.NewInt(0),
+		Y: new(big.Int).Sub(utils.FieldPrime, big.NewInt(1)), // p - 1 == -1 mod p
+	})
+
+	tests := []struct {
+		name          string
+		input         []byte
+		expected      []byte
+		expectedGas   uint64
+		expectedError error
+	}{
+		{
+			name:        "single valid point",
+			input:       base,
+			expected:    []byte{1},
+			expectedGas: BabyJubJubCurveValidatePointsBaseGas + BabyJubJubCurveValidatePointsPerPointGas,
+		},
+		{
+			name:        "mixed points",
+			input:       slices.Concat(base, offCurve, smallOrder, utils.MarshalPoint(babyjub.NewPoint())),
+			expected:    []byte{1, 0, 0, 1},
+			expectedGas: BabyJubJubCurveValidatePointsBaseGas + 4*BabyJubJubCurveValidatePointsPerPointGas,
+		},
+		{
+			name:        "invalid points only",
+			input:       slices.Concat(smallOrder, offCurve),
+			expected:    []byte{0, 0},
+			expectedGas: BabyJubJubCurveValidatePointsBaseGas + 2*BabyJubJubCurveValidatePointsPerPointGas,
+		},
+		{
+			name:        "maximum number of points",
+			input:       bytes.Repeat(base, BabyJubJubCurveValidatePointsMaxPoints),
+			expected:    bytes.Repeat([]byte{1}, BabyJubJubCurveValidatePointsMaxPoints),
+			expectedGas: BabyJubJubCurveValidatePointsBaseGas + BabyJubJubCurveValidatePointsMaxPoints*BabyJubJubCurveValidatePointsPerPointGas,
+		},
+		{
+			name:          "empty input",
+			input:         []byte{},
+			expectedError: utils.ErrorBabyJubJubCurveInvalidInputLength,
+		},
+		{
+			name:          "truncated point",
+			input:         slices.Concat(base, base[:utils.BabyJubJubCurveFieldByteSize]),
+			expectedError: utils.ErrorBabyJubJubCurveInvalidInputLength,
+		},
+		{
+			name:          "too many points",
+			input:         bytes.Repeat(base, BabyJubJubCurveValidatePointsMaxPoints+1),
+			expectedError: utils.ErrorBabyJubJubCurveInvalidInputLength,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			precompile := BabyJubJubCurveValidatePoints{}
+
+			actual, err := precompile.Run(tt.input)
+			gas := precompile.RequiredGas(tt.input)
+
+			if tt.expectedError != nil {
+				assert.NotNil(t, err)
+				assert.Equal(t, tt.expectedError, err)
+
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, tt.expectedGas, gas)
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func TestValidatePointsProperties(t *testing.T) {
+	parameters := gopter.DefaultTestParameters()
+	properties := gopter.NewProperties(parameters)
+
+	properties.Property("Run matches BabyJubJubCurveValidatePoint point by point", prop.ForAll(
+		func(points []*babyjub.Point, corrupt []bool) bool {
+			precompile := BabyJubJubCurveValidatePoints{}
+			single := BabyJubJubCurveValidatePoint{}
+
+			input := make([]byte, 0, len(points)*utils.BabyJubJubCurveAffinePointSize)
+			expected := make([]byte, 0, len(points))
+
+			for index, point := range points {
+				encoded := utils.MarshalPoint(point)
+
+				if corrupt[index] {
+					encoded[len(encoded)-1] ^= 1
+				}
+
+				result, err := single.Run(encoded)
+
+				if err != nil {
+					return false
+				}
+
+				input = append(input, encoded...)
+				expected = append(expected, result...)
+			}
+
+			actual, err := precompile.Run(input)
+
+			return err == nil && bytes.Equal(expected, actual)
+		},
+		gen.SliceOfN(8, utils.BabyJubJubPointGenerator()),
+		gen.SliceOfN(8, gen.Bool()),
+	))
+
+	properties.TestingRun(t)
+}
@@ -14,10 +14,32 @@ const (
 	//   - optionally lies in the correct prime-order subgroup
 	BabyJubJubCurveValidatePointInputSize = utils.BabyJubJubCurveAffinePointSize
 
+	// BabyJubJubCurveValidatePointOutputSize defines the fixed byte length of
+	// the output produced by the BabyJubJub point validation precompile: a
+	// single boolean byte.
+	BabyJubJubCurveValidatePointOutputSize = 1
+
 	// BabyJubJubCurveValidatePointGas is the estimated gas cost for executing
 	// the BabyJubJub point validation precompile in Ethereum.
 	//
 	// This is a fixed cost, since validation involves only a small number
 	// of curve checks.
 	BabyJubJubCurveValidatePointGas uint64 = 10000
+
+	// BabyJubJubCurveValidatePointsMaxPoints defines the maximum number of
+	// points accepted by the batch point validation precompile in a single
+	// invocation.
+	BabyJubJubCurveValidatePointsMaxPoints = 64
+
+	// BabyJubJubCurveValidatePointsBaseGas defines the fixed gas cost of the
+	// batch point validation precompile, independent of the number of points.
+	BabyJubJubCurveValidatePointsBaseGas uint64 = 3000
+
+	// BabyJubJubCurveValidatePointsPerPointGas defines the gas cost charged
+	// per point in the batch point validation precompile.
+	//
+	// Total gas cost is calculated as:
+	//
+	//	BabyJubJubCurveValidatePointsBaseGas + (number_of_points * BabyJubJubCurveValidatePointsPerPointGas)
+	BabyJubJubCurveValidatePointsPerPointGas = BabyJubJubCurveValidatePointGas
 )
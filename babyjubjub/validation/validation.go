@@ -30,6 +30,12 @@ func (c *BabyJubJubCurveValidatePoint) RequiredGas(input []byte) uint64 {
 	return BabyJubJubCurveValidatePointGas
 }
 
+// MaxOutputSize returns the byte length of the boolean result returned by Run,
+// which is BabyJubJubCurveValidatePointOutputSize regardless of the input.
+func (c *BabyJubJubCurveValidatePoint) MaxOutputSize(input []byte) int {
+	return BabyJubJubCurveValidatePointOutputSize
+}
+
 // Run executes the BabyJubJub point validation precompile.
 //
 // The input must be exactly BabyJubJubValidatePointInputSize bytes, which
@@ -46,15 +52,36 @@ func (c *BabyJubJubCurveValidatePoint) RequiredGas(input []byte) uint64 {
 //  3. Checks whether the point is in the prime-order subgroup.
 //  4. Returns 1 if the point is valid, 0 otherwise.
 //
+// The identity (0, 1) is a valid point, while the all-zero encoding (0, 0)
+// is not on the curve and is rejected. Callers that must exclude the
+// identity, e.g. for public keys, can check it with utils.IsIdentity.
+//
+// A point that cannot be read from input is not reported as invalid with 0:
+// it means the input is malformed, so ErrorBabyJubJubCurveInvalidInputLength
+// is returned instead.
+//
 // Returns an error if:
 //   - The input length is incorrect.
-//   - The point encoding is invalid.
+//   - The point cannot be read from input.
 func (c *BabyJubJubCurveValidatePoint) Run(input []byte) ([]byte, error) {
 	if len(input) != BabyJubJubCurveValidatePointInputSize {
 		return nil, utils.ErrorBabyJubJubCurveInvalidInputLength
 	}
 
-	point, _ := utils.ReadAffinePoint(input, 0)
+	return c.run(input)
+}
+
+// run validates the point encoded at the start of input.
+//
+// It does not rely on Run's length check: if the point cannot be read,
+// ErrorBabyJubJubCurveInvalidInputLength is returned instead of dereferencing
+// a nil point.
+func (c *BabyJubJubCurveValidatePoint) run(input []byte) ([]byte, error) {
+	point, err := utils.ReadAffinePoint(input, 0)
+
+	if err != nil {
+		return nil, utils.ErrorBabyJubJubCurveInvalidInputLength
+	}
 
 	if point.InCurve() && point.InSubGroup() {
 		return []byte{1}, nil
@@ -65,3 +92,6 @@ func (c *BabyJubJubCurveValidatePoint) Run(input []byte) ([]byte, error) {
 
 // Ensure BabyJubJubValidatePoint implements the common.Precompile interface.
 var _ common.Precompile = (*BabyJubJubCurveValidatePoint)(nil)
+
+// Ensure BabyJubJubCurveValidatePoint implements the common.OutputSizer interface.
+var _ common.OutputSizer = (*BabyJubJubCurveValidatePoint)(nil)
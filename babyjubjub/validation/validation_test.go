@@ -9,6 +9,8 @@ import (
 	"github.com/leanovate/gopter"
 	"github.com/leanovate/gopter/prop"
 	"github.com/privacy-ethereum/privacy-precompiles/babyjubjub/utils"
+	"github.com/privacy-ethereum/privacy-precompiles/common"
+	"github.com/privacy-ethereum/privacy-precompiles/internal/commontest"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -20,6 +22,14 @@ func TestBabyJubJubCurveValidatePointName(t *testing.T) {
 	assert.Equal(t, expected, precompile.Name())
 }
 
+func TestBabyJubJubCurveValidatePointMaxOutputSize(t *testing.T) {
+	precompile := BabyJubJubCurveValidatePoint{}
+
+	var sizer common.OutputSizer = &precompile
+
+	assert.Equal(t, 1, sizer.MaxOutputSize(nil))
+}
+
 func TestValidatePoint(t *testing.T) {
 	tests := []struct {
 		name          string
@@ -115,6 +125,71 @@ func TestValidatePoint(t *testing.T) {
 	}
 }
 
+func TestValidatePointZeroAndIdentity(t *testing.T) {
+	precompile := BabyJubJubCurveValidatePoint{}
+
+	zero := &babyjub.Point{X: big.NewInt(0), Y: big.NewInt(0)}
+	identity := babyjub.NewPoint()
+
+	// (0, 0) does not satisfy a*x^2 + y^2 = 1 + d*x^2*y^2.
+	assert.False(t, zero.InCurve())
+	assert.False(t, utils.IsIdentity(zero))
+
+	actual, err := precompile.Run(utils.MarshalPoint(zero))
+
+	assert.Nil(t, err)
+	assert.Equal(t, []byte{0}, actual)
+
+	// (0, 1) is the neutral element of the group.
+	assert.True(t, utils.IsIdentity(identity))
+	assert.Equal(t, utils.MarshalPoint(babyjub.B8), utils.MarshalPoint(
+		babyjub.NewPoint().Projective().Add(babyjub.B8.Projective(), identity.Projective()).Affine(),
+	))
+
+	actual, err = precompile.Run(utils.MarshalPoint(identity))
+
+	assert.Nil(t, err)
+	assert.Equal(t, []byte{1}, actual)
+}
+
+func TestValidatePointTruncatedInput(t *testing.T) {
+	input := utils.MarshalPoint(babyjub.B8)
+
+	tests := []struct {
+		name  string
+		input []byte
+	}{
+		{
+			name:  "empty input",
+			input: []byte{},
+		},
+		{
+			name:  "missing y coordinate",
+			input: input[:utils.BabyJubJubCurveFieldByteSize],
+		},
+		{
+			name:  "truncated y coordinate",
+			input: input[:BabyJubJubCurveValidatePointInputSize-1],
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			precompile := BabyJubJubCurveValidatePoint{}
+
+			var actual []byte
+			var err error
+
+			assert.NotPanics(t, func() {
+				actual, err = precompile.run(tt.input)
+			})
+
+			assert.Nil(t, actual)
+			assert.Equal(t, utils.ErrorBabyJubJubCurveInvalidInputLength, err)
+		})
+	}
+}
+
 func TestRunProperties(t *testing.T) {
 	parameters := gopter.DefaultTestParameters()
 	properties := gopter.NewProperties(parameters)
@@ -136,3 +211,10 @@ func TestRunProperties(t *testing.T) {
 
 	properties.TestingRun(t)
 }
+
+func BenchmarkValidatePoint(b *testing.B) {
+	point, _ := utils.BabyJubJubPointGenerator().Sample()
+	input := utils.MarshalPoint(point.(*babyjub.Point))
+
+	commontest.BenchmarkGasRatio(b, &BabyJubJubCurveValidatePoint{}, input)
+}
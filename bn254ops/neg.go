@@ -0,0 +1,104 @@
+package bn254ops
+
+import (
+	"github.com/consensys/gnark-crypto/ecc/bn254"
+	"github.com/privacy-ethereum/privacy-precompiles/common"
+	groth16bn254 "github.com/privacy-ethereum/privacy-precompiles/verifier/groth16/bn254"
+)
+
+// BN254G1Neg implements the BN254 G1 point negation precompile.
+//
+// Together with the EVM alt_bn128 precompiles it lets contracts build
+// custom pairing check calldata, where one side of an equation is moved
+// over by negating its G1 point.
+type BN254G1Neg struct{}
+
+// Name returns the human-readable name of the precompile.
+func (c *BN254G1Neg) Name() string {
+	return "BN254G1Neg"
+}
+
+// RequiredGas returns the fixed gas cost of executing this precompile.
+//
+// For BN254 G1 point negation, the gas cost is BN254G1NegGas.
+func (c *BN254G1Neg) RequiredGas(input []byte) uint64 {
+	return BN254G1NegGas
+}
+
+// MaxOutputSize returns the byte length of the negated point returned by Run,
+// which is BN254G1NegOutputSize regardless of the input.
+func (c *BN254G1Neg) MaxOutputSize(input []byte) int {
+	return BN254G1NegOutputSize
+}
+
+// Run executes the BN254 G1 point negation precompile.
+//
+// The input must be exactly BN254G1NegInputSize bytes, which encode a single
+// affine point in the format:
+//
+//	X || Y
+//
+// Each coordinate is a 32-byte big-endian base field element. The point at
+// infinity is encoded as 64 zero bytes, as for EIP-196, and negates to
+// itself.
+//
+// Run performs the following steps:
+//  1. Parses the point, rejecting coordinates that are not smaller than
+//     the base field modulus.
+//  2. Validates that the point lies on the curve using G1Affine.IsOnCurve.
+//     BN254 G1 has a cofactor of 1, so the point is also in the subgroup.
+//  3. Computes -P = (X, -Y).
+//  4. Returns the resulting point encoded as X || Y.
+//
+// Returns an error if:
+//   - The input length is incorrect (ErrorBN254InvalidInputLength).
+//   - A coordinate is not canonical or the point is not on the curve
+//     (common.ErrorInvalidG1).
+func (c *BN254G1Neg) Run(input []byte) ([]byte, error) {
+	if len(input) != BN254G1NegInputSize {
+		return nil, ErrorBN254InvalidInputLength
+	}
+
+	var point bn254.G1Affine
+
+	if err := readG1(input, &point); err != nil {
+		return nil, err
+	}
+
+	point.Neg(&point)
+
+	return marshalG1(&point), nil
+}
+
+// readG1 decodes a G1Size-byte X || Y point into destination, returning
+// common.ErrorInvalidG1 if a coordinate is not smaller than the base field
+// modulus or the point is not on the curve.
+func readG1(data []byte, destination *bn254.G1Affine) error {
+	if err := destination.X.SetBytesCanonical(data[:groth16bn254.BN254Groth16FieldSize]); err != nil {
+		return common.ErrorInvalidG1
+	}
+
+	if err := destination.Y.SetBytesCanonical(data[groth16bn254.BN254Groth16FieldSize:groth16bn254.BN254Groth16G1Size]); err != nil {
+		return common.ErrorInvalidG1
+	}
+
+	if !destination.IsOnCurve() {
+		return common.ErrorInvalidG1
+	}
+
+	return nil
+}
+
+// marshalG1 encodes point as X || Y with 32-byte big-endian coordinates.
+func marshalG1(point *bn254.G1Affine) []byte {
+	x := point.X.Bytes()
+	y := point.Y.Bytes()
+
+	return append(x[:], y[:]...)
+}
+
+// Ensure BN254G1Neg implements the common.Precompile interface.
+var _ common.Precompile = (*BN254G1Neg)(nil)
+
+// Ensure BN254G1Neg implements the common.OutputSizer interface.
+var _ common.OutputSizer = (*BN254G1Neg)(nil)
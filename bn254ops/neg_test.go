@@ -0,0 +1,156 @@
+package bn254ops
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bn254"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fp"
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/prop"
+	"github.com/privacy-ethereum/privacy-precompiles/common"
+	groth16bn254 "github.com/privacy-ethereum/privacy-precompiles/verifier/groth16/bn254"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBN254G1NegName(t *testing.T) {
+	precompile := BN254G1Neg{}
+
+	expected := "BN254G1Neg"
+	actual := precompile.Name()
+
+	assert.Equal(t, expected, actual)
+}
+
+func TestBN254G1NegMaxOutputSize(t *testing.T) {
+	precompile := BN254G1Neg{}
+
+	var sizer common.OutputSizer = &precompile
+
+	assert.Equal(t, 64, sizer.MaxOutputSize(nil))
+}
+
+func TestBN254G1Neg(t *testing.T) {
+	_, _, generator, _ := bn254.Generators()
+
+	var negatedGenerator bn254.G1Affine
+	negatedGenerator.Neg(&generator)
+
+	// (1, 3) does not satisfy y^2 = x^3 + 3.
+	offCurve := make([]byte, BN254G1NegInputSize)
+	offCurve[groth16bn254.BN254Groth16FieldSize-1] = 1
+	offCurve[BN254G1NegInputSize-1] = 3
+
+	// The generator (1, 2) with X encoded as p + 1, which is congruent to 1.
+	nonCanonical := marshalG1(&generator)
+	new(big.Int).Add(fp.Modulus(), big.NewInt(1)).FillBytes(nonCanonical[:groth16bn254.BN254Groth16FieldSize])
+
+	tests := []struct {
+		name          string
+		input         []byte
+		expected      []byte
+		expectedError error
+	}{
+		{
+			name:     "generator",
+			input:    marshalG1(&generator),
+			expected: marshalG1(&negatedGenerator),
+		},
+		{
+			name:     "point at infinity negates to itself",
+			input:    make([]byte, BN254G1NegInputSize),
+			expected: make([]byte, BN254G1NegOutputSize),
+		},
+		{
+			name:          "point not on curve",
+			input:         offCurve,
+			expectedError: common.ErrorInvalidG1,
+		},
+		{
+			name:          "non-canonical coordinate",
+			input:         nonCanonical,
+			expectedError: common.ErrorInvalidG1,
+		},
+		{
+			name:          "input too short",
+			input:         make([]byte, BN254G1NegInputSize-1),
+			expectedError: ErrorBN254InvalidInputLength,
+		},
+		{
+			name:          "empty input",
+			input:         []byte{},
+			expectedError: ErrorBN254InvalidInputLength,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			precompile := BN254G1Neg{}
+
+			actual, err := precompile.Run(tt.input)
+			gas := precompile.RequiredGas(tt.input)
+
+			if tt.expectedError != nil {
+				assert.Equal(t, tt.expectedError, err)
+
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, tt.expected, actual)
+			assert.Equal(t, BN254G1NegGas, gas)
+		})
+	}
+}
+
+func TestBN254G1NegProperties(t *testing.T) {
+	parameters := gopter.DefaultTestParameters()
+	properties := gopter.NewProperties(parameters)
+
+	properties.Property("P + Neg(P) is the identity", prop.ForAll(
+		func(point *bn254.G1Affine) bool {
+			precompile := BN254G1Neg{}
+
+			negated, err := precompile.Run(marshalG1(point))
+
+			if err != nil {
+				return false
+			}
+
+			var negatedPoint, sum bn254.G1Affine
+
+			if err := readG1(negated, &negatedPoint); err != nil {
+				return false
+			}
+
+			sum.Add(point, &negatedPoint)
+
+			return sum.IsInfinity()
+		},
+		groth16bn254.G1AffineGenerator(),
+	))
+
+	properties.Property("Neg(Neg(P)) is P", prop.ForAll(
+		func(point *bn254.G1Affine) bool {
+			precompile := BN254G1Neg{}
+
+			negated, err := precompile.Run(marshalG1(point))
+
+			if err != nil {
+				return false
+			}
+
+			result, err := precompile.Run(negated)
+
+			if err != nil {
+				return false
+			}
+
+			return bytes.Equal(result, marshalG1(point))
+		},
+		groth16bn254.G1AffineGenerator(),
+	))
+
+	properties.TestingRun(t)
+}
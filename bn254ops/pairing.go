@@ -0,0 +1,163 @@
+package bn254ops
+
+import (
+	"github.com/consensys/gnark-crypto/ecc/bn254"
+	"github.com/privacy-ethereum/privacy-precompiles/common"
+	groth16bn254 "github.com/privacy-ethereum/privacy-precompiles/verifier/groth16/bn254"
+)
+
+// BN254PairingCheck implements a generic BN254 pairing check precompile.
+//
+// It mirrors the EVM alt_bn128 pairing precompile (EIP-197) on top of
+// gnark-crypto, so contracts can check verification equations other than
+// Groth16, such as KZG openings.
+type BN254PairingCheck struct{}
+
+// Name returns the human-readable name of the precompile.
+func (c *BN254PairingCheck) Name() string {
+	return "BN254PairingCheck"
+}
+
+// RequiredGas returns the gas cost of executing this precompile.
+//
+// Gas is calculated as:
+//
+//	BN254PairingCheckBaseGas + (number_of_pairs * BN254PairingCheckPerPairGas)
+//
+// Inputs rejected by Run with ErrorBN254InvalidInputLength are priced at 0.
+func (c *BN254PairingCheck) RequiredGas(input []byte) uint64 {
+	pairs, err := pairingCheckPairs(input)
+
+	if err != nil {
+		return 0
+	}
+
+	return BN254PairingCheckBaseGas + uint64(pairs)*BN254PairingCheckPerPairGas
+}
+
+// MaxOutputSize returns the byte length of the boolean result returned by
+// Run, which is BN254PairingCheckOutputSize regardless of the input.
+func (c *BN254PairingCheck) MaxOutputSize(input []byte) int {
+	return BN254PairingCheckOutputSize
+}
+
+// Run executes the BN254 pairing check precompile.
+//
+// The input consists of k pairs encoded as:
+//
+//	G1_1 || G2_1 || ... || G1_k || G2_k
+//
+// Where:
+//   - Each G1 point is X || Y, with 32-byte big-endian coordinates.
+//   - Each G2 point is X.A1 || X.A0 || Y.A1 || Y.A0, the EIP-197 ordering
+//     read by groth16bn254.ParseG2EIP197.
+//   - The point at infinity is encoded as zero bytes.
+//   - 0 <= k <= BN254PairingCheckMaxPairs.
+//
+// Run performs the following steps:
+//  1. Validates the input length and the number of pairs.
+//  2. Parses every point, rejecting coordinates that are not smaller than
+//     the base field modulus, G1 points off the curve, and G2 points off
+//     the curve or outside the prime-order subgroup.
+//  3. Checks e(G1_1, G2_1) * ... * e(G1_k, G2_k) == 1 with
+//     bn254.PairingCheck.
+//
+// Return value:
+//   - []byte{1} if the product of pairings is the identity in GT, which
+//     includes the empty input as for EIP-197.
+//   - []byte{0} otherwise.
+//
+// Returns an error if:
+//   - The input is not a multiple of BN254PairingCheckPairSize or holds
+//     more than BN254PairingCheckMaxPairs pairs
+//     (ErrorBN254InvalidInputLength).
+//   - A G1 point is invalid (common.ErrorInvalidG1).
+//   - A G2 point is invalid (common.ErrorInvalidG2).
+func (c *BN254PairingCheck) Run(input []byte) ([]byte, error) {
+	pairs, err := pairingCheckPairs(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	g1Points := make([]bn254.G1Affine, pairs)
+	g2Points := make([]bn254.G2Affine, pairs)
+
+	for index := range pairs {
+		pair := input[index*BN254PairingCheckPairSize : (index+1)*BN254PairingCheckPairSize]
+
+		if err := readG1(pair[:groth16bn254.BN254Groth16G1Size], &g1Points[index]); err != nil {
+			return nil, err
+		}
+
+		if err := readG2(pair[groth16bn254.BN254Groth16G1Size:], &g2Points[index]); err != nil {
+			return nil, err
+		}
+	}
+
+	if pairs == 0 {
+		return []byte{1}, nil
+	}
+
+	ok, err := bn254.PairingCheck(g1Points, g2Points)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if !ok {
+		return []byte{0}, nil
+	}
+
+	return []byte{1}, nil
+}
+
+// pairingCheckPairs returns the number of pairs of a BN254PairingCheck
+// input, or ErrorBN254InvalidInputLength if the input is misaligned or
+// holds more than BN254PairingCheckMaxPairs pairs.
+func pairingCheckPairs(input []byte) (int, error) {
+	if len(input)%BN254PairingCheckPairSize != 0 {
+		return 0, ErrorBN254InvalidInputLength
+	}
+
+	pairs := len(input) / BN254PairingCheckPairSize
+
+	if pairs > BN254PairingCheckMaxPairs {
+		return 0, ErrorBN254InvalidInputLength
+	}
+
+	return pairs, nil
+}
+
+// readG2 decodes a G2Size-byte point in EIP-197 ordering into destination,
+// returning common.ErrorInvalidG2 if a coordinate is not smaller than the
+// base field modulus, or the point is not on the curve or not in the
+// prime-order subgroup.
+func readG2(data []byte, destination *bn254.G2Affine) error {
+	size := groth16bn254.BN254Groth16FieldSize
+	components := []*bn254.E2{&destination.X, &destination.Y}
+
+	for index, component := range components {
+		offset := 2 * index * size
+
+		if err := component.A1.SetBytesCanonical(data[offset : offset+size]); err != nil {
+			return common.ErrorInvalidG2
+		}
+
+		if err := component.A0.SetBytesCanonical(data[offset+size : offset+2*size]); err != nil {
+			return common.ErrorInvalidG2
+		}
+	}
+
+	if !destination.IsOnCurve() || !destination.IsInSubGroup() {
+		return common.ErrorInvalidG2
+	}
+
+	return nil
+}
+
+// Ensure BN254PairingCheck implements the common.Precompile interface.
+var _ common.Precompile = (*BN254PairingCheck)(nil)
+
+// Ensure BN254PairingCheck implements the common.OutputSizer interface.
+var _ common.OutputSizer = (*BN254PairingCheck)(nil)
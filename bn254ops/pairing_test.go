@@ -0,0 +1,184 @@
+package bn254ops
+
+import (
+	"math/big"
+	"slices"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bn254"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/gen"
+	"github.com/leanovate/gopter/prop"
+	"github.com/privacy-ethereum/privacy-precompiles/common"
+	groth16bn254 "github.com/privacy-ethereum/privacy-precompiles/verifier/groth16/bn254"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBN254PairingCheckName(t *testing.T) {
+	precompile := BN254PairingCheck{}
+
+	expected := "BN254PairingCheck"
+	actual := precompile.Name()
+
+	assert.Equal(t, expected, actual)
+}
+
+func TestBN254PairingCheckMaxOutputSize(t *testing.T) {
+	precompile := BN254PairingCheck{}
+
+	var sizer common.OutputSizer = &precompile
+
+	assert.Equal(t, 1, sizer.MaxOutputSize(nil))
+}
+
+func TestBN254PairingCheck(t *testing.T) {
+	_, _, g1, g2 := bn254.Generators()
+
+	// e(a*G1, b*G2) * e(-(a*b)*G1, G2) == 1
+	relation := func(a, b, c int64) []byte {
+		var left, right bn254.G1Affine
+		var scaled bn254.G2Affine
+
+		left.ScalarMultiplication(&g1, big.NewInt(a))
+		scaled.ScalarMultiplication(&g2, big.NewInt(b))
+		right.ScalarMultiplication(&g1, big.NewInt(c))
+		right.Neg(&right)
+
+		return slices.Concat(marshalG1(&left), marshalG2(&scaled), marshalG1(&right), marshalG2(&g2))
+	}
+
+	valid := relation(6, 7, 42)
+	tampered := relation(6, 7, 43)
+
+	offCurveG1 := slices.Clone(valid)
+	offCurveG1[groth16bn254.BN254Groth16G1Size-1] ^= 1
+
+	// Swapping the real and imaginary parts of X moves the point off the
+	// curve.
+	swappedG2 := slices.Clone(valid)
+	x := swappedG2[groth16bn254.BN254Groth16G1Size : groth16bn254.BN254Groth16G1Size+2*groth16bn254.BN254Groth16FieldSize]
+	x1 := slices.Clone(x[:groth16bn254.BN254Groth16FieldSize])
+	copy(x, x[groth16bn254.BN254Groth16FieldSize:])
+	copy(x[groth16bn254.BN254Groth16FieldSize:], x1)
+
+	tests := []struct {
+		name          string
+		input         []byte
+		expected      []byte
+		expectedGas   uint64
+		expectedError error
+	}{
+		{
+			name:        "valid pairing relation",
+			input:       valid,
+			expected:    []byte{1},
+			expectedGas: BN254PairingCheckBaseGas + 2*BN254PairingCheckPerPairGas,
+		},
+		{
+			name:        "tampered pairing relation",
+			input:       tampered,
+			expected:    []byte{0},
+			expectedGas: BN254PairingCheckBaseGas + 2*BN254PairingCheckPerPairGas,
+		},
+		{
+			name:        "single pair of generators",
+			input:       slices.Concat(marshalG1(&g1), marshalG2(&g2)),
+			expected:    []byte{0},
+			expectedGas: BN254PairingCheckBaseGas + BN254PairingCheckPerPairGas,
+		},
+		{
+			name:        "pair with the point at infinity",
+			input:       slices.Concat(make([]byte, groth16bn254.BN254Groth16G1Size), marshalG2(&g2)),
+			expected:    []byte{1},
+			expectedGas: BN254PairingCheckBaseGas + BN254PairingCheckPerPairGas,
+		},
+		{
+			name:        "empty input",
+			input:       []byte{},
+			expected:    []byte{1},
+			expectedGas: BN254PairingCheckBaseGas,
+		},
+		{
+			name:          "misaligned input",
+			input:         valid[1:],
+			expectedError: ErrorBN254InvalidInputLength,
+		},
+		{
+			name:          "too many pairs",
+			input:         make([]byte, (BN254PairingCheckMaxPairs+1)*BN254PairingCheckPairSize),
+			expectedError: ErrorBN254InvalidInputLength,
+		},
+		{
+			name:          "G1 point not on curve",
+			input:         offCurveG1,
+			expectedError: common.ErrorInvalidG1,
+		},
+		{
+			name:          "G2 point with swapped coordinates",
+			input:         swappedG2,
+			expectedError: common.ErrorInvalidG2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			precompile := BN254PairingCheck{}
+
+			actual, err := precompile.Run(tt.input)
+			gas := precompile.RequiredGas(tt.input)
+
+			if tt.expectedError != nil {
+				assert.Equal(t, tt.expectedError, err)
+
+				if tt.expectedError == ErrorBN254InvalidInputLength {
+					assert.Equal(t, uint64(0), gas)
+				}
+
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, tt.expected, actual)
+			assert.Equal(t, tt.expectedGas, gas)
+		})
+	}
+}
+
+func TestBN254PairingCheckProperties(t *testing.T) {
+	parameters := gopter.DefaultTestParameters()
+	parameters.MinSuccessfulTests = 20
+	properties := gopter.NewProperties(parameters)
+
+	properties.Property("e(a*G1, b*G2) * e(-(a*b)*G1, G2) is the identity", prop.ForAll(
+		func(a, b uint64) bool {
+			_, _, g1, g2 := bn254.Generators()
+
+			var product fr.Element
+			product.Mul(new(fr.Element).SetUint64(a), new(fr.Element).SetUint64(b))
+
+			var left, right bn254.G1Affine
+			var scaled bn254.G2Affine
+
+			left.ScalarMultiplication(&g1, new(big.Int).SetUint64(a))
+			scaled.ScalarMultiplication(&g2, new(big.Int).SetUint64(b))
+			right.ScalarMultiplication(&g1, product.BigInt(new(big.Int)))
+			right.Neg(&right)
+
+			precompile := BN254PairingCheck{}
+			actual, err := precompile.Run(slices.Concat(marshalG1(&left), marshalG2(&scaled), marshalG1(&right), marshalG2(&g2)))
+
+			return err == nil && actual[0] == 1
+		},
+		gen.UInt64(),
+		gen.UInt64(),
+	))
+
+	properties.TestingRun(t)
+}
+
+// marshalG2 encodes point as X.A1 || X.A0 || Y.A1 || Y.A0, the EIP-197
+// ordering produced by G2Affine.Marshal.
+func marshalG2(point *bn254.G2Affine) []byte {
+	return point.Marshal()
+}
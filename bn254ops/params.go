@@ -0,0 +1,62 @@
+package bn254ops
+
+import (
+	"errors"
+
+	"github.com/privacy-ethereum/privacy-precompiles/verifier/groth16/bn254"
+)
+
+// BN254 G1 neg precompile constants
+const (
+	// BN254G1NegInputSize defines the fixed byte length of the input to the
+	// BN254 G1 negation precompile. The input consists of a single
+	// uncompressed affine point serialized as X || Y.
+	BN254G1NegInputSize = bn254.BN254Groth16G1Size
+
+	// BN254G1NegOutputSize defines the fixed byte length of the output of the
+	// BN254 G1 negation precompile. The output is a single uncompressed
+	// affine point serialized as X || Y.
+	BN254G1NegOutputSize = bn254.BN254Groth16G1Size
+
+	// BN254G1NegGas is the gas cost estimate for executing the BN254 G1
+	// negation precompile in Ethereum.
+	//
+	// Negation is a single field subtraction, so it is priced like the EVM
+	// alt_bn128 addition precompile (EIP-1108).
+	BN254G1NegGas uint64 = 150
+)
+
+// BN254 pairing check precompile constants
+const (
+	// BN254PairingCheckPairSize defines the byte length of a single pair of
+	// the pairing check input: an uncompressed G1 point followed by an
+	// uncompressed G2 point in EIP-197 ordering.
+	BN254PairingCheckPairSize = bn254.BN254Groth16G1Size + bn254.BN254Groth16G2Size
+
+	// BN254PairingCheckMaxPairs defines the maximum number of pairs accepted
+	// by the pairing check precompile in a single invocation.
+	BN254PairingCheckMaxPairs = 16
+
+	// BN254PairingCheckOutputSize defines the byte length of the boolean
+	// result of the pairing check precompile.
+	BN254PairingCheckOutputSize = 1
+
+	// BN254PairingCheckBaseGas defines the fixed base gas cost of the pairing
+	// check precompile, matching the EVM alt_bn128 pairing precompile
+	// (EIP-1108).
+	BN254PairingCheckBaseGas uint64 = 45000
+
+	// BN254PairingCheckPerPairGas defines the gas cost charged per pair,
+	// matching the EVM alt_bn128 pairing precompile (EIP-1108).
+	//
+	// Total gas cost is calculated as:
+	//
+	//	BN254PairingCheckBaseGas + (number_of_pairs * BN254PairingCheckPerPairGas)
+	BN254PairingCheckPerPairGas uint64 = 34000
+)
+
+var (
+	// ErrorBN254InvalidInputLength is returned when the input to a BN254
+	// precompile does not have the expected length.
+	ErrorBN254InvalidInputLength = errors.New("invalid input length")
+)
@@ -0,0 +1,38 @@
+package common
+
+import "encoding/binary"
+
+// Precompile addresses, as the numeric value of the 20-byte EVM address.
+//
+// The values are part of the public interface: chains embedding these
+// precompiles rely on them being stable, so existing entries must never be
+// renumbered. New precompiles take the next free value.
+const (
+	// BabyJubJubAddAddress is the address of the BabyJubJub point addition precompile.
+	BabyJubJubAddAddress uint64 = 0x100
+
+	// BabyJubJubMulAddress is the address of the BabyJubJub scalar multiplication precompile.
+	BabyJubJubMulAddress uint64 = 0x101
+
+	// BabyJubJubValidateAddress is the address of the BabyJubJub point validation precompile.
+	BabyJubJubValidateAddress uint64 = 0x102
+
+	// BabyJubJubEdDSAAddress is the address of the BabyJubJub EdDSA verification precompile.
+	BabyJubJubEdDSAAddress uint64 = 0x103
+
+	// PoseidonAddress is the address of the Poseidon hash precompile.
+	PoseidonAddress uint64 = 0x104
+
+	// Groth16BN254Address is the address of the BN254 Groth16 verifier precompile.
+	Groth16BN254Address uint64 = 0x105
+)
+
+// Address returns the 20-byte EVM address for one of the address constants
+// above, encoded big-endian in the low-order bytes.
+func Address(value uint64) [20]byte {
+	var addr [20]byte
+
+	binary.BigEndian.PutUint64(addr[12:], value)
+
+	return addr
+}
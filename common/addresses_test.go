@@ -0,0 +1,27 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAddresses(t *testing.T) {
+	// Changing any of these values breaks chains that already embed the
+	// precompiles, so they are pinned here explicitly.
+	expected := map[uint64][20]byte{
+		BabyJubJubAddAddress:      {18: 0x01, 19: 0x00},
+		BabyJubJubMulAddress:      {18: 0x01, 19: 0x01},
+		BabyJubJubValidateAddress: {18: 0x01, 19: 0x02},
+		BabyJubJubEdDSAAddress:    {18: 0x01, 19: 0x03},
+		PoseidonAddress:           {18: 0x01, 19: 0x04},
+		Groth16BN254Address:       {18: 0x01, 19: 0x05},
+	}
+
+	// A duplicate constant would collapse two map keys.
+	assert.Len(t, expected, 6)
+
+	for value, addr := range expected {
+		assert.Equal(t, addr, Address(value))
+	}
+}
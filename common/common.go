@@ -15,6 +15,42 @@ type Precompile interface {
 	RequiredGas(input []byte) uint64
 }
 
+// OutputSizer is an optional interface implemented by precompiles that can
+// bound the byte length of their output without executing.
+//
+// It is kept separate from Precompile so that existing implementations do
+// not have to provide it.
+type OutputSizer interface {
+	// MaxOutputSize returns the maximum byte length of the output returned
+	// by Run for the given input
+	MaxOutputSize(input []byte) int
+}
+
+// PrecompileError wraps an error returned by a precompile's Run with the
+// name of the precompile that produced it.
+//
+// Several packages share sentinel messages such as "invalid input length",
+// so the name tells callers dispatching through a Registry which
+// precompile failed. The sentinel stays matchable with errors.Is, and the
+// wrapper itself can be recovered with errors.As.
+type PrecompileError struct {
+	// Name is the Name() of the failing precompile
+	Name string
+
+	// Err is the error returned by Run
+	Err error
+}
+
+// Error returns the precompile name followed by the underlying error.
+func (e *PrecompileError) Error() string {
+	return e.Name + ": " + e.Err.Error()
+}
+
+// Unwrap returns the underlying error returned by Run.
+func (e *PrecompileError) Unwrap() error {
+	return e.Err
+}
+
 var (
 	// ErrorInvalidG1 is returned when a serialized G1 point
 	// is malformed, out of bounds, or fails structural validation
@@ -35,4 +71,11 @@ var (
 	//   - Invalid field component encoding
 	//   - Corrupted or truncated calldata
 	ErrorInvalidG2 = errors.New("invalid G2 point")
+
+	// ErrorInvalidPublicWitnessSlice is returned when serialized public
+	// inputs are shorter than the requested number of inputs, or when that
+	// number is negative.
+	//
+	// The message text is kept stable for callers that match on strings.
+	ErrorInvalidPublicWitnessSlice = errors.New("invalid slice")
 )
@@ -0,0 +1,49 @@
+package common
+
+// EthPrecompile mirrors the method set of go-ethereum's
+// vm.PrecompiledContract interface:
+//
+//	RequiredGas(input []byte) uint64
+//	Run(input []byte) ([]byte, error)
+//	Name() string
+//
+// It is declared here so this module does not depend on go-ethereum. Any
+// value returned by AsEthPrecompile satisfies vm.PrecompiledContract and
+// can be installed directly into a geth fork's precompile set.
+type EthPrecompile interface {
+	// RequiredGas returns the gas charged before Run is executed
+	RequiredGas(input []byte) uint64
+
+	// Run executes the precompile logic on the given input bytes
+	Run(input []byte) ([]byte, error)
+
+	// Name returns the precompile's name
+	Name() string
+}
+
+// ethPrecompile adapts a Precompile to the EthPrecompile interface.
+type ethPrecompile struct {
+	precompile Precompile
+}
+
+// AsEthPrecompile wraps p so that it only exposes the RequiredGas, Run and
+// Name methods expected by go-ethereum. All calls are forwarded to p
+// unchanged.
+func AsEthPrecompile(p Precompile) EthPrecompile {
+	return &ethPrecompile{precompile: p}
+}
+
+// RequiredGas forwards to the wrapped precompile.
+func (c *ethPrecompile) RequiredGas(input []byte) uint64 {
+	return c.precompile.RequiredGas(input)
+}
+
+// Run forwards to the wrapped precompile.
+func (c *ethPrecompile) Run(input []byte) ([]byte, error) {
+	return c.precompile.Run(input)
+}
+
+// Name forwards to the wrapped precompile.
+func (c *ethPrecompile) Name() string {
+	return c.precompile.Name()
+}
@@ -0,0 +1,73 @@
+package common
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// gethPrecompiledContract matches the vm.PrecompiledContract interface of
+// go-ethereum without importing it.
+type gethPrecompiledContract interface {
+	RequiredGas(input []byte) uint64
+	Run(input []byte) ([]byte, error)
+	Name() string
+}
+
+type failingPrecompile struct{}
+
+func (c *failingPrecompile) Name() string {
+	return "Failing"
+}
+
+func (c *failingPrecompile) Run(input []byte) ([]byte, error) {
+	return nil, errors.New("failing")
+}
+
+func (c *failingPrecompile) RequiredGas(input []byte) uint64 {
+	return 42
+}
+
+func TestAsEthPrecompile(t *testing.T) {
+	tests := []struct {
+		name          string
+		precompile    Precompile
+		input         []byte
+		expected      []byte
+		expectedGas   uint64
+		expectedName  string
+		expectedError error
+	}{
+		{
+			name:         "forwards result",
+			precompile:   &mockPrecompile{name: "Echo"},
+			input:        []byte{1, 2, 3},
+			expected:     []byte{1, 2, 3},
+			expectedGas:  3,
+			expectedName: "Echo",
+		},
+		{
+			name:          "forwards error",
+			precompile:    &failingPrecompile{},
+			input:         []byte{1},
+			expectedGas:   42,
+			expectedName:  "Failing",
+			expectedError: errors.New("failing"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var contract gethPrecompiledContract = AsEthPrecompile(tt.precompile)
+
+			actual, err := contract.Run(tt.input)
+			gas := contract.RequiredGas(tt.input)
+
+			assert.Equal(t, tt.expectedError, err)
+			assert.Equal(t, tt.expected, actual)
+			assert.Equal(t, tt.expectedGas, gas)
+			assert.Equal(t, tt.expectedName, contract.Name())
+		})
+	}
+}
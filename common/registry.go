@@ -0,0 +1,112 @@
+package common
+
+import (
+	"errors"
+	"sync"
+)
+
+var (
+	// ErrorRegistryAddressInUse is returned when a precompile is registered
+	// at an address that already holds another precompile.
+	ErrorRegistryAddressInUse = errors.New("precompile address already registered")
+
+	// ErrorRegistryNameInUse is returned when a precompile is registered
+	// under a name that is already taken by another precompile.
+	ErrorRegistryNameInUse = errors.New("precompile name already registered")
+
+	// ErrorRegistryNilPrecompile is returned when a nil precompile is registered.
+	ErrorRegistryNilPrecompile = errors.New("nil precompile")
+
+	// ErrorRegistryUnknownAddress is returned by Run when no precompile is
+	// registered at the requested address.
+	ErrorRegistryUnknownAddress = errors.New("no precompile registered at address")
+)
+
+// Registry maps EVM-style addresses and precompile names to Precompile
+// instances.
+//
+// Each address and each name may be registered at most once. A Registry is
+// safe for concurrent use.
+type Registry struct {
+	mu        sync.RWMutex
+	byAddress map[[20]byte]Precompile
+	byName    map[string]Precompile
+}
+
+// NewRegistry returns an empty Registry.
+func NewRegistry() *Registry {
+	return &Registry{
+		byAddress: make(map[[20]byte]Precompile),
+		byName:    make(map[string]Precompile),
+	}
+}
+
+// Register adds p to the registry at addr and under p.Name().
+//
+// It returns ErrorRegistryAddressInUse or ErrorRegistryNameInUse if either
+// key is already taken, in which case the registry is left unchanged.
+func (r *Registry) Register(addr [20]byte, p Precompile) error {
+	if p == nil {
+		return ErrorRegistryNilPrecompile
+	}
+
+	name := p.Name()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.byAddress[addr]; ok {
+		return ErrorRegistryAddressInUse
+	}
+
+	if _, ok := r.byName[name]; ok {
+		return ErrorRegistryNameInUse
+	}
+
+	r.byAddress[addr] = p
+	r.byName[name] = p
+
+	return nil
+}
+
+// ByAddress returns the precompile registered at addr.
+func (r *Registry) ByAddress(addr [20]byte) (Precompile, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	p, ok := r.byAddress[addr]
+
+	return p, ok
+}
+
+// ByName returns the precompile registered under name.
+func (r *Registry) ByName(name string) (Precompile, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	p, ok := r.byName[name]
+
+	return p, ok
+}
+
+// Run executes the precompile registered at addr on input.
+//
+// Errors returned by the precompile are wrapped in a *PrecompileError
+// carrying its name, so the underlying sentinel remains matchable with
+// errors.Is. Returns ErrorRegistryUnknownAddress, unwrapped, if no
+// precompile is registered at addr.
+func (r *Registry) Run(addr [20]byte, input []byte) ([]byte, error) {
+	p, ok := r.ByAddress(addr)
+
+	if !ok {
+		return nil, ErrorRegistryUnknownAddress
+	}
+
+	output, err := p.Run(input)
+
+	if err != nil {
+		return nil, &PrecompileError{Name: p.Name(), Err: err}
+	}
+
+	return output, nil
+}
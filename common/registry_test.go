@@ -0,0 +1,132 @@
+package common
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type mockPrecompile struct {
+	name string
+}
+
+func (c *mockPrecompile) Name() string {
+	return c.name
+}
+
+func (c *mockPrecompile) Run(input []byte) ([]byte, error) {
+	return input, nil
+}
+
+func (c *mockPrecompile) RequiredGas(input []byte) uint64 {
+	return uint64(len(input))
+}
+
+func TestRegistry(t *testing.T) {
+	first := &mockPrecompile{name: "First"}
+	second := &mockPrecompile{name: "Second"}
+
+	registry := NewRegistry()
+
+	assert.Nil(t, registry.Register([20]byte{19: 1}, first))
+	assert.Nil(t, registry.Register([20]byte{19: 2}, second))
+
+	p, ok := registry.ByAddress([20]byte{19: 1})
+	assert.True(t, ok)
+	assert.Same(t, first, p)
+
+	p, ok = registry.ByName("Second")
+	assert.True(t, ok)
+	assert.Same(t, second, p)
+
+	_, ok = registry.ByAddress([20]byte{19: 3})
+	assert.False(t, ok)
+
+	_, ok = registry.ByName("Third")
+	assert.False(t, ok)
+}
+
+func TestRegistryCollisions(t *testing.T) {
+	tests := []struct {
+		name          string
+		addr          [20]byte
+		precompile    Precompile
+		expectedError error
+	}{
+		{
+			name:          "address in use",
+			addr:          [20]byte{19: 1},
+			precompile:    &mockPrecompile{name: "Other"},
+			expectedError: ErrorRegistryAddressInUse,
+		},
+		{
+			name:          "name in use",
+			addr:          [20]byte{19: 2},
+			precompile:    &mockPrecompile{name: "First"},
+			expectedError: ErrorRegistryNameInUse,
+		},
+		{
+			name:          "nil precompile",
+			addr:          [20]byte{19: 3},
+			precompile:    nil,
+			expectedError: ErrorRegistryNilPrecompile,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			first := &mockPrecompile{name: "First"}
+			registry := NewRegistry()
+			assert.Nil(t, registry.Register([20]byte{19: 1}, first))
+
+			err := registry.Register(tt.addr, tt.precompile)
+			assert.Equal(t, tt.expectedError, err)
+
+			// A rejected registration must leave the registry unchanged.
+			_, ok := registry.ByAddress(tt.addr)
+			assert.Equal(t, tt.addr == [20]byte{19: 1}, ok)
+
+			p, ok := registry.ByName("First")
+			assert.True(t, ok)
+			assert.Same(t, first, p)
+		})
+	}
+}
+
+func TestRegistryRun(t *testing.T) {
+	registry := NewRegistry()
+
+	assert.Nil(t, registry.Register([20]byte{19: 1}, &mockPrecompile{name: "Echo"}))
+	assert.Nil(t, registry.Register([20]byte{19: 2}, &failingPrecompile{}))
+
+	actual, err := registry.Run([20]byte{19: 1}, []byte{1, 2, 3})
+
+	assert.Nil(t, err)
+	assert.Equal(t, []byte{1, 2, 3}, actual)
+
+	actual, err = registry.Run([20]byte{19: 2}, []byte{1})
+
+	assert.Nil(t, actual)
+	assert.EqualError(t, err, "Failing: failing")
+
+	var precompileError *PrecompileError
+
+	assert.True(t, errors.As(err, &precompileError))
+	assert.Equal(t, "Failing", precompileError.Name)
+	assert.EqualError(t, precompileError.Err, "failing")
+
+	_, err = registry.Run([20]byte{19: 3}, nil)
+
+	assert.Equal(t, ErrorRegistryUnknownAddress, err)
+}
+
+func TestPrecompileError(t *testing.T) {
+	sentinel := errors.New("invalid input length")
+
+	var err error = &PrecompileError{Name: "Poseidon", Err: sentinel}
+
+	assert.EqualError(t, err, "Poseidon: invalid input length")
+	assert.True(t, errors.Is(err, sentinel))
+	assert.False(t, errors.Is(err, errors.New("invalid input length")))
+}
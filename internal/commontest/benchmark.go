@@ -0,0 +1,45 @@
+// Package commontest provides helpers shared by the tests and benchmarks of
+// the precompile packages.
+//
+// It lives apart from common so that the production packages do not import
+// testing.
+package commontest
+
+import (
+	"testing"
+
+	"github.com/privacy-ethereum/privacy-precompiles/common"
+)
+
+// BenchmarkGasRatio benchmarks p.Run on input and reports the measured cost
+// against the gas declared by p.RequiredGas, to help calibrate gas
+// constants.
+//
+// Besides the standard ns/op, it reports:
+//   - gas/op: the value returned by RequiredGas for input.
+//   - ns/gas: the measured ns/op divided by gas/op, omitted if the declared
+//     gas is 0.
+//
+// Precompiles priced consistently report similar ns/gas values. The
+// benchmark fails if input is rejected by Run, since the error path is
+// usually much cheaper than a successful execution.
+func BenchmarkGasRatio(b *testing.B, p common.Precompile, input []byte) {
+	b.Helper()
+
+	if _, err := p.Run(input); err != nil {
+		b.Fatalf("%s: %v", p.Name(), err)
+	}
+
+	gas := p.RequiredGas(input)
+
+	for b.Loop() {
+		_, _ = p.Run(input)
+	}
+
+	b.ReportMetric(float64(gas), "gas/op")
+
+	if gas > 0 {
+		nsPerOp := float64(b.Elapsed().Nanoseconds()) / float64(b.N)
+		b.ReportMetric(nsPerOp/float64(gas), "ns/gas")
+	}
+}
@@ -0,0 +1,62 @@
+package commontest
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type mockPrecompile struct {
+	name string
+}
+
+func (c *mockPrecompile) Name() string {
+	return c.name
+}
+
+func (c *mockPrecompile) Run(input []byte) ([]byte, error) {
+	return input, nil
+}
+
+func (c *mockPrecompile) RequiredGas(input []byte) uint64 {
+	return uint64(len(input))
+}
+
+type failingPrecompile struct{}
+
+func (c *failingPrecompile) Name() string {
+	return "Failing"
+}
+
+func (c *failingPrecompile) Run(input []byte) ([]byte, error) {
+	return nil, errors.New("failing")
+}
+
+func (c *failingPrecompile) RequiredGas(input []byte) uint64 {
+	return 42
+}
+
+func TestBenchmarkGasRatio(t *testing.T) {
+	result := testing.Benchmark(func(b *testing.B) {
+		BenchmarkGasRatio(b, &mockPrecompile{name: "Mock"}, make([]byte, 100))
+	})
+
+	assert.Positive(t, result.N)
+	assert.Equal(t, float64(100), result.Extra["gas/op"])
+	assert.Contains(t, result.Extra, "ns/gas")
+
+	result = testing.Benchmark(func(b *testing.B) {
+		BenchmarkGasRatio(b, &mockPrecompile{name: "Mock"}, nil)
+	})
+
+	assert.Positive(t, result.N)
+	assert.Equal(t, float64(0), result.Extra["gas/op"])
+	assert.NotContains(t, result.Extra, "ns/gas")
+
+	result = testing.Benchmark(func(b *testing.B) {
+		BenchmarkGasRatio(b, &failingPrecompile{}, nil)
+	})
+
+	assert.Zero(t, result.N)
+}
@@ -0,0 +1,67 @@
+package keccak
+
+import (
+	"math/big"
+
+	"github.com/iden3/go-iden3-crypto/constants"
+	"github.com/iden3/go-iden3-crypto/keccak256"
+	"github.com/privacy-ethereum/privacy-precompiles/common"
+)
+
+// KeccakToField implements the precompile hashing arbitrary bytes with
+// keccak256 and reducing the digest into the BN254 scalar field.
+//
+// Solidity contracts commonly hash data with keccak256 and then need the
+// digest as a circuit public input. The precompile returns the same field
+// element as computing uint256(keccak256(data)) % r in Solidity, where r is
+// the BN254 scalar field modulus.
+type KeccakToField struct{}
+
+// Name returns the human-readable name of the precompile.
+func (c *KeccakToField) Name() string {
+	return "KeccakToField"
+}
+
+// RequiredGas returns the gas cost of executing this precompile.
+//
+// Gas is calculated as:
+//
+//	KeccakToFieldBaseGas + (number_of_words * KeccakToFieldPerWordGas)
+//
+// Where each word is KeccakToFieldWordSize bytes and a trailing partial word
+// is charged as a full word.
+func (c *KeccakToField) RequiredGas(input []byte) uint64 {
+	return uint64(len(input)+(KeccakToFieldWordSize-1))/
+		KeccakToFieldWordSize*KeccakToFieldPerWordGas +
+		KeccakToFieldBaseGas
+}
+
+// MaxOutputSize returns the byte length of the field element returned by
+// Run, which is KeccakToFieldOutputSize regardless of the input.
+func (c *KeccakToField) MaxOutputSize(input []byte) int {
+	return KeccakToFieldOutputSize
+}
+
+// Run executes the Keccak to field precompile.
+//
+// The input is an arbitrary, possibly empty, byte string.
+//
+// Run performs the following steps:
+//  1. Computes the keccak256 digest of the input.
+//  2. Interprets the digest as a big-endian 256-bit integer.
+//  3. Reduces it modulo the BN254 scalar field modulus.
+//  4. Returns the result encoded as a 32-byte big-endian value.
+//
+// Run never returns an error.
+func (c *KeccakToField) Run(input []byte) ([]byte, error) {
+	digest := new(big.Int).SetBytes(keccak256.Hash(input))
+	digest.Mod(digest, constants.Q)
+
+	return digest.FillBytes(make([]byte, KeccakToFieldOutputSize)), nil
+}
+
+// Ensure KeccakToField implements the common.Precompile interface.
+var _ common.Precompile = (*KeccakToField)(nil)
+
+// Ensure KeccakToField implements the common.OutputSizer interface.
+var _ common.OutputSizer = (*KeccakToField)(nil)
@@ -0,0 +1,113 @@
+package keccak
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/iden3/go-iden3-crypto/constants"
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/gen"
+	"github.com/leanovate/gopter/prop"
+	"github.com/privacy-ethereum/privacy-precompiles/common"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKeccakToFieldName(t *testing.T) {
+	precompile := KeccakToField{}
+
+	expected := "KeccakToField"
+	actual := precompile.Name()
+
+	assert.Equal(t, expected, actual)
+}
+
+func TestKeccakToFieldMaxOutputSize(t *testing.T) {
+	precompile := KeccakToField{}
+
+	var sizer common.OutputSizer = &precompile
+
+	assert.Equal(t, 32, sizer.MaxOutputSize(nil))
+}
+
+func TestKeccakToField(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       []byte
+		expected    []byte
+		expectedGas uint64
+	}{
+		{
+			// keccak256("") = c5d2460186f7233c927e7db2dcc703c0e500b653ca82273b7bfad8045d85a470
+			name:        "empty input",
+			input:       []byte{},
+			expected:    fromHex("04410c360230a295b13d66d8d6c1a24c44311531e39c64f66c7301b49d85a46c"),
+			expectedGas: KeccakToFieldBaseGas,
+		},
+		{
+			// keccak256("abc") = 4e03657aea45a94fc7d47ba826c8d667c0d1e6e33a64a036ec44f58fa12d6c45
+			name:        "abc",
+			input:       []byte("abc"),
+			expected:    fromHex("1d9f1708091409260f8435f1a5477e0a989dfe9ac0ab2fa5a862fffbb12d6c44"),
+			expectedGas: KeccakToFieldBaseGas + KeccakToFieldPerWordGas,
+		},
+		{
+			// keccak256("mimc") = b6e489e6b37224a50bebfddbe7d89fa8fdcaa84304a70bd13f79b5d9f7951e9e
+			name:        "mimc",
+			input:       []byte("mimc"),
+			expected:    fromHex("25b79e8e0fdd4427e2fb2cb863549691852eef69977aba1d73d3d51e27951e9b"),
+			expectedGas: KeccakToFieldBaseGas + KeccakToFieldPerWordGas,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			precompile := KeccakToField{}
+
+			actual, err := precompile.Run(tt.input)
+			gas := precompile.RequiredGas(tt.input)
+
+			assert.Nil(t, err)
+			assert.Equal(t, tt.expected, actual)
+			assert.Equal(t, tt.expectedGas, gas)
+		})
+	}
+}
+
+func TestRunProperties(t *testing.T) {
+	parameters := gopter.DefaultTestParameters()
+	properties := gopter.NewProperties(parameters)
+
+	properties.Property("Run returns a canonical field element", prop.ForAll(
+		func(input []byte) bool {
+			precompile := KeccakToField{}
+
+			actual, err := precompile.Run(input)
+
+			if err != nil || len(actual) != KeccakToFieldOutputSize {
+				return false
+			}
+
+			return new(big.Int).SetBytes(actual).Cmp(constants.Q) < 0
+		},
+		gen.SliceOf(gen.UInt8()),
+	))
+
+	properties.Property("Gas increases with word count", prop.ForAll(
+		func(words uint8) bool {
+			precompile := KeccakToField{}
+			input := make([]byte, int(words)*KeccakToFieldWordSize)
+
+			return precompile.RequiredGas(input) == uint64(words)*KeccakToFieldPerWordGas+KeccakToFieldBaseGas
+		},
+		gen.UInt8(),
+	))
+
+	properties.TestingRun(t)
+}
+
+func fromHex(value string) []byte {
+	result, _ := hex.DecodeString(value)
+
+	return result
+}
@@ -0,0 +1,27 @@
+package keccak
+
+// Keccak to field precompile constants
+const (
+	// KeccakToFieldWordSize defines the byte length of an input word used to
+	// price the precompile, matching the EVM word size.
+	KeccakToFieldWordSize = 32
+
+	// KeccakToFieldOutputSize defines the byte length of the field element
+	// returned by the precompile.
+	KeccakToFieldOutputSize = 32
+
+	// KeccakToFieldBaseGas defines the fixed base gas cost for executing the
+	// Keccak to field precompile, independent of input size.
+	//
+	// It covers the EVM KECCAK256 base cost plus the modular reduction of
+	// the digest.
+	KeccakToFieldBaseGas uint64 = 60
+
+	// KeccakToFieldPerWordGas defines the gas cost charged per input word,
+	// rounding a trailing partial word up, as for the EVM KECCAK256 opcode.
+	//
+	// Total gas cost is calculated as:
+	//
+	//	KeccakToFieldBaseGas + (number_of_words * KeccakToFieldPerWordGas)
+	KeccakToFieldPerWordGas uint64 = 6
+)
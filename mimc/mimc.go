@@ -0,0 +1,73 @@
+package mimc
+
+import (
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr/mimc"
+	"github.com/privacy-ethereum/privacy-precompiles/common"
+)
+
+// MiMC implements the MiMC hash precompile over the BN254 scalar field.
+//
+// It satisfies the common.Precompile interface and can be used in a generic
+// precompile execution framework to compute the same MiMC digests as gnark
+// circuits using the BN254 MiMC hasher.
+type MiMC struct{}
+
+// Name returns the human-readable name of the precompile.
+func (c *MiMC) Name() string {
+	return "MiMC"
+}
+
+// RequiredGas returns the gas cost of executing this precompile.
+//
+// Gas is calculated as:
+//
+//	MiMCBaseGas + (number_of_words * MiMCPerWordGas)
+//
+// Where each word is a 32-byte field element.
+func (c *MiMC) RequiredGas(input []byte) uint64 {
+	return uint64(len(input)+(MiMCInputWordSize-1))/
+		MiMCInputWordSize*MiMCPerWordGas +
+		MiMCBaseGas
+}
+
+// Run executes the MiMC hash precompile.
+//
+// The input must consist of N field elements encoded as:
+//
+//	e1 || e2 || ... || eN
+//
+// Where:
+//   - Each element is a big-endian integer padded to MiMCInputWordSize bytes.
+//   - 1 <= N <= MiMCMaxParams.
+//   - The total input length must be a multiple of MiMCInputWordSize.
+//
+// Run performs the following steps:
+//  1. Validates input length and parameter bounds.
+//  2. Absorbs the elements into the gnark-crypto BN254 MiMC hasher.
+//  3. Returns the resulting field element encoded as a 32-byte big-endian value.
+//
+// Returns an error if:
+//   - The input length is zero.
+//   - The input length is not a multiple of MiMCInputWordSize.
+//   - The number of elements exceeds MiMCMaxParams.
+//   - Any element is not inside the field (ErrorMiMCInputNotInField).
+func (c *MiMC) Run(input []byte) ([]byte, error) {
+	if len(input) == 0 || len(input)%MiMCInputWordSize != 0 {
+		return nil, ErrorMiMCInvalidInputLength
+	}
+
+	if len(input)/MiMCInputWordSize > MiMCMaxParams {
+		return nil, ErrorMiMCInvalidInputLength
+	}
+
+	hasher := mimc.NewMiMC()
+
+	if _, err := hasher.Write(input); err != nil {
+		return nil, ErrorMiMCInputNotInField
+	}
+
+	return hasher.Sum(nil), nil
+}
+
+// Ensure MiMC implements the common.Precompile interface.
+var _ common.Precompile = (*MiMC)(nil)
@@ -0,0 +1,141 @@
+package mimc
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	"github.com/iden3/go-iden3-crypto/constants"
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/gen"
+	"github.com/leanovate/gopter/prop"
+	"github.com/privacy-ethereum/privacy-precompiles/babyjubjub/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMiMCName(t *testing.T) {
+	precompile := MiMC{}
+
+	expected := "MiMC"
+	actual := precompile.Name()
+
+	assert.Equal(t, expected, actual)
+}
+
+func TestMiMCHash(t *testing.T) {
+	tests := []struct {
+		name          string
+		input         []byte
+		expected      []byte
+		expectedGas   uint64
+		expectedError error
+	}{
+		{
+			name:        "mimc hash of single word test vector",
+			input:       prepareInput([]*big.Int{fromHex("105afe02a0f7648bee1669b05bf7ae69a37dbb6c86ebbee325dffe97ac1f8e64")}),
+			expected:    prepareInput([]*big.Int{fromHex("263b9e754e6c611d646e65b16c48f51ab7bc0abedfae9c6ea04e2814ed28daf4")}),
+			expectedGas: MiMCBaseGas + MiMCPerWordGas,
+		},
+		{
+			name: "mimc hash of two words test vector",
+			input: prepareInput([]*big.Int{
+				fromHex("208f0b283064057cf912b65eaa51e2cb2b85fdbe2fd0b2841f4bca59321ef1bf"),
+				fromHex("226bee7671296d05c998a5b5b4b1d25f478696d5997ba4f4be1a682c56a69e11"),
+			}),
+			expected:    prepareInput([]*big.Int{fromHex("1476ada1433d73817a69e45c84c5d452ad858f2dfdb1f7e4da203d3c4fd42222")}),
+			expectedGas: MiMCBaseGas + 2*MiMCPerWordGas,
+		},
+		{
+			name:          "mimc hash of empty input",
+			input:         []byte{},
+			expectedError: ErrorMiMCInvalidInputLength,
+		},
+		{
+			name:          "mimc hash invalid input length",
+			input:         make([]byte, MiMCInputWordSize-1),
+			expectedError: ErrorMiMCInvalidInputLength,
+		},
+		{
+			name:          "mimc hash of too many words",
+			input:         make([]byte, MiMCInputWordSize*(MiMCMaxParams+1)),
+			expectedError: ErrorMiMCInvalidInputLength,
+		},
+		{
+			name:          "mimc hash of word equal to the field modulus",
+			input:         prepareInput([]*big.Int{big.NewInt(1), constants.Q}),
+			expectedError: ErrorMiMCInputNotInField,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			precompile := MiMC{}
+
+			actual, err := precompile.Run(tt.input)
+			gas := precompile.RequiredGas(tt.input)
+
+			if tt.expectedError != nil {
+				assert.NotNil(t, err)
+				assert.Equal(t, tt.expectedError, err)
+
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, tt.expected, actual)
+			assert.Equal(t, tt.expectedGas, gas)
+		})
+	}
+}
+
+func TestRunProperties(t *testing.T) {
+	parameters := gopter.DefaultTestParameters()
+	properties := gopter.NewProperties(parameters)
+
+	properties.Property("Run returns correct deterministic mimc hash for valid field elements", prop.ForAll(
+		func(scalars []*big.Int) bool {
+			precompile := MiMC{}
+			input := prepareInput(scalars)
+
+			result1, err1 := precompile.Run(input)
+			result2, err2 := precompile.Run(input)
+
+			if err1 != nil || err2 != nil {
+				return false
+			}
+
+			return bytes.Equal(result1, result2)
+		},
+		gen.SliceOfN(MiMCMaxParams, utils.ScalarGenerator()),
+	))
+
+	properties.Property("Gas increases with word count", prop.ForAll(
+		func(words uint8) bool {
+			precompile := MiMC{}
+			input := make([]byte, int(words)*MiMCInputWordSize)
+
+			return precompile.RequiredGas(input) == uint64(words)*MiMCPerWordGas+MiMCBaseGas
+		},
+		gen.UInt8(),
+	))
+
+	properties.TestingRun(t)
+}
+
+func fromHex(value string) *big.Int {
+	result, _ := new(big.Int).SetString(value, 16)
+
+	return result
+}
+
+func prepareInput(scalars []*big.Int) []byte {
+	input := make([]byte, 0, len(scalars)*MiMCInputWordSize)
+
+	for _, scalar := range scalars {
+		buffer := make([]byte, MiMCInputWordSize)
+		scalar.FillBytes(buffer)
+		input = append(input, buffer...)
+	}
+
+	return input
+}
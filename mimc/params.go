@@ -0,0 +1,44 @@
+package mimc
+
+import "errors"
+
+// MiMC hash precompile constants
+const (
+	// MiMCInputWordSize defines the fixed byte length of a single MiMC input
+	// field element.
+	//
+	// Each element must be encoded as a big-endian field element padded
+	// to 32 bytes.
+	MiMCInputWordSize = 32
+
+	// MiMCMaxParams defines the maximum number of field elements accepted
+	// by the MiMC precompile in a single invocation.
+	MiMCMaxParams = 16
+
+	// MiMCBaseGas defines the fixed base gas cost for executing the MiMC
+	// hash precompile, independent of input size.
+	MiMCBaseGas uint64 = 600
+
+	// MiMCPerWordGas defines the gas cost charged per input field element
+	// (word) provided to the precompile.
+	//
+	// Total gas cost is calculated as:
+	//
+	//	MiMCBaseGas + (number_of_words * MiMCPerWordGas)
+	MiMCPerWordGas uint64 = 6600
+)
+
+var (
+	// ErrorMiMCInvalidInputLength is returned when the input to the MiMC
+	// precompile does not conform to the expected format.
+	//
+	// This occurs when:
+	//   - The input length is zero.
+	//   - The input length is not a multiple of MiMCInputWordSize.
+	//   - The number of input words exceeds MiMCMaxParams.
+	ErrorMiMCInvalidInputLength = errors.New("invalid input length")
+
+	// ErrorMiMCInputNotInField is returned when an input word is equal to or
+	// greater than the BN254 scalar field modulus.
+	ErrorMiMCInputNotInField = errors.New("inputs values not inside Finite Field")
+)
@@ -0,0 +1,110 @@
+package poseidon
+
+import "github.com/privacy-ethereum/privacy-precompiles/common"
+
+// PoseidonWithArity implements a Poseidon hash precompile over a fixed,
+// caller-selected arity.
+//
+// go-iden3-crypto picks the Poseidon parameter set from the number of
+// inputs: N inputs are hashed with the width t = N + 1 permutation. Circuits
+// built around a fixed-width Poseidon, such as circomlib's Poseidon(n)
+// template fed with fewer than n signals, hash the message zero-padded to n
+// elements. PoseidonWithArity reproduces those outputs by padding the
+// message to the selected arity before hashing:
+//
+//	PoseidonWithArity(n, w1, ..., wK) = Poseidon(w1, ..., wK, 0, ..., 0)
+//
+// with n - K trailing zeros.
+//
+// Supported arities are PoseidonMinArity to PoseidonMaxArity, i.e. the
+// iden3 parameter sets of width t = 2 to t = 17 over the BN254 scalar
+// field, which is also the BabyJubJub base field.
+type PoseidonWithArity struct{}
+
+// Name returns the human-readable name of the precompile.
+func (c *PoseidonWithArity) Name() string {
+	return "PoseidonWithArity"
+}
+
+// RequiredGas returns the gas cost of executing this precompile.
+//
+// The permutation cost depends on the selected arity rather than on the
+// message length, so gas is calculated as:
+//
+//	PoseidonBaseGas + (arity * PoseidonPerWordGas)
+//
+// Inputs rejected by Run for their length or arity cost 0.
+func (c *PoseidonWithArity) RequiredGas(input []byte) uint64 {
+	arity, ok := arityOf(input)
+
+	if !ok {
+		return 0
+	}
+
+	return PoseidonBaseGas + uint64(arity)*PoseidonPerWordGas
+}
+
+// MaxOutputSize returns the byte length of the hash returned by Run,
+// which is PoseidonOutputSize regardless of the input.
+func (c *PoseidonWithArity) MaxOutputSize(input []byte) int {
+	return PoseidonOutputSize
+}
+
+// Run executes the fixed-arity Poseidon hash precompile.
+//
+// The input must be encoded as:
+//
+//	n || w1 || w2 || ... || wK
+//
+// Where:
+//   - n is a PoseidonArityPrefixSize-byte arity, with
+//     PoseidonMinArity <= n <= PoseidonMaxArity.
+//   - w1..wK are the message words, encoded as accepted by Poseidon.Run.
+//   - 1 <= K <= n.
+//
+// Run zero-pads the message to n words and returns Poseidon.Run over the
+// padded message.
+//
+// Returns an error if:
+//   - The arity is unsupported, the message is empty, its length is not a
+//     multiple of PoseidonInputWordSize, or it holds more than n words
+//     (ErrorPoseidonInvalidInputLength).
+//   - Any word is not inside the field (ErrorPoseidonInputNotInField).
+func (c *PoseidonWithArity) Run(input []byte) ([]byte, error) {
+	arity, ok := arityOf(input)
+
+	if !ok {
+		return nil, ErrorPoseidonInvalidInputLength
+	}
+
+	padded := make([]byte, arity*PoseidonInputWordSize)
+	copy(padded, input[PoseidonArityPrefixSize:])
+
+	return (&Poseidon{}).Run(padded)
+}
+
+// arityOf returns the arity selected by a PoseidonWithArity input, and
+// false if the arity is unsupported or the message does not hold between
+// 1 and arity whole words.
+func arityOf(input []byte) (int, bool) {
+	if len(input) <= PoseidonArityPrefixSize {
+		return 0, false
+	}
+
+	arity := int(input[0])
+	message := len(input) - PoseidonArityPrefixSize
+
+	if arity < PoseidonMinArity || arity > PoseidonMaxArity ||
+		message%PoseidonInputWordSize != 0 ||
+		message/PoseidonInputWordSize > arity {
+		return 0, false
+	}
+
+	return arity, true
+}
+
+// Ensure PoseidonWithArity implements the common.Precompile interface.
+var _ common.Precompile = (*PoseidonWithArity)(nil)
+
+// Ensure PoseidonWithArity implements the common.OutputSizer interface.
+var _ common.OutputSizer = (*PoseidonWithArity)(nil)
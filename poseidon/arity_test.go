@@ -0,0 +1,177 @@
+package poseidon
+
+import (
+	"bytes"
+	"errors"
+	"math/big"
+	"reflect"
+	"testing"
+
+	"github.com/iden3/go-iden3-crypto/constants"
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/gen"
+	"github.com/leanovate/gopter/prop"
+	"github.com/privacy-ethereum/privacy-precompiles/common"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPoseidonWithArityName(t *testing.T) {
+	precompile := PoseidonWithArity{}
+
+	expected := "PoseidonWithArity"
+	actual := precompile.Name()
+
+	assert.Equal(t, expected, actual)
+}
+
+func TestPoseidonWithArityMaxOutputSize(t *testing.T) {
+	precompile := PoseidonWithArity{}
+
+	var sizer common.OutputSizer = &precompile
+
+	assert.Equal(t, 32, sizer.MaxOutputSize(nil))
+}
+
+func TestPoseidonWithArity(t *testing.T) {
+	// Known answers are the zero-padded go-iden3-crypto test vectors.
+	tests := []struct {
+		name          string
+		input         []byte
+		expected      string
+		expectedGas   uint64
+		expectedError error
+	}{
+		{
+			name:        "arity 1",
+			input:       arityInput(1, 1),
+			expected:    "18586133768512220936620570745912940619677854269274689475585506675881198879027",
+			expectedGas: PoseidonBaseGas + PoseidonPerWordGas,
+		},
+		{
+			name:        "arity 2",
+			input:       arityInput(2, 1, 2),
+			expected:    "7853200120776062878684798364095072458815029376092732009249414926327459813530",
+			expectedGas: PoseidonBaseGas + 2*PoseidonPerWordGas,
+		},
+		{
+			name:        "arity 5 with zero padding",
+			input:       arityInput(5, 1, 2),
+			expected:    "1018317224307729531995786483840663576608797660851238720571059489595066344487",
+			expectedGas: PoseidonBaseGas + 5*PoseidonPerWordGas,
+		},
+		{
+			name:        "arity 6 with zero padding",
+			input:       arityInput(6, 1, 2),
+			expected:    "15336558801450556532856248569924170992202208561737609669134139141992924267169",
+			expectedGas: PoseidonBaseGas + 6*PoseidonPerWordGas,
+		},
+		{
+			name:        "arity 14 with zero padding",
+			input:       arityInput(14, 1, 2, 3, 4, 5, 6, 7, 8, 9),
+			expected:    "5540388656744764564518487011617040650780060800286365721923524861648744699539",
+			expectedGas: PoseidonBaseGas + 14*PoseidonPerWordGas,
+		},
+		{
+			name:        "arity 16 with zero padding",
+			input:       arityInput(16, 1, 2, 3, 4, 5, 6, 7, 8, 9),
+			expected:    "11882816200654282475720830292386643970958445617880627439994635298904836126497",
+			expectedGas: PoseidonBaseGas + 16*PoseidonPerWordGas,
+		},
+		{
+			name:          "empty input",
+			input:         []byte{},
+			expectedError: ErrorPoseidonInvalidInputLength,
+		},
+		{
+			name:          "arity without message",
+			input:         []byte{2},
+			expectedError: ErrorPoseidonInvalidInputLength,
+		},
+		{
+			name:          "arity 0",
+			input:         arityInput(0, 1),
+			expectedError: ErrorPoseidonInvalidInputLength,
+		},
+		{
+			name:          "arity above PoseidonMaxArity",
+			input:         arityInput(PoseidonMaxArity+1, 1),
+			expectedError: ErrorPoseidonInvalidInputLength,
+		},
+		{
+			name:          "more words than the arity",
+			input:         arityInput(2, 1, 2, 3),
+			expectedError: ErrorPoseidonInvalidInputLength,
+		},
+		{
+			name:          "partial word",
+			input:         arityInput(2, 1)[:PoseidonArityPrefixSize+PoseidonInputWordSize-1],
+			expectedError: ErrorPoseidonInvalidInputLength,
+		},
+		{
+			name: "word equal to the field modulus",
+			input: append(
+				[]byte{2},
+				constants.Q.FillBytes(make([]byte, PoseidonInputWordSize))...,
+			),
+			expectedError: ErrorPoseidonInputNotInField,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			precompile := PoseidonWithArity{}
+
+			actual, err := precompile.Run(tt.input)
+			gas := precompile.RequiredGas(tt.input)
+
+			if tt.expectedError != nil {
+				assert.NotNil(t, err)
+				assert.True(t, errors.Is(err, tt.expectedError))
+
+				if tt.expectedError == ErrorPoseidonInvalidInputLength {
+					assert.Equal(t, uint64(0), gas)
+				}
+
+				return
+			}
+
+			expected, _ := new(big.Int).SetString(tt.expected, 10)
+
+			assert.Nil(t, err)
+			assert.Equal(t, expected.FillBytes(make([]byte, PoseidonOutputSize)), actual)
+			assert.Equal(t, tt.expectedGas, gas)
+		})
+	}
+}
+
+func TestPoseidonWithArityProperties(t *testing.T) {
+	parameters := gopter.DefaultTestParameters()
+	properties := gopter.NewProperties(parameters)
+
+	properties.Property("a full message matches Poseidon", prop.ForAll(
+		func(words []uint64) bool {
+			input := arityInput(len(words), words...)
+
+			expected, _ := (&Poseidon{}).Run(input[PoseidonArityPrefixSize:])
+			actual, err := (&PoseidonWithArity{}).Run(input)
+
+			return err == nil && bytes.Equal(expected, actual)
+		},
+		gen.IntRange(PoseidonMinArity, PoseidonMaxArity).FlatMap(func(n any) gopter.Gen {
+			return gen.SliceOfN(n.(int), gen.UInt64())
+		}, reflect.TypeOf([]uint64{})),
+	))
+
+	properties.TestingRun(t)
+}
+
+// arityInput encodes a PoseidonWithArity input selecting arity over words.
+func arityInput(arity int, words ...uint64) []byte {
+	input := []byte{byte(arity)}
+
+	for _, word := range words {
+		input = append(input, new(big.Int).SetUint64(word).FillBytes(make([]byte, PoseidonInputWordSize))...)
+	}
+
+	return input
+}
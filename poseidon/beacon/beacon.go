@@ -0,0 +1,89 @@
+package beacon
+
+import (
+	"math/big"
+
+	"github.com/iden3/go-iden3-crypto/poseidon"
+	iden3Utils "github.com/iden3/go-iden3-crypto/utils"
+	"github.com/privacy-ethereum/privacy-precompiles/common"
+	poseidonPrecompile "github.com/privacy-ethereum/privacy-precompiles/poseidon"
+	commonUtils "github.com/privacy-ethereum/privacy-precompiles/utils"
+)
+
+// PoseidonBeaconCommitVerify implements a precompile opening a Poseidon
+// commitment that is bound to a public randomness beacon round.
+//
+// It satisfies the common.Precompile interface and can be used in a generic
+// precompile execution framework, e.g. in commit-reveal schemes tied to a
+// randomness beacon.
+//
+// The commitment is computed as:
+//
+//	commitment = poseidon(value, salt, beacon)
+//
+// Because the beacon value of the round is an input of the hash, an opening
+// is only valid for that round: the same (value, salt) pair cannot be used to
+// open the commitment against the beacon of any other round.
+type PoseidonBeaconCommitVerify struct{}
+
+// Name returns the human-readable name of the precompile.
+func (c *PoseidonBeaconCommitVerify) Name() string {
+	return "PoseidonBeaconCommitVerify"
+}
+
+// RequiredGas returns the fixed gas cost of executing this precompile.
+//
+// For beacon commitment verification, the gas cost is PoseidonBeaconCommitVerifyGas.
+func (c *PoseidonBeaconCommitVerify) RequiredGas(input []byte) uint64 {
+	return PoseidonBeaconCommitVerifyGas
+}
+
+// Run executes the beacon commitment verification precompile.
+//
+// The input must be exactly PoseidonBeaconCommitVerifyInputSize bytes:
+//
+//	commitment || value || salt || beacon
+//
+// Each element is a big-endian field element padded to
+// poseidon.PoseidonInputWordSize bytes.
+//
+// Run performs the following steps:
+//  1. Validates the input length.
+//  2. Validates that every element is inside the Poseidon field.
+//  3. Computes poseidon(value, salt, beacon).
+//  4. Returns []byte{1} if the hash equals commitment, []byte{0} otherwise.
+//
+// Returns an error if:
+//   - The input length is incorrect.
+//   - Any element is not inside the Poseidon field.
+func (c *PoseidonBeaconCommitVerify) Run(input []byte) ([]byte, error) {
+	if len(input) != PoseidonBeaconCommitVerifyInputSize {
+		return nil, ErrorPoseidonBeaconCommitVerifyInvalidInputLength
+	}
+
+	elements := make([]*big.Int, PoseidonBeaconCommitVerifyInputSize/poseidonPrecompile.PoseidonInputWordSize)
+	offset := 0
+
+	for index := range elements {
+		elements[index], offset = commonUtils.ReadField(input, offset, poseidonPrecompile.PoseidonInputWordSize)
+	}
+
+	if !iden3Utils.CheckBigIntArrayInField(elements) {
+		return nil, ErrorPoseidonBeaconCommitVerifyNotInField
+	}
+
+	hash, err := poseidon.Hash(elements[1:])
+
+	if err != nil {
+		return nil, err
+	}
+
+	if hash.Cmp(elements[0]) == 0 {
+		return []byte{1}, nil
+	}
+
+	return []byte{0}, nil
+}
+
+// Ensure PoseidonBeaconCommitVerify implements the common.Precompile interface.
+var _ common.Precompile = (*PoseidonBeaconCommitVerify)(nil)
@@ -0,0 +1,145 @@
+package beacon
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/iden3/go-iden3-crypto/poseidon"
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/prop"
+	"github.com/privacy-ethereum/privacy-precompiles/babyjubjub/utils"
+	poseidonPrecompile "github.com/privacy-ethereum/privacy-precompiles/poseidon"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPoseidonBeaconCommitVerifyName(t *testing.T) {
+	precompile := PoseidonBeaconCommitVerify{}
+
+	expected := "PoseidonBeaconCommitVerify"
+	actual := precompile.Name()
+
+	assert.Equal(t, expected, actual)
+}
+
+func TestPoseidonBeaconCommitVerify(t *testing.T) {
+	value := big.NewInt(42)
+	salt := big.NewInt(123456789)
+	beacon := big.NewInt(1001)
+	otherBeacon := big.NewInt(1002)
+
+	commitment, _ := poseidon.Hash([]*big.Int{value, salt, beacon})
+
+	tests := []struct {
+		name          string
+		input         []byte
+		expected      []byte
+		expectedError error
+	}{
+		{
+			name:     "matching beacon",
+			input:    prepareInput(commitment, value, salt, beacon),
+			expected: []byte{1},
+		},
+		{
+			name:     "different beacon",
+			input:    prepareInput(commitment, value, salt, otherBeacon),
+			expected: []byte{0},
+		},
+		{
+			name:     "different value",
+			input:    prepareInput(commitment, big.NewInt(43), salt, beacon),
+			expected: []byte{0},
+		},
+		{
+			name:     "different salt",
+			input:    prepareInput(commitment, value, big.NewInt(1), beacon),
+			expected: []byte{0},
+		},
+		{
+			name:          "commitment not in field",
+			input:         prepareInput(utils.FieldPrime, value, salt, beacon),
+			expectedError: ErrorPoseidonBeaconCommitVerifyNotInField,
+		},
+		{
+			name:          "beacon not in field",
+			input:         prepareInput(commitment, value, salt, utils.FieldPrime),
+			expectedError: ErrorPoseidonBeaconCommitVerifyNotInField,
+		},
+		{
+			name:          "input too short",
+			input:         make([]byte, PoseidonBeaconCommitVerifyInputSize-1),
+			expectedError: ErrorPoseidonBeaconCommitVerifyInvalidInputLength,
+		},
+		{
+			name:          "input too long",
+			input:         make([]byte, PoseidonBeaconCommitVerifyInputSize+poseidonPrecompile.PoseidonInputWordSize),
+			expectedError: ErrorPoseidonBeaconCommitVerifyInvalidInputLength,
+		},
+		{
+			name:          "empty input",
+			input:         []byte{},
+			expectedError: ErrorPoseidonBeaconCommitVerifyInvalidInputLength,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			precompile := PoseidonBeaconCommitVerify{}
+
+			actual, err := precompile.Run(tt.input)
+			gas := precompile.RequiredGas(tt.input)
+
+			if tt.expectedError != nil {
+				assert.NotNil(t, err)
+				assert.Equal(t, tt.expectedError, err)
+
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, tt.expected, actual)
+			assert.Equal(t, PoseidonBeaconCommitVerifyGas, gas)
+		})
+	}
+}
+
+func TestRunProperties(t *testing.T) {
+	parameters := gopter.DefaultTestParameters()
+	properties := gopter.NewProperties(parameters)
+
+	properties.Property("Run only opens the commitment for its own beacon round", prop.ForAll(
+		func(value, salt, beacon, otherBeacon *big.Int) bool {
+			precompile := PoseidonBeaconCommitVerify{}
+			commitment, _ := poseidon.Hash([]*big.Int{value, salt, beacon})
+
+			matching, err1 := precompile.Run(prepareInput(commitment, value, salt, beacon))
+			other, err2 := precompile.Run(prepareInput(commitment, value, salt, otherBeacon))
+
+			if err1 != nil || err2 != nil {
+				return false
+			}
+
+			if beacon.Cmp(otherBeacon) == 0 {
+				return matching[0] == 1 && other[0] == 1
+			}
+
+			return matching[0] == 1 && other[0] == 0
+		},
+		utils.ScalarGenerator(),
+		utils.ScalarGenerator(),
+		utils.ScalarGenerator(),
+		utils.ScalarGenerator(),
+	))
+
+	properties.TestingRun(t)
+}
+
+func prepareInput(commitment, value, salt, beacon *big.Int) []byte {
+	input := make([]byte, 0, PoseidonBeaconCommitVerifyInputSize)
+
+	for _, element := range []*big.Int{commitment, value, salt, beacon} {
+		input = append(input, element.FillBytes(make([]byte, poseidonPrecompile.PoseidonInputWordSize))...)
+	}
+
+	return input
+}
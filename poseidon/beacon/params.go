@@ -0,0 +1,31 @@
+package beacon
+
+import (
+	"errors"
+
+	"github.com/privacy-ethereum/privacy-precompiles/poseidon"
+)
+
+// Poseidon beacon commitment precompile constants
+const (
+	// PoseidonBeaconCommitVerifyInputSize defines the exact byte length of the
+	// beacon commitment verification input:
+	//
+	//	commitment || value || salt || beacon
+	PoseidonBeaconCommitVerifyInputSize = 4 * poseidon.PoseidonInputWordSize
+
+	// PoseidonBeaconCommitVerifyGas defines the fixed gas cost of the beacon
+	// commitment verification precompile. It matches the cost of hashing
+	// three words with the Poseidon precompile.
+	PoseidonBeaconCommitVerifyGas = poseidon.PoseidonBaseGas + 3*poseidon.PoseidonPerWordGas
+)
+
+var (
+	// ErrorPoseidonBeaconCommitVerifyInvalidInputLength is returned when the
+	// input length is not exactly PoseidonBeaconCommitVerifyInputSize bytes.
+	ErrorPoseidonBeaconCommitVerifyInvalidInputLength = errors.New("invalid input length")
+
+	// ErrorPoseidonBeaconCommitVerifyNotInField is returned when any input
+	// element is not inside the Poseidon finite field.
+	ErrorPoseidonBeaconCommitVerifyNotInField = errors.New("inputs values not inside Finite Field")
+)
@@ -0,0 +1,130 @@
+package commit
+
+import (
+	"bytes"
+
+	"github.com/privacy-ethereum/privacy-precompiles/common"
+	"github.com/privacy-ethereum/privacy-precompiles/poseidon"
+)
+
+// PoseidonCommit implements a Poseidon commitment precompile for
+// commit-reveal schemes.
+//
+// The commitment is computed as:
+//
+//	commitment = poseidon(value, salt)
+//
+// Contracts that commit with PoseidonCommit and open with
+// PoseidonCommitVerify share a single layout, so commitments produced by
+// one contract can be revealed to another.
+type PoseidonCommit struct{}
+
+// Name returns the human-readable name of the precompile.
+func (c *PoseidonCommit) Name() string {
+	return "PoseidonCommit"
+}
+
+// RequiredGas returns the fixed gas cost of executing this precompile.
+//
+// For Poseidon commitments, the gas cost is PoseidonCommitGas.
+func (c *PoseidonCommit) RequiredGas(input []byte) uint64 {
+	return PoseidonCommitGas
+}
+
+// MaxOutputSize returns the byte length of the commitment returned by Run,
+// which is PoseidonCommitOutputSize regardless of the input.
+func (c *PoseidonCommit) MaxOutputSize(input []byte) int {
+	return PoseidonCommitOutputSize
+}
+
+// Run executes the Poseidon commitment precompile.
+//
+// The input must be exactly PoseidonCommitInputSize bytes:
+//
+//	value || salt
+//
+// Each element is a big-endian field element padded to
+// poseidon.PoseidonInputWordSize bytes.
+//
+// Run returns poseidon(value, salt) as computed by the Poseidon precompile.
+//
+// Returns an error if:
+//   - The input length is incorrect.
+//   - Any element is not inside the Poseidon field
+//     (poseidon.ErrorPoseidonInputNotInField).
+func (c *PoseidonCommit) Run(input []byte) ([]byte, error) {
+	if len(input) != PoseidonCommitInputSize {
+		return nil, ErrorPoseidonCommitInvalidInputLength
+	}
+
+	return (&poseidon.Poseidon{}).Run(input)
+}
+
+// PoseidonCommitVerify implements the reveal check matching PoseidonCommit.
+type PoseidonCommitVerify struct{}
+
+// Name returns the human-readable name of the precompile.
+func (c *PoseidonCommitVerify) Name() string {
+	return "PoseidonCommitVerify"
+}
+
+// RequiredGas returns the fixed gas cost of executing this precompile.
+//
+// For Poseidon commitment verification, the gas cost is PoseidonCommitGas.
+func (c *PoseidonCommitVerify) RequiredGas(input []byte) uint64 {
+	return PoseidonCommitGas
+}
+
+// MaxOutputSize returns the byte length of the boolean result returned by
+// Run, which is PoseidonCommitVerifyOutputSize regardless of the input.
+func (c *PoseidonCommitVerify) MaxOutputSize(input []byte) int {
+	return PoseidonCommitVerifyOutputSize
+}
+
+// Run executes the Poseidon commitment verification precompile.
+//
+// The input must be exactly PoseidonCommitVerifyInputSize bytes:
+//
+//	value || salt || commitment
+//
+// Each element is a big-endian field element padded to
+// poseidon.PoseidonInputWordSize bytes.
+//
+// Run performs the following steps:
+//  1. Validates the input length.
+//  2. Computes the commitment of value || salt with PoseidonCommit.
+//  3. Returns []byte{1} if it equals commitment, []byte{0} otherwise.
+//
+// Returns an error if:
+//   - The input length is incorrect.
+//   - value or salt is not inside the Poseidon field
+//     (poseidon.ErrorPoseidonInputNotInField).
+func (c *PoseidonCommitVerify) Run(input []byte) ([]byte, error) {
+	if len(input) != PoseidonCommitVerifyInputSize {
+		return nil, ErrorPoseidonCommitInvalidInputLength
+	}
+
+	commitment, err := (&PoseidonCommit{}).Run(input[:PoseidonCommitInputSize])
+
+	if err != nil {
+		return nil, err
+	}
+
+	if bytes.Equal(commitment, input[PoseidonCommitInputSize:]) {
+		return []byte{1}, nil
+	}
+
+	return []byte{0}, nil
+}
+
+// Ensure PoseidonCommit implements the common.Precompile interface.
+var _ common.Precompile = (*PoseidonCommit)(nil)
+
+// Ensure PoseidonCommit implements the common.OutputSizer interface.
+var _ common.OutputSizer = (*PoseidonCommit)(nil)
+
+// Ensure PoseidonCommitVerify implements the common.Precompile interface.
+var _ common.Precompile = (*PoseidonCommitVerify)(nil)
+
+// Ensure PoseidonCommitVerify implements the common.OutputSizer interface.
+var _ common.OutputSizer = (*PoseidonCommitVerify)(nil)
@@ -0,0 +1,215 @@
+package commit
+
+import (
+	"errors"
+	"math/big"
+	"slices"
+	"testing"
+
+	"github.com/iden3/go-iden3-crypto/poseidon"
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/prop"
+	"github.com/privacy-ethereum/privacy-precompiles/babyjubjub/utils"
+	"github.com/privacy-ethereum/privacy-precompiles/common"
+	poseidonPrecompile "github.com/privacy-ethereum/privacy-precompiles/poseidon"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPoseidonCommitName(t *testing.T) {
+	precompile := PoseidonCommit{}
+
+	expected := "PoseidonCommit"
+	actual := precompile.Name()
+
+	assert.Equal(t, expected, actual)
+}
+
+func TestPoseidonCommitVerifyName(t *testing.T) {
+	precompile := PoseidonCommitVerify{}
+
+	expected := "PoseidonCommitVerify"
+	actual := precompile.Name()
+
+	assert.Equal(t, expected, actual)
+}
+
+func TestPoseidonCommitMaxOutputSize(t *testing.T) {
+	var sizer common.OutputSizer = &PoseidonCommit{}
+
+	assert.Equal(t, 32, sizer.MaxOutputSize(nil))
+
+	sizer = &PoseidonCommitVerify{}
+
+	assert.Equal(t, 1, sizer.MaxOutputSize(nil))
+}
+
+func TestPoseidonCommit(t *testing.T) {
+	value := big.NewInt(42)
+	salt := big.NewInt(123456789)
+
+	commitment, _ := poseidon.Hash([]*big.Int{value, salt})
+
+	tests := []struct {
+		name          string
+		input         []byte
+		expected      []byte
+		expectedError error
+	}{
+		{
+			name:     "commitment of value and salt",
+			input:    encode(value, salt),
+			expected: encode(commitment),
+		},
+		{
+			name:          "empty input",
+			input:         []byte{},
+			expectedError: ErrorPoseidonCommitInvalidInputLength,
+		},
+		{
+			name:          "missing salt",
+			input:         encode(value),
+			expectedError: ErrorPoseidonCommitInvalidInputLength,
+		},
+		{
+			name:          "value not in field",
+			input:         encode(utils.FieldPrime, salt),
+			expectedError: poseidonPrecompile.ErrorPoseidonInputNotInField,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			precompile := PoseidonCommit{}
+
+			actual, err := precompile.Run(tt.input)
+			gas := precompile.RequiredGas(tt.input)
+
+			if tt.expectedError != nil {
+				assert.NotNil(t, err)
+				assert.True(t, errors.Is(err, tt.expectedError))
+
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, tt.expected, actual)
+			assert.Equal(t, PoseidonCommitGas, gas)
+		})
+	}
+}
+
+func TestPoseidonCommitVerify(t *testing.T) {
+	value := big.NewInt(42)
+	salt := big.NewInt(123456789)
+
+	commitment, _ := poseidon.Hash([]*big.Int{value, salt})
+
+	tests := []struct {
+		name          string
+		input         []byte
+		expected      []byte
+		expectedError error
+	}{
+		{
+			name:     "matching opening",
+			input:    encode(value, salt, commitment),
+			expected: []byte{1},
+		},
+		{
+			name:     "wrong salt",
+			input:    encode(value, big.NewInt(1), commitment),
+			expected: []byte{0},
+		},
+		{
+			name:     "wrong value",
+			input:    encode(big.NewInt(43), salt, commitment),
+			expected: []byte{0},
+		},
+		{
+			name:     "swapped value and salt",
+			input:    encode(salt, value, commitment),
+			expected: []byte{0},
+		},
+		{
+			name:          "missing commitment",
+			input:         encode(value, salt),
+			expectedError: ErrorPoseidonCommitInvalidInputLength,
+		},
+		{
+			name:          "salt not in field",
+			input:         encode(value, utils.FieldPrime, commitment),
+			expectedError: poseidonPrecompile.ErrorPoseidonInputNotInField,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			precompile := PoseidonCommitVerify{}
+
+			actual, err := precompile.Run(tt.input)
+			gas := precompile.RequiredGas(tt.input)
+
+			if tt.expectedError != nil {
+				assert.NotNil(t, err)
+				assert.True(t, errors.Is(err, tt.expectedError))
+
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, tt.expected, actual)
+			assert.Equal(t, PoseidonCommitGas, gas)
+		})
+	}
+}
+
+func TestPoseidonCommitProperties(t *testing.T) {
+	parameters := gopter.DefaultTestParameters()
+	properties := gopter.NewProperties(parameters)
+
+	properties.Property("Verify(value, salt, Commit(value, salt)) == 1", prop.ForAll(
+		func(value, salt *big.Int) bool {
+			commitment, err := (&PoseidonCommit{}).Run(encode(value, salt))
+
+			if err != nil {
+				return false
+			}
+
+			actual, err := (&PoseidonCommitVerify{}).Run(slices.Concat(encode(value, salt), commitment))
+
+			return err == nil && actual[0] == 1
+		},
+		utils.ScalarGenerator(),
+		utils.ScalarGenerator(),
+	))
+
+	properties.Property("a wrong salt fails", prop.ForAll(
+		func(value, salt, otherSalt *big.Int) bool {
+			if salt.Cmp(otherSalt) == 0 {
+				return true
+			}
+
+			commitment, _ := (&PoseidonCommit{}).Run(encode(value, salt))
+			actual, err := (&PoseidonCommitVerify{}).Run(slices.Concat(encode(value, otherSalt), commitment))
+
+			return err == nil && actual[0] == 0
+		},
+		utils.ScalarGenerator(),
+		utils.ScalarGenerator(),
+		utils.ScalarGenerator(),
+	))
+
+	properties.TestingRun(t)
+}
+
+// encode packs elements as big-endian field elements padded to
+// poseidon.PoseidonInputWordSize bytes.
+func encode(elements ...*big.Int) []byte {
+	out := make([]byte, 0, len(elements)*poseidonPrecompile.PoseidonInputWordSize)
+
+	for _, element := range elements {
+		out = append(out, element.FillBytes(make([]byte, poseidonPrecompile.PoseidonInputWordSize))...)
+	}
+
+	return out
+}
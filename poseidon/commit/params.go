@@ -0,0 +1,41 @@
+package commit
+
+import (
+	"errors"
+
+	"github.com/privacy-ethereum/privacy-precompiles/poseidon"
+)
+
+// Poseidon commit-and-reveal precompile constants
+const (
+	// PoseidonCommitInputSize defines the exact byte length of the
+	// commitment precompile input:
+	//
+	//	value || salt
+	PoseidonCommitInputSize = 2 * poseidon.PoseidonInputWordSize
+
+	// PoseidonCommitOutputSize defines the byte length of the commitment
+	// returned by the commitment precompile.
+	PoseidonCommitOutputSize = poseidon.PoseidonOutputSize
+
+	// PoseidonCommitVerifyInputSize defines the exact byte length of the
+	// commitment verification precompile input:
+	//
+	//	value || salt || commitment
+	PoseidonCommitVerifyInputSize = PoseidonCommitInputSize + PoseidonCommitOutputSize
+
+	// PoseidonCommitVerifyOutputSize defines the byte length of the boolean
+	// result returned by the commitment verification precompile.
+	PoseidonCommitVerifyOutputSize = 1
+
+	// PoseidonCommitGas defines the fixed gas cost of both precompiles. It
+	// matches the cost of hashing two words with the Poseidon precompile.
+	PoseidonCommitGas = poseidon.PoseidonBaseGas + 2*poseidon.PoseidonPerWordGas
+)
+
+var (
+	// ErrorPoseidonCommitInvalidInputLength is returned when the input length
+	// is not exactly PoseidonCommitInputSize bytes for PoseidonCommit, or
+	// PoseidonCommitVerifyInputSize bytes for PoseidonCommitVerify.
+	ErrorPoseidonCommitInvalidInputLength = errors.New("invalid input length")
+)
@@ -0,0 +1,78 @@
+package poseidon
+
+import (
+	"github.com/iden3/go-iden3-crypto/poseidon"
+	"github.com/privacy-ethereum/privacy-precompiles/common"
+)
+
+// PoseidonContinue implements an incremental Poseidon hash precompile that
+// folds new words into the digest returned by a previous call.
+//
+// It lets callers hash a stream longer than PoseidonMaxParams words across
+// several invocations. The construction is a Poseidon hash with the prior
+// digest as its first element, in the continuation domain: the permutation
+// starts from PoseidonContinueDomain as its capacity element instead of
+// zero.
+//
+//	Continue(state, w1, ..., wK) = poseidon.HashWithState([state, w1, ..., wK], PoseidonContinueDomain)
+//
+// A stream split into chunks c1, c2, ..., cM hashes to
+//
+//	Continue(... Continue(Poseidon(c1), c2) ..., cM)
+//
+// Because of the separate domain, this chain never equals the fresh hash
+// Poseidon(Poseidon(c1), c2), so chained and one-shot digests cannot be
+// confused. Off-chain code reproduces it with go-iden3-crypto's
+// HashWithState, or circomlib's PoseidonEx with initialState set to
+// PoseidonContinueDomain.
+type PoseidonContinue struct{}
+
+// Name returns the human-readable name of the precompile.
+func (c *PoseidonContinue) Name() string {
+	return "PoseidonContinue"
+}
+
+// RequiredGas returns the gas cost of executing this precompile.
+//
+// The prior digest is charged as one word, so the whole input is priced
+// the same way as for Poseidon.
+func (c *PoseidonContinue) RequiredGas(input []byte) uint64 {
+	return InputGas(input)
+}
+
+// Run executes the incremental Poseidon hash precompile.
+//
+// The input must be encoded as:
+//
+//	state || w1 || w2 || ... || wK
+//
+// Where:
+//   - state is a PoseidonContinueStateSize-byte digest, typically the
+//     output of a previous Poseidon or PoseidonContinue call.
+//   - w1..wK are the new words, encoded as accepted by Poseidon.Run.
+//   - 1 <= K <= PoseidonMaxParams - 1.
+//
+// Returns ErrorPoseidonInvalidInputLength if no word follows the state,
+// and otherwise the same errors as Poseidon.Run on the whole input.
+func (c *PoseidonContinue) Run(input []byte) ([]byte, error) {
+	if len(input) <= PoseidonContinueStateSize {
+		return nil, ErrorPoseidonInvalidInputLength
+	}
+
+	elements, err := readElements(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	hash, err := poseidon.HashWithState(elements, PoseidonContinueDomain)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return hash.FillBytes(make([]byte, PoseidonOutputSize)), nil
+}
+
+// Ensure PoseidonContinue implements the common.Precompile interface.
+var _ common.Precompile = (*PoseidonContinue)(nil)
@@ -0,0 +1,176 @@
+package poseidon
+
+import (
+	"bytes"
+	"math/big"
+	"slices"
+	"testing"
+
+	"github.com/iden3/go-iden3-crypto/poseidon"
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/gen"
+	"github.com/leanovate/gopter/prop"
+	"github.com/privacy-ethereum/privacy-precompiles/babyjubjub/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPoseidonContinueName(t *testing.T) {
+	precompile := PoseidonContinue{}
+
+	expected := "PoseidonContinue"
+	actual := precompile.Name()
+
+	assert.Equal(t, expected, actual)
+}
+
+func TestPoseidonContinue(t *testing.T) {
+	word := func(value int64) []byte {
+		return big.NewInt(value).FillBytes(make([]byte, PoseidonInputWordSize))
+	}
+
+	state, _ := (&Poseidon{}).Run(word(1))
+	stateValue, _ := poseidon.Hash([]*big.Int{big.NewInt(1)})
+	encode := func(elements ...*big.Int) []byte {
+		hash, _ := poseidon.HashWithState(elements, PoseidonContinueDomain)
+
+		return hash.FillBytes(make([]byte, PoseidonOutputSize))
+	}
+
+	zeros := make([]*big.Int, PoseidonMaxParams-1)
+
+	for index := range zeros {
+		zeros[index] = big.NewInt(0)
+	}
+
+	tests := []struct {
+		name          string
+		input         []byte
+		expected      []byte
+		expectedGas   uint64
+		expectedError error
+	}{
+		{
+			name:        "one word after state",
+			input:       slices.Concat(state, word(2)),
+			expected:    encode(stateValue, big.NewInt(2)),
+			expectedGas: PoseidonBaseGas + 2*PoseidonPerWordGas,
+		},
+		{
+			name:        "two words after state",
+			input:       slices.Concat(state, word(2), word(3)),
+			expected:    encode(stateValue, big.NewInt(2), big.NewInt(3)),
+			expectedGas: PoseidonBaseGas + 3*PoseidonPerWordGas,
+		},
+		{
+			name:        "max words after state",
+			input:       slices.Concat(state, make([]byte, (PoseidonMaxParams-1)*PoseidonInputWordSize)),
+			expected:    encode(append([]*big.Int{stateValue}, zeros...)...),
+			expectedGas: PoseidonBaseGas + PoseidonMaxParams*PoseidonPerWordGas,
+		},
+		{
+			name:          "state only",
+			input:         state,
+			expectedError: ErrorPoseidonInvalidInputLength,
+		},
+		{
+			name:          "empty input",
+			input:         nil,
+			expectedError: ErrorPoseidonInvalidInputLength,
+		},
+		{
+			name:          "truncated state",
+			input:         state[:PoseidonContinueStateSize-1],
+			expectedError: ErrorPoseidonInvalidInputLength,
+		},
+		{
+			name:          "partial word",
+			input:         slices.Concat(state, word(2)[1:]),
+			expectedError: ErrorPoseidonInvalidInputLength,
+		},
+		{
+			name:          "more than max params",
+			input:         slices.Concat(state, make([]byte, PoseidonMaxParams*PoseidonInputWordSize)),
+			expectedError: ErrorPoseidonInvalidInputLength,
+		},
+		{
+			name:          "state not in field",
+			input:         slices.Concat(bytes.Repeat([]byte{0xff}, PoseidonContinueStateSize), word(2)),
+			expectedError: ErrorPoseidonInputNotInField,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			precompile := PoseidonContinue{}
+
+			actual, err := precompile.Run(tt.input)
+			gas := precompile.RequiredGas(tt.input)
+
+			if tt.expectedError != nil {
+				assert.NotNil(t, err)
+				assert.Equal(t, tt.expectedError, err)
+
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, tt.expected, actual)
+			assert.Equal(t, tt.expectedGas, gas)
+		})
+	}
+}
+
+func TestPoseidonContinueDomain(t *testing.T) {
+	assert.Equal(t, "poseidon.continue", string(PoseidonContinueDomain.Bytes()))
+	assert.True(t, PoseidonContinueDomain.Cmp(utils.FieldPrime) < 0)
+}
+
+func TestPoseidonContinueDiffersFromFreshHash(t *testing.T) {
+	state, _ := (&Poseidon{}).Run(prepareInput([]*big.Int{big.NewInt(1)}))
+	input := slices.Concat(state, prepareInput([]*big.Int{big.NewInt(2)}))
+
+	continued, err1 := (&PoseidonContinue{}).Run(input)
+	fresh, err2 := (&Poseidon{}).Run(input)
+
+	assert.Nil(t, err1)
+	assert.Nil(t, err2)
+	assert.NotEqual(t, fresh, continued)
+}
+
+func TestPoseidonContinueProperties(t *testing.T) {
+	parameters := gopter.DefaultTestParameters()
+	properties := gopter.NewProperties(parameters)
+
+	properties.Property("Continue(Run(a), b) equals HashWithState(Poseidon(a), b; PoseidonContinueDomain)", prop.ForAll(
+		func(a []*big.Int, b []*big.Int) bool {
+			if len(a) == 0 || len(a) > PoseidonMaxParams || len(b) == 0 || len(b) >= PoseidonMaxParams {
+				return true
+			}
+
+			state, err := (&Poseidon{}).Run(prepareInput(a))
+
+			if err != nil {
+				return false
+			}
+
+			result1, err1 := (&PoseidonContinue{}).Run(slices.Concat(state, prepareInput(b)))
+			result2, err2 := (&PoseidonContinue{}).Run(slices.Concat(state, prepareInput(b)))
+
+			if err1 != nil || err2 != nil {
+				return false
+			}
+
+			inner, _ := poseidon.Hash(a)
+			outer, _ := poseidon.HashWithState(append([]*big.Int{inner}, b...), PoseidonContinueDomain)
+			fresh, _ := poseidon.Hash(append([]*big.Int{inner}, b...))
+
+			return bytes.Equal(result1, result2) &&
+				bytes.Equal(result1, outer.FillBytes(make([]byte, PoseidonOutputSize))) &&
+				!bytes.Equal(result1, fresh.FillBytes(make([]byte, PoseidonOutputSize)))
+		},
+		gen.SliceOf(utils.ScalarGenerator()),
+		gen.SliceOf(utils.ScalarGenerator()),
+	))
+
+	properties.TestingRun(t)
+}
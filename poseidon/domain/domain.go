@@ -0,0 +1,92 @@
+package domain
+
+import (
+	"math/big"
+
+	iden3Poseidon "github.com/iden3/go-iden3-crypto/poseidon"
+	"github.com/privacy-ethereum/privacy-precompiles/common"
+	"github.com/privacy-ethereum/privacy-precompiles/poseidon"
+	commonUtils "github.com/privacy-ethereum/privacy-precompiles/utils"
+)
+
+// PoseidonWithDomain implements a domain separated Poseidon hash precompile.
+//
+// It satisfies the common.Precompile interface and can be used in a generic
+// precompile execution framework. Unrelated applications hashing the same
+// inputs under different domains obtain unrelated digests.
+//
+// The digest is computed with the same permutation as the Poseidon precompile
+// for N inputs, but with the domain as the initial capacity element instead
+// of zero:
+//
+//	state = [domain, e1, ..., eN]
+//	digest = permutation(state)[0]
+//
+// This is poseidon.HashWithState(e1, ..., eN; domain). A zero domain yields
+// the same digest as the Poseidon precompile. poseidon.PoseidonContinueDomain
+// is reserved for the PoseidonContinue precompile and cannot be used.
+type PoseidonWithDomain struct{}
+
+// Name returns the human-readable name of the precompile.
+func (c *PoseidonWithDomain) Name() string {
+	return "PoseidonWithDomain"
+}
+
+// RequiredGas returns the gas cost of executing this precompile.
+//
+// The domain is charged as an input word, so the cost is identical to the
+// Poseidon precompile over the same input:
+//
+//	PoseidonBaseGas + (number_of_words * PoseidonPerWordGas)
+func (c *PoseidonWithDomain) RequiredGas(input []byte) uint64 {
+	return poseidon.InputGas(input)
+}
+
+// Run executes the domain separated Poseidon hash precompile.
+//
+// The input is encoded as:
+//
+//	domain || e1 || ... || eN
+//
+// Where:
+//   - Each element is a big-endian field element padded to
+//     poseidon.PoseidonInputWordSize bytes.
+//   - 1 <= N <= poseidon.PoseidonMaxParams.
+//
+// The digest is returned as a 32-byte big-endian value.
+//
+// Returns an error if:
+//   - The input length is not a multiple of the word size.
+//   - The number of input words is outside [1, poseidon.PoseidonMaxParams].
+//   - The domain or any input is not inside the Poseidon field.
+//   - The domain is poseidon.PoseidonContinueDomain
+//     (poseidon.ErrorPoseidonReservedDomain).
+func (c *PoseidonWithDomain) Run(input []byte) ([]byte, error) {
+	if len(input) < PoseidonWithDomainMinInputSize ||
+		len(input) > PoseidonWithDomainMaxInputSize ||
+		len(input)%poseidon.PoseidonInputWordSize != 0 {
+		return nil, poseidon.ErrorPoseidonInvalidInputLength
+	}
+
+	domain, offset := commonUtils.ReadField(input, 0, poseidon.PoseidonInputWordSize)
+
+	if domain.Cmp(poseidon.PoseidonContinueDomain) == 0 {
+		return nil, poseidon.ErrorPoseidonReservedDomain
+	}
+	elements := make([]*big.Int, (len(input)-offset)/poseidon.PoseidonInputWordSize)
+
+	for index := range elements {
+		elements[index], offset = commonUtils.ReadField(input, offset, poseidon.PoseidonInputWordSize)
+	}
+
+	hash, err := iden3Poseidon.HashWithState(elements, domain)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return hash.FillBytes(make([]byte, poseidon.PoseidonInputWordSize)), nil
+}
+
+// Ensure PoseidonWithDomain implements the common.Precompile interface.
+var _ common.Precompile = (*PoseidonWithDomain)(nil)
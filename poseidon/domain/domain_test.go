@@ -0,0 +1,167 @@
+package domain
+
+import (
+	"bytes"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/gen"
+	"github.com/leanovate/gopter/prop"
+	"github.com/privacy-ethereum/privacy-precompiles/babyjubjub/utils"
+	"github.com/privacy-ethereum/privacy-precompiles/poseidon"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPoseidonWithDomainName(t *testing.T) {
+	precompile := PoseidonWithDomain{}
+
+	expected := "PoseidonWithDomain"
+	actual := precompile.Name()
+
+	assert.Equal(t, expected, actual)
+}
+
+func TestPoseidonWithDomain(t *testing.T) {
+	inputs := []*big.Int{big.NewInt(1), big.NewInt(2)}
+
+	tests := []struct {
+		name          string
+		input         []byte
+		expected      []byte
+		expectedGas   uint64
+		expectedError error
+	}{
+		{
+			name:  "zero domain matches the Poseidon precompile",
+			input: prepareInput(big.NewInt(0), inputs),
+			expected: func() []byte {
+				hash, _ := (&poseidon.Poseidon{}).Run(prepareWords(inputs))
+
+				return hash
+			}(),
+			expectedGas: poseidon.PoseidonBaseGas + 3*poseidon.PoseidonPerWordGas,
+		},
+		{
+			name:        "maximum inputs",
+			input:       prepareInput(big.NewInt(1), make([]*big.Int, poseidon.PoseidonMaxParams)),
+			expectedGas: poseidon.PoseidonBaseGas + (poseidon.PoseidonMaxParams+1)*poseidon.PoseidonPerWordGas,
+		},
+		{
+			name:          "domain not in field",
+			input:         prepareInput(utils.FieldPrime, inputs),
+			expectedError: errors.New("initState values not inside Finite Field"),
+		},
+		{
+			name:          "input not in field",
+			input:         prepareInput(big.NewInt(1), []*big.Int{utils.FieldPrime}),
+			expectedError: errors.New("inputs values not inside Finite Field"),
+		},
+		{
+			name:          "reserved continuation domain",
+			input:         prepareInput(poseidon.PoseidonContinueDomain, inputs),
+			expectedError: poseidon.ErrorPoseidonReservedDomain,
+		},
+		{
+			name:          "domain without inputs",
+			input:         prepareInput(big.NewInt(1), nil),
+			expectedError: poseidon.ErrorPoseidonInvalidInputLength,
+		},
+		{
+			name:          "too many inputs",
+			input:         prepareInput(big.NewInt(1), make([]*big.Int, poseidon.PoseidonMaxParams+1)),
+			expectedError: poseidon.ErrorPoseidonInvalidInputLength,
+		},
+		{
+			name:          "unaligned input",
+			input:         prepareInput(big.NewInt(1), inputs)[1:],
+			expectedError: poseidon.ErrorPoseidonInvalidInputLength,
+		},
+		{
+			name:          "empty input",
+			input:         []byte{},
+			expectedError: poseidon.ErrorPoseidonInvalidInputLength,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			precompile := PoseidonWithDomain{}
+
+			actual, err := precompile.Run(tt.input)
+			gas := precompile.RequiredGas(tt.input)
+
+			if tt.expectedError != nil {
+				assert.NotNil(t, err)
+				assert.Equal(t, tt.expectedError, err)
+
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Len(t, actual, poseidon.PoseidonInputWordSize)
+			assert.Equal(t, tt.expectedGas, gas)
+
+			if tt.expected != nil {
+				assert.Equal(t, tt.expected, actual)
+			}
+		})
+	}
+}
+
+func TestRunProperties(t *testing.T) {
+	parameters := gopter.DefaultTestParameters()
+	properties := gopter.NewProperties(parameters)
+
+	properties.Property("Same domain and inputs are reproducible", prop.ForAll(
+		func(domain *big.Int, inputs []*big.Int) bool {
+			precompile := PoseidonWithDomain{}
+
+			digest1, err1 := precompile.Run(prepareInput(domain, inputs))
+			digest2, err2 := precompile.Run(prepareInput(domain, inputs))
+
+			return err1 == nil && err2 == nil && bytes.Equal(digest1, digest2)
+		},
+		utils.ScalarGenerator(),
+		gen.SliceOfN(4, utils.ScalarGenerator()),
+	))
+
+	properties.Property("Different domains over identical inputs produce different digests", prop.ForAll(
+		func(domain1, domain2 *big.Int, inputs []*big.Int) bool {
+			if domain1.Cmp(domain2) == 0 {
+				return true
+			}
+
+			precompile := PoseidonWithDomain{}
+
+			digest1, err1 := precompile.Run(prepareInput(domain1, inputs))
+			digest2, err2 := precompile.Run(prepareInput(domain2, inputs))
+
+			return err1 == nil && err2 == nil && !bytes.Equal(digest1, digest2)
+		},
+		utils.ScalarGenerator(),
+		utils.ScalarGenerator(),
+		gen.SliceOfN(4, utils.ScalarGenerator()),
+	))
+
+	properties.TestingRun(t)
+}
+
+func prepareInput(domain *big.Int, inputs []*big.Int) []byte {
+	return append(domain.FillBytes(make([]byte, poseidon.PoseidonInputWordSize)), prepareWords(inputs)...)
+}
+
+func prepareWords(inputs []*big.Int) []byte {
+	words := make([]byte, 0, len(inputs)*poseidon.PoseidonInputWordSize)
+
+	for _, input := range inputs {
+		if input == nil {
+			input = big.NewInt(0)
+		}
+
+		words = append(words, input.FillBytes(make([]byte, poseidon.PoseidonInputWordSize))...)
+	}
+
+	return words
+}
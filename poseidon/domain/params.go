@@ -0,0 +1,15 @@
+package domain
+
+import "github.com/privacy-ethereum/privacy-precompiles/poseidon"
+
+// Poseidon domain separated hash precompile constants
+const (
+	// PoseidonWithDomainMinInputSize defines the minimum byte length of the
+	// domain separated hash input: the domain followed by one input word.
+	PoseidonWithDomainMinInputSize = 2 * poseidon.PoseidonInputWordSize
+
+	// PoseidonWithDomainMaxInputSize defines the maximum byte length of the
+	// domain separated hash input: the domain followed by
+	// poseidon.PoseidonMaxParams input words.
+	PoseidonWithDomainMaxInputSize = (poseidon.PoseidonMaxParams + 1) * poseidon.PoseidonInputWordSize
+)
@@ -0,0 +1,112 @@
+package expand
+
+import (
+	"encoding/binary"
+	"math/big"
+
+	iden3Poseidon "github.com/iden3/go-iden3-crypto/poseidon"
+	"github.com/privacy-ethereum/privacy-precompiles/common"
+	"github.com/privacy-ethereum/privacy-precompiles/poseidon"
+	commonUtils "github.com/privacy-ethereum/privacy-precompiles/utils"
+)
+
+// PoseidonExpand implements a Poseidon precompile returning several output
+// field elements for a single set of inputs.
+//
+// It satisfies the common.Precompile interface and can be used in a generic
+// precompile execution framework as a PRF-style expansion.
+//
+// For N inputs the Poseidon permutation has width t = N + 1. Output i, for
+// 0 <= i < k, is a Poseidon hash of the inputs with the counter i as the
+// initial capacity element:
+//
+//	output_i = permutation([i, e1, ..., eN])[0]
+//
+// The first output is therefore the Poseidon precompile digest of the same
+// inputs.
+//
+// Each output exposes a single element of its own permutation. Since the
+// permutation is publicly invertible, releasing a whole permutation state
+// would reveal the inputs; with t - 1 elements of every state kept hidden,
+// no set of outputs can be run backwards to e1, ..., eN.
+type PoseidonExpand struct{}
+
+// Name returns the human-readable name of the precompile.
+func (c *PoseidonExpand) Name() string {
+	return "PoseidonExpand"
+}
+
+// RequiredGas returns the gas cost of executing this precompile.
+//
+// Every output runs its own permutation over the inputs, so gas is
+// calculated as:
+//
+//	k * (PoseidonBaseGas + (number_of_input_words * PoseidonPerWordGas))
+//
+// If the input is shorter than the count prefix, only the base gas is charged.
+func (c *PoseidonExpand) RequiredGas(input []byte) uint64 {
+	if len(input) < PoseidonExpandCountSize {
+		return poseidon.PoseidonBaseGas
+	}
+
+	count := uint64(binary.BigEndian.Uint32(input[:PoseidonExpandCountSize]))
+
+	return count * poseidon.InputGas(input[PoseidonExpandCountSize:])
+}
+
+// Run executes the Poseidon expand precompile.
+//
+// The input is encoded as:
+//
+//	k || e1 || ... || eN
+//
+// Where:
+//   - k is the number of requested outputs, a 4-byte big-endian integer with
+//     1 <= k <= PoseidonExpandMaxOutputs.
+//   - Each element is a big-endian field element padded to
+//     poseidon.PoseidonInputWordSize bytes.
+//   - 1 <= N <= poseidon.PoseidonMaxParams.
+//
+// The output is k field elements, each encoded as a 32-byte big-endian value.
+//
+// Returns an error if:
+//   - k or the number of input words is out of bounds, or the input is unaligned.
+//   - Any input is not inside the Poseidon field.
+func (c *PoseidonExpand) Run(input []byte) ([]byte, error) {
+	if len(input) < PoseidonExpandCountSize {
+		return nil, poseidon.ErrorPoseidonInvalidInputLength
+	}
+
+	count := binary.BigEndian.Uint32(input[:PoseidonExpandCountSize])
+	words := input[PoseidonExpandCountSize:]
+
+	if count == 0 || count > PoseidonExpandMaxOutputs ||
+		len(words) == 0 || len(words)%poseidon.PoseidonInputWordSize != 0 ||
+		len(words)/poseidon.PoseidonInputWordSize > poseidon.PoseidonMaxParams {
+		return nil, poseidon.ErrorPoseidonInvalidInputLength
+	}
+
+	elements := make([]*big.Int, len(words)/poseidon.PoseidonInputWordSize)
+	offset := 0
+
+	for index := range elements {
+		elements[index], offset = commonUtils.ReadField(words, offset, poseidon.PoseidonInputWordSize)
+	}
+
+	output := make([]byte, 0, int(count)*poseidon.PoseidonInputWordSize)
+
+	for counter := range count {
+		element, err := iden3Poseidon.HashWithState(elements, big.NewInt(int64(counter)))
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, element.FillBytes(make([]byte, poseidon.PoseidonInputWordSize))...)
+	}
+
+	return output, nil
+}
+
+// Ensure PoseidonExpand implements the common.Precompile interface.
+var _ common.Precompile = (*PoseidonExpand)(nil)
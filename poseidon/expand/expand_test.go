@@ -0,0 +1,226 @@
+package expand
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"math/big"
+	"testing"
+
+	iden3Poseidon "github.com/iden3/go-iden3-crypto/poseidon"
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/gen"
+	"github.com/leanovate/gopter/prop"
+	"github.com/privacy-ethereum/privacy-precompiles/babyjubjub/utils"
+	"github.com/privacy-ethereum/privacy-precompiles/poseidon"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPoseidonExpandName(t *testing.T) {
+	precompile := PoseidonExpand{}
+
+	expected := "PoseidonExpand"
+	actual := precompile.Name()
+
+	assert.Equal(t, expected, actual)
+}
+
+func TestPoseidonExpand(t *testing.T) {
+	inputs := []*big.Int{big.NewInt(1), big.NewInt(2)}
+
+	tests := []struct {
+		name          string
+		input         []byte
+		expectedSize  int
+		expectedGas   uint64
+		expectedError error
+	}{
+		{
+			name:         "single output",
+			input:        prepareInput(1, inputs),
+			expectedSize: poseidon.PoseidonInputWordSize,
+			expectedGas:  poseidon.PoseidonBaseGas + 2*poseidon.PoseidonPerWordGas,
+		},
+		{
+			name:         "outputs spanning several permutations",
+			input:        prepareInput(7, inputs),
+			expectedSize: 7 * poseidon.PoseidonInputWordSize,
+			expectedGas:  7 * (poseidon.PoseidonBaseGas + 2*poseidon.PoseidonPerWordGas),
+		},
+		{
+			name:         "maximum outputs",
+			input:        prepareInput(PoseidonExpandMaxOutputs, inputs),
+			expectedSize: PoseidonExpandMaxOutputs * poseidon.PoseidonInputWordSize,
+			expectedGas:  PoseidonExpandMaxOutputs * (poseidon.PoseidonBaseGas + 2*poseidon.PoseidonPerWordGas),
+		},
+		{
+			name:          "zero outputs",
+			input:         prepareInput(0, inputs),
+			expectedError: poseidon.ErrorPoseidonInvalidInputLength,
+		},
+		{
+			name:          "too many outputs",
+			input:         prepareInput(PoseidonExpandMaxOutputs+1, inputs),
+			expectedError: poseidon.ErrorPoseidonInvalidInputLength,
+		},
+		{
+			name:          "no input words",
+			input:         prepareInput(1, nil),
+			expectedError: poseidon.ErrorPoseidonInvalidInputLength,
+		},
+		{
+			name:          "too many input words",
+			input:         prepareInput(1, make([]*big.Int, poseidon.PoseidonMaxParams+1)),
+			expectedError: poseidon.ErrorPoseidonInvalidInputLength,
+		},
+		{
+			name:          "unaligned input words",
+			input:         prepareInput(1, inputs)[:PoseidonExpandCountSize+poseidon.PoseidonInputWordSize+1],
+			expectedError: poseidon.ErrorPoseidonInvalidInputLength,
+		},
+		{
+			name:          "truncated count",
+			input:         []byte{0, 0, 1},
+			expectedError: poseidon.ErrorPoseidonInvalidInputLength,
+		},
+		{
+			name:          "input not in field",
+			input:         prepareInput(1, []*big.Int{utils.FieldPrime}),
+			expectedError: errors.New("inputs values not inside Finite Field"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			precompile := PoseidonExpand{}
+
+			actual, err := precompile.Run(tt.input)
+			gas := precompile.RequiredGas(tt.input)
+
+			if tt.expectedError != nil {
+				assert.NotNil(t, err)
+				assert.Equal(t, tt.expectedError, err)
+
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Len(t, actual, tt.expectedSize)
+			assert.Equal(t, tt.expectedGas, gas)
+		})
+	}
+}
+
+func TestPoseidonExpandSingleOutputMatchesPoseidon(t *testing.T) {
+	inputs := []*big.Int{big.NewInt(1), big.NewInt(2), big.NewInt(3)}
+
+	expected, err1 := (&poseidon.Poseidon{}).Run(prepareWords(inputs))
+	actual, err2 := (&PoseidonExpand{}).Run(prepareInput(1, inputs))
+
+	assert.Nil(t, err1)
+	assert.Nil(t, err2)
+	assert.Equal(t, expected, actual)
+}
+
+func TestPoseidonExpandDoesNotRevealPermutationState(t *testing.T) {
+	inputs := []*big.Int{big.NewInt(1), big.NewInt(2), big.NewInt(3)}
+	width := len(inputs) + 1
+
+	actual, err := (&PoseidonExpand{}).Run(prepareInput(uint32(width), inputs))
+	assert.Nil(t, err)
+
+	// The full output of the first permutation is what an inverse
+	// permutation would need to recover [0, e1, ..., eN]. Only its first
+	// element may appear in the output.
+	state, err := iden3Poseidon.HashWithStateEx(inputs, big.NewInt(0), width)
+	assert.Nil(t, err)
+
+	assert.Equal(t, prepareWords(state[:1]), actual[:poseidon.PoseidonInputWordSize])
+	assert.NotEqual(t, prepareWords(state), actual)
+
+	for index, element := range state[1:] {
+		assert.False(t,
+			bytes.Contains(actual, prepareWords([]*big.Int{element})),
+			"state element %d leaked", index+1,
+		)
+	}
+
+	// Every output is the first element of a separate permutation, keyed by
+	// its position.
+	for counter := range width {
+		expected, err := iden3Poseidon.HashWithState(inputs, big.NewInt(int64(counter)))
+		assert.Nil(t, err)
+
+		start := counter * poseidon.PoseidonInputWordSize
+		assert.Equal(t, prepareWords([]*big.Int{expected}), actual[start:start+poseidon.PoseidonInputWordSize])
+	}
+}
+
+func TestRunProperties(t *testing.T) {
+	parameters := gopter.DefaultTestParameters()
+	properties := gopter.NewProperties(parameters)
+
+	properties.Property("Run is deterministic", prop.ForAll(
+		func(count int, inputs []*big.Int) bool {
+			precompile := PoseidonExpand{}
+
+			output1, err1 := precompile.Run(prepareInput(uint32(count), inputs))
+			output2, err2 := precompile.Run(prepareInput(uint32(count), inputs))
+
+			return err1 == nil && err2 == nil && bytes.Equal(output1, output2)
+		},
+		gen.IntRange(1, PoseidonExpandMaxOutputs),
+		gen.SliceOfN(3, utils.ScalarGenerator()),
+	))
+
+	properties.Property("Shorter expansions are prefixes of longer ones", prop.ForAll(
+		func(count1, count2 int, inputs []*big.Int) bool {
+			if count1 > count2 {
+				count1, count2 = count2, count1
+			}
+
+			precompile := PoseidonExpand{}
+
+			output1, err1 := precompile.Run(prepareInput(uint32(count1), inputs))
+			output2, err2 := precompile.Run(prepareInput(uint32(count2), inputs))
+
+			return err1 == nil && err2 == nil && bytes.HasPrefix(output2, output1)
+		},
+		gen.IntRange(1, PoseidonExpandMaxOutputs),
+		gen.IntRange(1, PoseidonExpandMaxOutputs),
+		gen.SliceOfN(3, utils.ScalarGenerator()),
+	))
+
+	properties.Property("First output equals the Poseidon digest", prop.ForAll(
+		func(count int, inputs []*big.Int) bool {
+			digest, err1 := (&poseidon.Poseidon{}).Run(prepareWords(inputs))
+			output, err2 := (&PoseidonExpand{}).Run(prepareInput(uint32(count), inputs))
+
+			return err1 == nil && err2 == nil && bytes.Equal(output[:poseidon.PoseidonInputWordSize], digest)
+		},
+		gen.IntRange(1, PoseidonExpandMaxOutputs),
+		gen.SliceOfN(5, utils.ScalarGenerator()),
+	))
+
+	properties.TestingRun(t)
+}
+
+func prepareInput(count uint32, inputs []*big.Int) []byte {
+	input := binary.BigEndian.AppendUint32(nil, count)
+
+	return append(input, prepareWords(inputs)...)
+}
+
+func prepareWords(inputs []*big.Int) []byte {
+	words := make([]byte, 0, len(inputs)*poseidon.PoseidonInputWordSize)
+
+	for _, input := range inputs {
+		if input == nil {
+			input = big.NewInt(0)
+		}
+
+		words = append(words, input.FillBytes(make([]byte, poseidon.PoseidonInputWordSize))...)
+	}
+
+	return words
+}
@@ -0,0 +1,13 @@
+package expand
+
+// Poseidon expand precompile constants
+const (
+	// PoseidonExpandCountSize defines the byte length of the big-endian
+	// output count k prefixing the expand input.
+	PoseidonExpandCountSize = 4
+
+	// PoseidonExpandMaxOutputs defines the maximum number of field elements
+	// that can be requested from the expand precompile in a single
+	// invocation.
+	PoseidonExpandMaxOutputs = 64
+)
@@ -0,0 +1,65 @@
+package poseidon
+
+import (
+	"encoding/binary"
+
+	"github.com/privacy-ethereum/privacy-precompiles/common"
+)
+
+// PoseidonFramed implements the Poseidon hash precompile with an explicit
+// element count prefix.
+//
+// Unlike Poseidon, which infers the arity from the input length, the
+// caller states the number of elements up front. A mismatch between the
+// prefix and the data that follows is rejected instead of being hashed,
+// which catches calldata that was padded or truncated by mistake.
+type PoseidonFramed struct{}
+
+// Name returns the human-readable name of the precompile.
+func (c *PoseidonFramed) Name() string {
+	return "PoseidonFramed"
+}
+
+// RequiredGas returns the gas cost of executing this precompile.
+//
+// The element count prefix is not charged; the remaining input is priced
+// the same way as for Poseidon.
+func (c *PoseidonFramed) RequiredGas(input []byte) uint64 {
+	if len(input) < PoseidonFramedLengthSize {
+		return PoseidonBaseGas
+	}
+
+	return InputGas(input[PoseidonFramedLengthSize:])
+}
+
+// Run executes the framed Poseidon hash precompile.
+//
+// The input must be encoded as:
+//
+//	count || e1 || e2 || ... || eN
+//
+// Where:
+//   - count is a PoseidonFramedLengthSize-byte big-endian integer equal to N.
+//   - e1..eN follow the encoding accepted by Poseidon.Run.
+//
+// Returns ErrorPoseidonInvalidInputLength if the prefix is missing or does
+// not match the number of words that follow it, and otherwise the same
+// result and errors as Poseidon.Run on e1 || ... || eN.
+func (c *PoseidonFramed) Run(input []byte) ([]byte, error) {
+	if len(input) < PoseidonFramedLengthSize {
+		return nil, ErrorPoseidonInvalidInputLength
+	}
+
+	count := uint64(binary.BigEndian.Uint32(input))
+	elements := input[PoseidonFramedLengthSize:]
+
+	if len(elements)%PoseidonInputWordSize != 0 ||
+		uint64(len(elements)/PoseidonInputWordSize) != count {
+		return nil, ErrorPoseidonInvalidInputLength
+	}
+
+	return (&Poseidon{}).Run(elements)
+}
+
+// Ensure PoseidonFramed implements the common.Precompile interface.
+var _ common.Precompile = (*PoseidonFramed)(nil)
@@ -0,0 +1,105 @@
+package poseidon
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPoseidonFramedName(t *testing.T) {
+	precompile := PoseidonFramed{}
+
+	expected := "PoseidonFramed"
+	actual := precompile.Name()
+
+	assert.Equal(t, expected, actual)
+}
+
+func TestPoseidonFramed(t *testing.T) {
+	frame := func(count uint32, data []byte) []byte {
+		return append(binary.BigEndian.AppendUint32(nil, count), data...)
+	}
+
+	twoWords := make([]byte, 2*PoseidonInputWordSize)
+	twoWords[PoseidonInputWordSize-1] = 1
+	twoWords[2*PoseidonInputWordSize-1] = 2
+
+	expectedTwoWords, _ := (&Poseidon{}).Run(twoWords)
+
+	tests := []struct {
+		name          string
+		input         []byte
+		expected      []byte
+		expectedGas   uint64
+		expectedError error
+	}{
+		{
+			name:        "prefix matches one word",
+			input:       frame(1, make([]byte, PoseidonInputWordSize)),
+			expected:    []byte{42, 9, 169, 253, 147, 197, 144, 194, 107, 145, 239, 251, 178, 73, 159, 7, 232, 247, 170, 18, 226, 180, 148, 10, 58, 237, 36, 17, 203, 101, 225, 28},
+			expectedGas: PoseidonBaseGas + PoseidonPerWordGas,
+		},
+		{
+			name:        "prefix matches two words",
+			input:       frame(2, twoWords),
+			expected:    expectedTwoWords,
+			expectedGas: PoseidonBaseGas + 2*PoseidonPerWordGas,
+		},
+		{
+			name:          "prefix larger than word count",
+			input:         frame(2, make([]byte, PoseidonInputWordSize)),
+			expectedError: ErrorPoseidonInvalidInputLength,
+		},
+		{
+			name:          "prefix smaller than word count",
+			input:         frame(1, twoWords),
+			expectedError: ErrorPoseidonInvalidInputLength,
+		},
+		{
+			name:          "zero prefix with padded word",
+			input:         frame(0, make([]byte, PoseidonInputWordSize)),
+			expectedError: ErrorPoseidonInvalidInputLength,
+		},
+		{
+			name:          "zero prefix without words",
+			input:         frame(0, nil),
+			expectedError: ErrorPoseidonInvalidInputLength,
+		},
+		{
+			name:          "partial word",
+			input:         frame(1, make([]byte, PoseidonInputWordSize+1)),
+			expectedError: ErrorPoseidonInvalidInputLength,
+		},
+		{
+			name:          "truncated prefix",
+			input:         []byte{0, 0, 1},
+			expectedError: ErrorPoseidonInvalidInputLength,
+		},
+		{
+			name:          "more than max params",
+			input:         frame(PoseidonMaxParams+1, make([]byte, (PoseidonMaxParams+1)*PoseidonInputWordSize)),
+			expectedError: ErrorPoseidonInvalidInputLength,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			precompile := PoseidonFramed{}
+
+			actual, err := precompile.Run(tt.input)
+			gas := precompile.RequiredGas(tt.input)
+
+			if tt.expectedError != nil {
+				assert.NotNil(t, err)
+				assert.Equal(t, tt.expectedError, err)
+
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, tt.expected, actual)
+			assert.Equal(t, tt.expectedGas, gas)
+		})
+	}
+}
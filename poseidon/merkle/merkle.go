@@ -0,0 +1,168 @@
+package merkle
+
+import (
+	"math/big"
+
+	"github.com/iden3/go-iden3-crypto/babyjub"
+	"github.com/iden3/go-iden3-crypto/poseidon"
+	"github.com/privacy-ethereum/privacy-precompiles/common"
+	commonUtils "github.com/privacy-ethereum/privacy-precompiles/utils"
+)
+
+// PoseidonPointMerkleVerify implements a Poseidon Merkle inclusion proof
+// verification precompile where leaves are BabyJubJub curve points.
+//
+// It satisfies the common.Precompile interface and can be used in a generic
+// precompile execution framework, e.g. to prove membership of a public key
+// in a registry tree.
+type PoseidonPointMerkleVerify struct{}
+
+// Name returns the human-readable name of the precompile.
+func (c *PoseidonPointMerkleVerify) Name() string {
+	return "PoseidonPointMerkleVerify"
+}
+
+// RequiredGas returns the gas cost of executing this precompile.
+//
+// Gas is calculated as:
+//
+//	PoseidonPointMerkleVerifyBaseGas + (depth * PoseidonMerklePerLevelGas)
+//
+// Where depth is the number of 32-byte siblings following the fixed header.
+func (c *PoseidonPointMerkleVerify) RequiredGas(input []byte) uint64 {
+	return PoseidonPointMerkleVerifyBaseGas +
+		uint64(calculateDepth(input, PoseidonPointMerkleVerifyHeaderSize))*PoseidonMerklePerLevelGas
+}
+
+// Run executes the Poseidon point Merkle verification precompile.
+//
+// The input is encoded as:
+//
+//	x || y || root || pathIndices || sibling_0 || ... || sibling_{d-1}
+//
+// Where:
+//   - (x, y) is the leaf point in affine coordinates.
+//   - root is the claimed Merkle root.
+//   - pathIndices is a bitmap where bit i set means the node at level i is
+//     the right child (sibling_i is hashed on the left).
+//   - sibling_i is the sibling node at level i, starting from the leaf.
+//   - 1 <= d <= PoseidonMerkleMaxDepth.
+//
+// Each element is a big-endian field element padded to PoseidonMerkleWordSize bytes.
+//
+// Run performs the following steps:
+//  1. Validates the input length and depth bounds.
+//  2. Validates that the leaf point is in the BabyJubJub subgroup.
+//  3. Hashes the leaf as poseidon(x, y).
+//  4. Folds the siblings into the leaf hash following pathIndices.
+//  5. Returns []byte{1} if the computed root equals root, []byte{0} otherwise.
+//
+// Returns an error if:
+//   - The input length is invalid.
+//   - The leaf point is not on the curve or not in the subgroup.
+//   - pathIndices has bits set at or above the depth.
+//   - Any field element is not inside the Poseidon field.
+func (c *PoseidonPointMerkleVerify) Run(input []byte) ([]byte, error) {
+	depth, err := validateDepth(input, PoseidonPointMerkleVerifyHeaderSize)
+
+	if err != nil {
+		return nil, err
+	}
+
+	x, offset := commonUtils.ReadField(input, 0, PoseidonMerkleWordSize)
+	y, offset := commonUtils.ReadField(input, offset, PoseidonMerkleWordSize)
+
+	leaf := &babyjub.Point{X: x, Y: y}
+
+	if !leaf.InSubGroup() {
+		return nil, ErrorPoseidonMerkleInvalidLeafPoint
+	}
+
+	root, offset := commonUtils.ReadField(input, offset, PoseidonMerkleWordSize)
+	pathIndices, offset := commonUtils.ReadField(input, offset, PoseidonMerkleWordSize)
+
+	leafHash, err := PointLeaf(leaf)
+
+	if err != nil {
+		return nil, err
+	}
+
+	computedRoot, err := computeRoot(input, offset, depth, leafHash, pathIndices)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if computedRoot.Cmp(root) == 0 {
+		return []byte{1}, nil
+	}
+
+	return []byte{0}, nil
+}
+
+// PointLeaf returns the Merkle leaf of a BabyJubJub point:
+//
+//	poseidon(x, y)
+func PointLeaf(point *babyjub.Point) (*big.Int, error) {
+	return poseidon.Hash([]*big.Int{point.X, point.Y})
+}
+
+// calculateDepth returns the number of siblings encoded after a header of
+// headerSize bytes. No validation is performed.
+func calculateDepth(input []byte, headerSize int) int {
+	if len(input) < headerSize {
+		return 0
+	}
+
+	return (len(input) - headerSize) / PoseidonMerkleWordSize
+}
+
+// validateDepth checks that input consists of a header of headerSize bytes
+// followed by between 1 and PoseidonMerkleMaxDepth siblings, and returns
+// the depth.
+func validateDepth(input []byte, headerSize int) (int, error) {
+	if len(input) < headerSize || (len(input)-headerSize)%PoseidonMerkleWordSize != 0 {
+		return 0, ErrorPoseidonMerkleInvalidInputLength
+	}
+
+	depth := calculateDepth(input, headerSize)
+
+	if depth == 0 || depth > PoseidonMerkleMaxDepth {
+		return 0, ErrorPoseidonMerkleInvalidInputLength
+	}
+
+	return depth, nil
+}
+
+// computeRoot folds depth siblings read from input starting at offset into
+// node, using the bits of pathIndices to select the hashing order at each
+// level, and returns the resulting root.
+//
+// The caller must ensure that input holds depth siblings from offset.
+func computeRoot(input []byte, offset, depth int, node, pathIndices *big.Int) (*big.Int, error) {
+	if pathIndices.BitLen() > depth {
+		return nil, ErrorPoseidonMerkleInvalidPathIndices
+	}
+
+	for level := range depth {
+		var sibling *big.Int
+		var err error
+
+		sibling, offset = commonUtils.ReadField(input, offset, PoseidonMerkleWordSize)
+
+		if pathIndices.Bit(level) == 1 {
+			node, err = poseidon.Hash([]*big.Int{sibling, node})
+		} else {
+			node, err = poseidon.Hash([]*big.Int{node, sibling})
+		}
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return node, nil
+}
+
+// Ensure PoseidonPointMerkleVerify implements the common.Precompile interface.
+var _ common.Precompile = (*PoseidonPointMerkleVerify)(nil)
@@ -0,0 +1,202 @@
+package merkle
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/iden3/go-iden3-crypto/babyjub"
+	"github.com/iden3/go-iden3-crypto/poseidon"
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/gen"
+	"github.com/leanovate/gopter/prop"
+	"github.com/privacy-ethereum/privacy-precompiles/babyjubjub/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPoseidonPointMerkleVerifyName(t *testing.T) {
+	precompile := PoseidonPointMerkleVerify{}
+
+	expected := "PoseidonPointMerkleVerify"
+	actual := precompile.Name()
+
+	assert.Equal(t, expected, actual)
+}
+
+func TestPoseidonPointMerkleVerify(t *testing.T) {
+	points := make([]*babyjub.Point, 8)
+
+	for index := range points {
+		points[index] = babyjub.NewPoint().Mul(big.NewInt(int64(index+1)), babyjub.B8)
+	}
+
+	leaves := make([]*big.Int, len(points))
+
+	for index, point := range points {
+		leaves[index], _ = poseidon.Hash([]*big.Int{point.X, point.Y})
+	}
+
+	root, siblings := buildTree(leaves, 5)
+
+	tests := []struct {
+		name          string
+		input         []byte
+		expected      []byte
+		expectedGas   uint64
+		expectedError error
+	}{
+		{
+			name:        "valid inclusion proof",
+			input:       prepareInput(points[5], root, big.NewInt(5), siblings),
+			expected:    []byte{1},
+			expectedGas: PoseidonPointMerkleVerifyBaseGas + 3*PoseidonMerklePerLevelGas,
+		},
+		{
+			name: "corrupted sibling",
+			input: func() []byte {
+				corrupted := append([]*big.Int{}, siblings...)
+				corrupted[1] = new(big.Int).Add(corrupted[1], big.NewInt(1))
+
+				return prepareInput(points[5], root, big.NewInt(5), corrupted)
+			}(),
+			expected:    []byte{0},
+			expectedGas: PoseidonPointMerkleVerifyBaseGas + 3*PoseidonMerklePerLevelGas,
+		},
+		{
+			name:        "wrong leaf point",
+			input:       prepareInput(points[4], root, big.NewInt(5), siblings),
+			expected:    []byte{0},
+			expectedGas: PoseidonPointMerkleVerifyBaseGas + 3*PoseidonMerklePerLevelGas,
+		},
+		{
+			name:        "wrong path indices",
+			input:       prepareInput(points[5], root, big.NewInt(4), siblings),
+			expected:    []byte{0},
+			expectedGas: PoseidonPointMerkleVerifyBaseGas + 3*PoseidonMerklePerLevelGas,
+		},
+		{
+			name:          "path indices above depth",
+			input:         prepareInput(points[5], root, big.NewInt(13), siblings),
+			expectedError: ErrorPoseidonMerkleInvalidPathIndices,
+		},
+		{
+			name:          "leaf point not on curve",
+			input:         prepareInput(&babyjub.Point{X: big.NewInt(123), Y: big.NewInt(456)}, root, big.NewInt(5), siblings),
+			expectedError: ErrorPoseidonMerkleInvalidLeafPoint,
+		},
+		{
+			name: "leaf point not in subgroup",
+			input: prepareInput(
+				&babyjub.Point{X: big.NewInt(0), Y: new(big.Int).Sub(utils.FieldPrime, big.NewInt(1))},
+				root,
+				big.NewInt(5),
+				siblings,
+			),
+			expectedError: ErrorPoseidonMerkleInvalidLeafPoint,
+		},
+		{
+			name:          "empty input",
+			input:         []byte{},
+			expectedError: ErrorPoseidonMerkleInvalidInputLength,
+		},
+		{
+			name:          "missing siblings",
+			input:         make([]byte, PoseidonPointMerkleVerifyHeaderSize),
+			expectedError: ErrorPoseidonMerkleInvalidInputLength,
+		},
+		{
+			name:          "unaligned siblings",
+			input:         prepareInput(points[5], root, big.NewInt(5), siblings)[:PoseidonPointMerkleVerifyHeaderSize+PoseidonMerkleWordSize+1],
+			expectedError: ErrorPoseidonMerkleInvalidInputLength,
+		},
+		{
+			name:          "depth above maximum",
+			input:         prepareInput(points[5], root, big.NewInt(5), make([]*big.Int, PoseidonMerkleMaxDepth+1)),
+			expectedError: ErrorPoseidonMerkleInvalidInputLength,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			precompile := PoseidonPointMerkleVerify{}
+
+			actual, err := precompile.Run(tt.input)
+			gas := precompile.RequiredGas(tt.input)
+
+			if tt.expectedError != nil {
+				assert.NotNil(t, err)
+				assert.Equal(t, tt.expectedError, err)
+
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, tt.expected, actual)
+			assert.Equal(t, tt.expectedGas, gas)
+		})
+	}
+}
+
+func TestPoseidonPointMerkleVerifyProperties(t *testing.T) {
+	parameters := gopter.DefaultTestParameters()
+	properties := gopter.NewProperties(parameters)
+
+	properties.Property("Run accepts any leaf of a tree built from valid points", prop.ForAll(
+		func(points []*babyjub.Point, index int) bool {
+			leaves := make([]*big.Int, len(points))
+
+			for i, point := range points {
+				leaves[i], _ = poseidon.Hash([]*big.Int{point.X, point.Y})
+			}
+
+			root, siblings := buildTree(leaves, index)
+
+			precompile := PoseidonPointMerkleVerify{}
+			result, err := precompile.Run(prepareInput(points[index], root, big.NewInt(int64(index)), siblings))
+
+			return err == nil && result[0] == 1
+		},
+		gen.SliceOfN(4, utils.BabyJubJubPointGenerator()),
+		gen.IntRange(0, 3),
+	))
+
+	properties.TestingRun(t)
+}
+
+// buildTree returns the root of the Poseidon Merkle tree over leaves and the
+// sibling path of the leaf at index. The number of leaves must be a power of two.
+func buildTree(leaves []*big.Int, index int) (*big.Int, []*big.Int) {
+	siblings := make([]*big.Int, 0)
+	level := leaves
+
+	for len(level) > 1 {
+		siblings = append(siblings, level[index^1])
+		next := make([]*big.Int, len(level)/2)
+
+		for i := range next {
+			next[i], _ = poseidon.Hash([]*big.Int{level[2*i], level[2*i+1]})
+		}
+
+		level = next
+		index /= 2
+	}
+
+	return level[0], siblings
+}
+
+func prepareInput(leaf *babyjub.Point, root, pathIndices *big.Int, siblings []*big.Int) []byte {
+	input := utils.MarshalPoint(leaf)
+	input = append(input, root.FillBytes(make([]byte, PoseidonMerkleWordSize))...)
+	input = append(input, pathIndices.FillBytes(make([]byte, PoseidonMerkleWordSize))...)
+
+	for _, sibling := range siblings {
+		buffer := make([]byte, PoseidonMerkleWordSize)
+
+		if sibling != nil {
+			sibling.FillBytes(buffer)
+		}
+
+		input = append(input, buffer...)
+	}
+
+	return input
+}
@@ -0,0 +1,194 @@
+package merkle
+
+import (
+	"math/big"
+
+	iden3Poseidon "github.com/iden3/go-iden3-crypto/poseidon"
+	iden3Utils "github.com/iden3/go-iden3-crypto/utils"
+	"github.com/privacy-ethereum/privacy-precompiles/common"
+	"github.com/privacy-ethereum/privacy-precompiles/poseidon"
+	commonUtils "github.com/privacy-ethereum/privacy-precompiles/utils"
+)
+
+// PoseidonMultiTreeRootVerify implements a precompile checking the claimed
+// Poseidon Merkle roots of several trees in a single call.
+//
+// It satisfies the common.Precompile interface and can be used in a generic
+// precompile execution framework. Systems maintaining several trees can
+// amortize the per-call overhead across all of them.
+type PoseidonMultiTreeRootVerify struct{}
+
+// treeGroup describes a single (leaves, claimedRoot) group of the
+// PoseidonMultiTreeRootVerify input.
+type treeGroup struct {
+	depth        int
+	claimedRoot  *big.Int
+	leavesOffset int
+}
+
+// Name returns the human-readable name of the precompile.
+func (c *PoseidonMultiTreeRootVerify) Name() string {
+	return "PoseidonMultiTreeRootVerify"
+}
+
+// RequiredGas returns the gas cost of executing this precompile.
+//
+// Gas is calculated as:
+//
+//	PoseidonMultiTreeRootVerifyBaseGas + sum((2^depth - 1) * PoseidonMerkleHashGas)
+//
+// If the input is malformed, only the base gas is charged.
+func (c *PoseidonMultiTreeRootVerify) RequiredGas(input []byte) uint64 {
+	gas := PoseidonMultiTreeRootVerifyBaseGas
+	groups, err := parseTreeGroups(input)
+
+	if err != nil {
+		return gas
+	}
+
+	for _, group := range groups {
+		gas += (uint64(1)<<group.depth - 1) * PoseidonMerkleHashGas
+	}
+
+	return gas
+}
+
+// Run executes the Poseidon multi-tree root verification precompile.
+//
+// The input is a concatenation of 1..PoseidonMultiTreeMaxTrees groups:
+//
+//	group_0 || group_1 || ... || group_{n-1}
+//
+// where each group is encoded as:
+//
+//	depth || claimedRoot || leaf_0 || ... || leaf_{2^depth - 1}
+//
+// Each element is a big-endian field element padded to PoseidonMerkleWordSize
+// bytes, and 0 <= depth <= PoseidonMultiTreeMaxDepth. Internal nodes are
+// computed as poseidon(left, right); a tree of depth 0 has its single leaf
+// as root.
+//
+// The output is a bitmap of ceil(n/8) bytes where the bit for tree i is set
+// if its claimed root is correct. Bits are packed most significant first:
+// tree i maps to bit (7 - i%8) of byte i/8, and unused trailing bits are zero.
+//
+// Returns an error if:
+//   - The input is empty, truncated, or has trailing bytes.
+//   - Any depth exceeds PoseidonMultiTreeMaxDepth.
+//   - The number of trees exceeds PoseidonMultiTreeMaxTrees.
+//   - Any field element is not inside the Poseidon field.
+func (c *PoseidonMultiTreeRootVerify) Run(input []byte) ([]byte, error) {
+	groups, err := parseTreeGroups(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	bitmap := make([]byte, (len(groups)+7)/8)
+
+	for index, group := range groups {
+		root, err := computeTreeRoot(input, group.leavesOffset, group.depth)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if root.Cmp(group.claimedRoot) == 0 {
+			bitmap[index/8] |= 0x80 >> (index % 8)
+		}
+	}
+
+	return bitmap, nil
+}
+
+// parseTreeGroups walks the PoseidonMultiTreeRootVerify input and returns
+// the tree groups it contains, validating their bounds.
+func parseTreeGroups(input []byte) ([]treeGroup, error) {
+	groups := make([]treeGroup, 0)
+	offset := 0
+
+	for offset < len(input) {
+		if len(groups) == PoseidonMultiTreeMaxTrees {
+			return nil, ErrorPoseidonMerkleInvalidInputLength
+		}
+
+		depth, next := commonUtils.ReadField(input, offset, PoseidonMerkleWordSize)
+		claimedRoot, next := commonUtils.ReadField(input, next, PoseidonMerkleWordSize)
+
+		if depth == nil || claimedRoot == nil || depth.Cmp(big.NewInt(PoseidonMultiTreeMaxDepth)) > 0 {
+			return nil, ErrorPoseidonMerkleInvalidInputLength
+		}
+
+		groupEnd := next + (1<<depth.Uint64())*PoseidonMerkleWordSize
+
+		if groupEnd > len(input) {
+			return nil, ErrorPoseidonMerkleInvalidInputLength
+		}
+
+		groups = append(groups, treeGroup{
+			depth:        int(depth.Uint64()),
+			claimedRoot:  claimedRoot,
+			leavesOffset: next,
+		})
+
+		offset = groupEnd
+	}
+
+	if len(groups) == 0 {
+		return nil, ErrorPoseidonMerkleInvalidInputLength
+	}
+
+	return groups, nil
+}
+
+// computeTreeRoot returns the Poseidon Merkle root of the 2^depth leaves read
+// from input starting at offset.
+//
+// The caller must ensure that input holds 2^depth leaves from offset.
+func computeTreeRoot(input []byte, offset, depth int) (*big.Int, error) {
+	leaves := make([]*big.Int, 1<<depth)
+
+	for index := range leaves {
+		leaves[index], offset = commonUtils.ReadField(input, offset, PoseidonMerkleWordSize)
+	}
+
+	return ComputeRoot(leaves)
+}
+
+// ComputeRoot returns the root of the Poseidon Merkle tree over leaves, where
+// each internal node is poseidon(left, right).
+//
+// The number of leaves must be a non-zero power of two. A single leaf is its
+// own root. Every leaf is checked to be inside the Poseidon field, including
+// a single leaf that is not hashed, and poseidon.ErrorPoseidonInputNotInField
+// is returned otherwise.
+func ComputeRoot(leaves []*big.Int) (*big.Int, error) {
+	for _, leaf := range leaves {
+		if !iden3Utils.CheckBigIntInField(leaf) {
+			return nil, poseidon.ErrorPoseidonInputNotInField
+		}
+	}
+
+	level := leaves
+
+	for len(level) > 1 {
+		next := make([]*big.Int, len(level)/2)
+
+		for index := range next {
+			node, err := iden3Poseidon.Hash([]*big.Int{level[2*index], level[2*index+1]})
+
+			if err != nil {
+				return nil, err
+			}
+
+			next[index] = node
+		}
+
+		level = next
+	}
+
+	return level[0], nil
+}
+
+// Ensure PoseidonMultiTreeRootVerify implements the common.Precompile interface.
+var _ common.Precompile = (*PoseidonMultiTreeRootVerify)(nil)
@@ -0,0 +1,171 @@
+package merkle
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/gen"
+	"github.com/leanovate/gopter/prop"
+	"github.com/privacy-ethereum/privacy-precompiles/babyjubjub/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPoseidonMultiTreeRootVerifyName(t *testing.T) {
+	precompile := PoseidonMultiTreeRootVerify{}
+
+	expected := "PoseidonMultiTreeRootVerify"
+	actual := precompile.Name()
+
+	assert.Equal(t, expected, actual)
+}
+
+func TestPoseidonMultiTreeRootVerify(t *testing.T) {
+	leaves1 := []*big.Int{big.NewInt(1), big.NewInt(2), big.NewInt(3), big.NewInt(4)}
+	leaves2 := []*big.Int{big.NewInt(5), big.NewInt(6)}
+
+	root1, _ := buildTree(leaves1, 0)
+	root2, _ := buildTree(leaves2, 0)
+
+	tests := []struct {
+		name          string
+		input         []byte
+		expected      []byte
+		expectedGas   uint64
+		expectedError error
+	}{
+		{
+			name: "one correct and one incorrect root",
+			input: append(
+				prepareGroup(2, root1, leaves1),
+				prepareGroup(1, new(big.Int).Add(root2, big.NewInt(1)), leaves2)...,
+			),
+			expected:    []byte{0x80},
+			expectedGas: PoseidonMultiTreeRootVerifyBaseGas + 4*PoseidonMerkleHashGas,
+		},
+		{
+			name: "one incorrect and one correct root",
+			input: append(
+				prepareGroup(2, root2, leaves1),
+				prepareGroup(1, root2, leaves2)...,
+			),
+			expected:    []byte{0x40},
+			expectedGas: PoseidonMultiTreeRootVerifyBaseGas + 4*PoseidonMerkleHashGas,
+		},
+		{
+			name:        "single leaf tree",
+			input:       prepareGroup(0, big.NewInt(7), []*big.Int{big.NewInt(7)}),
+			expected:    []byte{0x80},
+			expectedGas: PoseidonMultiTreeRootVerifyBaseGas,
+		},
+		{
+			name: "bitmap spans two bytes",
+			input: func() []byte {
+				input := make([]byte, 0)
+
+				for range 9 {
+					input = append(input, prepareGroup(2, root1, leaves1)...)
+				}
+
+				return input
+			}(),
+			expected:    []byte{0xff, 0x80},
+			expectedGas: PoseidonMultiTreeRootVerifyBaseGas + 27*PoseidonMerkleHashGas,
+		},
+		{
+			name:          "empty input",
+			input:         []byte{},
+			expectedError: ErrorPoseidonMerkleInvalidInputLength,
+		},
+		{
+			name:          "truncated header",
+			input:         make([]byte, PoseidonMultiTreeGroupHeaderSize-1),
+			expectedError: ErrorPoseidonMerkleInvalidInputLength,
+		},
+		{
+			name:          "truncated leaves",
+			input:         prepareGroup(2, root1, leaves1)[:PoseidonMultiTreeGroupHeaderSize+3*PoseidonMerkleWordSize],
+			expectedError: ErrorPoseidonMerkleInvalidInputLength,
+		},
+		{
+			name:          "depth above maximum",
+			input:         prepareGroup(PoseidonMultiTreeMaxDepth+1, root1, leaves1),
+			expectedError: ErrorPoseidonMerkleInvalidInputLength,
+		},
+		{
+			name: "too many trees",
+			input: func() []byte {
+				input := make([]byte, 0)
+
+				for range PoseidonMultiTreeMaxTrees + 1 {
+					input = append(input, prepareGroup(0, big.NewInt(7), []*big.Int{big.NewInt(7)})...)
+				}
+
+				return input
+			}(),
+			expectedError: ErrorPoseidonMerkleInvalidInputLength,
+		},
+		{
+			name: "leaf outside the field",
+			input: prepareGroup(1, root2, []*big.Int{
+				big.NewInt(5),
+				new(big.Int).Add(utils.FieldPrime, big.NewInt(1)),
+			}),
+			expectedError: errors.New("inputs values not inside Finite Field"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			precompile := PoseidonMultiTreeRootVerify{}
+
+			actual, err := precompile.Run(tt.input)
+			gas := precompile.RequiredGas(tt.input)
+
+			if tt.expectedError != nil {
+				assert.NotNil(t, err)
+				assert.Equal(t, tt.expectedError, err)
+
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, tt.expected, actual)
+			assert.Equal(t, tt.expectedGas, gas)
+		})
+	}
+}
+
+func TestPoseidonMultiTreeRootVerifyProperties(t *testing.T) {
+	parameters := gopter.DefaultTestParameters()
+	properties := gopter.NewProperties(parameters)
+
+	properties.Property("Run marks every correctly claimed root", prop.ForAll(
+		func(leaves []*big.Int) bool {
+			root, _ := buildTree(leaves, 0)
+
+			precompile := PoseidonMultiTreeRootVerify{}
+			result, err := precompile.Run(append(
+				prepareGroup(3, root, leaves),
+				prepareGroup(3, root, leaves)...,
+			))
+
+			return err == nil && len(result) == 1 && result[0] == 0xc0
+		},
+		gen.SliceOfN(8, utils.ScalarGenerator()),
+	))
+
+	properties.TestingRun(t)
+}
+
+func prepareGroup(depth int64, root *big.Int, leaves []*big.Int) []byte {
+	input := big.NewInt(depth).FillBytes(make([]byte, PoseidonMerkleWordSize))
+	input = append(input, root.FillBytes(make([]byte, PoseidonMerkleWordSize))...)
+
+	for _, leaf := range leaves {
+		input = append(input, leaf.FillBytes(make([]byte, PoseidonMerkleWordSize))...)
+	}
+
+	return input
+}
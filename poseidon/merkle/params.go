@@ -0,0 +1,134 @@
+package merkle
+
+import (
+	"errors"
+
+	"github.com/privacy-ethereum/privacy-precompiles/babyjubjub/validation"
+	"github.com/privacy-ethereum/privacy-precompiles/poseidon"
+)
+
+// Poseidon Merkle precompile constants
+const (
+	// PoseidonMerkleWordSize defines the fixed byte length of a single
+	// field element (coordinate, root, path indices or sibling) in the
+	// Merkle precompile input.
+	PoseidonMerkleWordSize = poseidon.PoseidonInputWordSize
+
+	// PoseidonMerkleMaxDepth defines the maximum tree depth (number of
+	// siblings) accepted by the Merkle precompiles.
+	//
+	// The bound keeps gas and execution time predictable and matches the
+	// width of the path indices bitmap that can be meaningfully used.
+	PoseidonMerkleMaxDepth = 32
+
+	// PoseidonPointMerkleVerifyHeaderSize defines the byte length of the
+	// fixed prefix of the PoseidonPointMerkleVerify input:
+	//
+	//	x || y || root || pathIndices
+	PoseidonPointMerkleVerifyHeaderSize = 4 * PoseidonMerkleWordSize
+
+	// PoseidonMerkleHashGas defines the gas cost of a single two-to-one
+	// Poseidon compression used to hash a leaf or an internal node.
+	PoseidonMerkleHashGas = poseidon.PoseidonBaseGas + 2*poseidon.PoseidonPerWordGas
+
+	// PoseidonPointMerkleVerifyBaseGas defines the fixed gas cost of the
+	// PoseidonPointMerkleVerify precompile. It covers the leaf point
+	// validation and the leaf hash.
+	PoseidonPointMerkleVerifyBaseGas = validation.BabyJubJubCurveValidatePointGas + PoseidonMerkleHashGas
+
+	// PoseidonMerklePerLevelGas defines the gas cost charged per sibling
+	// in the Merkle path.
+	//
+	// Total gas cost is calculated as:
+	//
+	//	BaseGas + (depth * PoseidonMerklePerLevelGas)
+	PoseidonMerklePerLevelGas = PoseidonMerkleHashGas
+
+	// PoseidonMultiTreeMaxTrees defines the maximum number of trees accepted
+	// by the PoseidonMultiTreeRootVerify precompile in a single invocation.
+	PoseidonMultiTreeMaxTrees = 16
+
+	// PoseidonMultiTreeMaxDepth defines the maximum depth of each tree
+	// accepted by the PoseidonMultiTreeRootVerify precompile. A tree of depth
+	// d carries 2^d leaves.
+	PoseidonMultiTreeMaxDepth = 10
+
+	// PoseidonMultiTreeGroupHeaderSize defines the byte length of the header
+	// preceding the leaves of each tree group:
+	//
+	//	depth || claimedRoot
+	PoseidonMultiTreeGroupHeaderSize = 2 * PoseidonMerkleWordSize
+
+	// PoseidonMultiTreeRootVerifyBaseGas defines the fixed gas cost of the
+	// PoseidonMultiTreeRootVerify precompile, paid once per call regardless
+	// of the number of trees.
+	//
+	// Total gas cost is calculated as:
+	//
+	//	BaseGas + sum over trees of ((2^depth - 1) * PoseidonMerkleHashGas)
+	PoseidonMultiTreeRootVerifyBaseGas uint64 = 3000
+
+	// PoseidonMerkleRootMaxLeaves defines the maximum number of leaves
+	// accepted by the PoseidonMerkleRoot precompile.
+	PoseidonMerkleRootMaxLeaves = 1 << PoseidonMultiTreeMaxDepth
+
+	// PoseidonMerkleRootBaseGas defines the fixed gas cost of the
+	// PoseidonMerkleRoot precompile.
+	//
+	// Total gas cost is calculated as:
+	//
+	//	BaseGas + (n - 1) * PoseidonMerkleHashGas
+	PoseidonMerkleRootBaseGas uint64 = 3000
+
+	// PoseidonMerkleVerifyHeaderSize defines the byte length of the fixed
+	// prefix of the PoseidonMerkleVerify input:
+	//
+	//	leaf || root || pathLen
+	PoseidonMerkleVerifyHeaderSize = 2*PoseidonMerkleWordSize + PoseidonMerkleVerifyPathLengthSize
+
+	// PoseidonMerkleVerifyPathLengthSize defines the byte length of the
+	// big-endian path length in the PoseidonMerkleVerify input.
+	PoseidonMerkleVerifyPathLengthSize = 4
+
+	// PoseidonMerkleVerifyLevelSize defines the byte length of a single
+	// path level in the PoseidonMerkleVerify input:
+	//
+	//	sibling || dirBit
+	PoseidonMerkleVerifyLevelSize = PoseidonMerkleWordSize + 1
+
+	// PoseidonMerkleVerifyBaseGas defines the fixed gas cost of the
+	// PoseidonMerkleVerify precompile.
+	//
+	// Total gas cost is calculated as:
+	//
+	//	BaseGas + (depth * PoseidonMerklePerLevelGas)
+	PoseidonMerkleVerifyBaseGas uint64 = 3000
+)
+
+var (
+	// ErrorPoseidonMerkleInvalidInputLength is returned when the input to a
+	// Poseidon Merkle precompile does not conform to the expected format.
+	//
+	// This occurs when:
+	//   - The input is shorter than the fixed header.
+	//   - The sibling section is not a multiple of PoseidonMerkleWordSize.
+	//   - The depth is zero or exceeds PoseidonMerkleMaxDepth.
+	//   - The tree groups of a PoseidonMultiTreeRootVerify input are
+	//     missing, truncated, or out of bounds.
+	//
+	// PoseidonMerkleRoot returns poseidon.ErrorPoseidonInvalidInputLength
+	// instead.
+	ErrorPoseidonMerkleInvalidInputLength = errors.New("invalid input length")
+
+	// ErrorPoseidonMerkleInvalidPathIndices is returned when the path indices
+	// bitmap has bits set at or above the tree depth.
+	ErrorPoseidonMerkleInvalidPathIndices = errors.New("invalid path indices")
+
+	// ErrorPoseidonMerkleInvalidLeafPoint is returned when the leaf point is
+	// not on the BabyJubJub curve or not in the prime-order subgroup.
+	ErrorPoseidonMerkleInvalidLeafPoint = errors.New("invalid leaf point")
+
+	// ErrorPoseidonMerkleInvalidDirection is returned when a direction byte
+	// of the PoseidonMerkleVerify path is neither 0 nor 1.
+	ErrorPoseidonMerkleInvalidDirection = errors.New("invalid path direction")
+)
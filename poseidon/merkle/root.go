@@ -0,0 +1,105 @@
+package merkle
+
+import (
+	"math/big"
+
+	"github.com/privacy-ethereum/privacy-precompiles/common"
+	"github.com/privacy-ethereum/privacy-precompiles/poseidon"
+	commonUtils "github.com/privacy-ethereum/privacy-precompiles/utils"
+)
+
+// PoseidonMerkleRoot implements a precompile computing the Poseidon Merkle
+// root of a full binary tree in a single call.
+//
+// It satisfies the common.Precompile interface and can be used in a generic
+// precompile execution framework. Building a root this way avoids paying
+// the per-call overhead of one Poseidon precompile call per internal node.
+type PoseidonMerkleRoot struct{}
+
+// Name returns the human-readable name of the precompile.
+func (c *PoseidonMerkleRoot) Name() string {
+	return "PoseidonMerkleRoot"
+}
+
+// RequiredGas returns the gas cost of executing this precompile.
+//
+// Gas is calculated as:
+//
+//	PoseidonMerkleRootBaseGas + (n - 1) * PoseidonMerkleHashGas
+//
+// If the input is malformed, only the base gas is charged.
+func (c *PoseidonMerkleRoot) RequiredGas(input []byte) uint64 {
+	count, err := parseLeafCount(input)
+
+	if err != nil {
+		return PoseidonMerkleRootBaseGas
+	}
+
+	return PoseidonMerkleRootBaseGas + uint64(count-1)*PoseidonMerkleHashGas
+}
+
+// Run executes the Poseidon Merkle root precompile.
+//
+// The input is encoded as:
+//
+//	n || leaf_0 || ... || leaf_{n-1}
+//
+// Where:
+//   - n is a non-zero power of two, at most PoseidonMerkleRootMaxLeaves.
+//   - Each element is a big-endian field element padded to
+//     PoseidonMerkleWordSize bytes.
+//
+// Internal nodes are computed as poseidon(left, right), level by level from
+// the leaves. A single leaf is its own root. The output is the root encoded
+// as a PoseidonMerkleWordSize-byte big-endian value.
+//
+// Returns an error if:
+//   - n is not a power of two or exceeds PoseidonMerkleRootMaxLeaves
+//     (poseidon.ErrorPoseidonInvalidInputLength).
+//   - The input does not hold exactly n leaves after the count
+//     (poseidon.ErrorPoseidonInvalidInputLength).
+//   - Any leaf, even the single leaf of a one-leaf tree, is not inside the
+//     Poseidon field (poseidon.ErrorPoseidonInputNotInField).
+func (c *PoseidonMerkleRoot) Run(input []byte) ([]byte, error) {
+	count, err := parseLeafCount(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	leaves := make([]*big.Int, count)
+	offset := PoseidonMerkleWordSize
+
+	for index := range leaves {
+		leaves[index], offset = commonUtils.ReadField(input, offset, PoseidonMerkleWordSize)
+	}
+
+	root, err := ComputeRoot(leaves)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return root.FillBytes(make([]byte, PoseidonMerkleWordSize)), nil
+}
+
+// parseLeafCount reads the leaf count of the PoseidonMerkleRoot input and
+// checks that it is a supported power of two matching the input length.
+func parseLeafCount(input []byte) (int, error) {
+	count, _ := commonUtils.ReadField(input, 0, PoseidonMerkleWordSize)
+
+	if count == nil || count.Sign() == 0 || count.Cmp(big.NewInt(PoseidonMerkleRootMaxLeaves)) > 0 {
+		return 0, poseidon.ErrorPoseidonInvalidInputLength
+	}
+
+	n := int(count.Uint64())
+
+	if n&(n-1) != 0 || len(input) != (n+1)*PoseidonMerkleWordSize {
+		return 0, poseidon.ErrorPoseidonInvalidInputLength
+	}
+
+	return n, nil
+}
+
+// Ensure PoseidonMerkleRoot implements the common.Precompile interface.
+var _ common.Precompile = (*PoseidonMerkleRoot)(nil)
@@ -0,0 +1,172 @@
+package merkle
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/gen"
+	"github.com/leanovate/gopter/prop"
+	"github.com/privacy-ethereum/privacy-precompiles/babyjubjub/utils"
+	"github.com/privacy-ethereum/privacy-precompiles/poseidon"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPoseidonMerkleRootName(t *testing.T) {
+	precompile := PoseidonMerkleRoot{}
+
+	expected := "PoseidonMerkleRoot"
+	actual := precompile.Name()
+
+	assert.Equal(t, expected, actual)
+}
+
+func TestPoseidonMerkleRoot(t *testing.T) {
+	leaves := []*big.Int{big.NewInt(1), big.NewInt(2), big.NewInt(3), big.NewInt(4)}
+
+	tests := []struct {
+		name          string
+		input         []byte
+		expected      []byte
+		expectedGas   uint64
+		expectedError error
+	}{
+		{
+			name:        "four leaves",
+			input:       prepareLeaves(4, leaves),
+			expected:    rootByPrecompile(t, leaves),
+			expectedGas: PoseidonMerkleRootBaseGas + 3*PoseidonMerkleHashGas,
+		},
+		{
+			name:        "two leaves",
+			input:       prepareLeaves(2, leaves[:2]),
+			expected:    rootByPrecompile(t, leaves[:2]),
+			expectedGas: PoseidonMerkleRootBaseGas + PoseidonMerkleHashGas,
+		},
+		{
+			name:        "single leaf",
+			input:       prepareLeaves(1, leaves[:1]),
+			expected:    leaves[0].FillBytes(make([]byte, PoseidonMerkleWordSize)),
+			expectedGas: PoseidonMerkleRootBaseGas,
+		},
+		{
+			name:          "zero leaves",
+			input:         prepareLeaves(0, nil),
+			expectedError: poseidon.ErrorPoseidonInvalidInputLength,
+		},
+		{
+			name:          "count not a power of two",
+			input:         prepareLeaves(3, leaves[:3]),
+			expectedError: poseidon.ErrorPoseidonInvalidInputLength,
+		},
+		{
+			name:          "count larger than leaves",
+			input:         prepareLeaves(4, leaves[:2]),
+			expectedError: poseidon.ErrorPoseidonInvalidInputLength,
+		},
+		{
+			name:          "count smaller than leaves",
+			input:         prepareLeaves(2, leaves),
+			expectedError: poseidon.ErrorPoseidonInvalidInputLength,
+		},
+		{
+			name:          "count above max leaves",
+			input:         prepareLeaves(2*PoseidonMerkleRootMaxLeaves, nil),
+			expectedError: poseidon.ErrorPoseidonInvalidInputLength,
+		},
+		{
+			name:          "truncated leaf",
+			input:         prepareLeaves(2, leaves[:2])[:3*PoseidonMerkleWordSize-1],
+			expectedError: poseidon.ErrorPoseidonInvalidInputLength,
+		},
+		{
+			name:          "empty input",
+			input:         []byte{},
+			expectedError: poseidon.ErrorPoseidonInvalidInputLength,
+		},
+		{
+			name:          "leaf outside the field",
+			input:         prepareLeaves(2, []*big.Int{big.NewInt(1), new(big.Int).Add(utils.FieldPrime, big.NewInt(1))}),
+			expectedGas:   PoseidonMerkleRootBaseGas + PoseidonMerkleHashGas,
+			expectedError: poseidon.ErrorPoseidonInputNotInField,
+		},
+		{
+			name:          "single leaf outside the field",
+			input:         prepareLeaves(1, []*big.Int{utils.FieldPrime}),
+			expectedError: poseidon.ErrorPoseidonInputNotInField,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			precompile := PoseidonMerkleRoot{}
+
+			actual, err := precompile.Run(tt.input)
+			gas := precompile.RequiredGas(tt.input)
+
+			if tt.expectedError != nil {
+				assert.NotNil(t, err)
+				assert.Equal(t, tt.expectedError, err)
+
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, tt.expected, actual)
+			assert.Equal(t, tt.expectedGas, gas)
+		})
+	}
+}
+
+func TestPoseidonMerkleRootProperties(t *testing.T) {
+	parameters := gopter.DefaultTestParameters()
+	properties := gopter.NewProperties(parameters)
+
+	properties.Property("Run matches a level-by-level Poseidon computation", prop.ForAll(
+		func(leaves []*big.Int) bool {
+			precompile := PoseidonMerkleRoot{}
+			result, err := precompile.Run(prepareLeaves(int64(len(leaves)), leaves))
+
+			return err == nil && bytes.Equal(rootByPrecompile(t, leaves), result)
+		},
+		gen.SliceOfN(8, utils.ScalarGenerator()),
+	))
+
+	properties.TestingRun(t)
+}
+
+// rootByPrecompile computes the Merkle root over leaves one level at a time,
+// hashing each pair with the Poseidon precompile.
+func rootByPrecompile(t *testing.T, leaves []*big.Int) []byte {
+	level := make([][]byte, len(leaves))
+
+	for index, leaf := range leaves {
+		level[index] = leaf.FillBytes(make([]byte, PoseidonMerkleWordSize))
+	}
+
+	for len(level) > 1 {
+		next := make([][]byte, len(level)/2)
+
+		for index := range next {
+			node, err := (&poseidon.Poseidon{}).Run(append(append([]byte{}, level[2*index]...), level[2*index+1]...))
+			assert.Nil(t, err)
+
+			next[index] = node
+		}
+
+		level = next
+	}
+
+	return level[0]
+}
+
+func prepareLeaves(count int64, leaves []*big.Int) []byte {
+	input := big.NewInt(count).FillBytes(make([]byte, PoseidonMerkleWordSize))
+
+	for _, leaf := range leaves {
+		input = append(input, leaf.FillBytes(make([]byte, PoseidonMerkleWordSize))...)
+	}
+
+	return input
+}
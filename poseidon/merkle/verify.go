@@ -0,0 +1,124 @@
+package merkle
+
+import (
+	"encoding/binary"
+	"math/big"
+
+	"github.com/iden3/go-iden3-crypto/poseidon"
+	"github.com/privacy-ethereum/privacy-precompiles/common"
+	commonUtils "github.com/privacy-ethereum/privacy-precompiles/utils"
+)
+
+// PoseidonMerkleVerify implements a Poseidon Merkle inclusion proof
+// verification precompile for arbitrary field element leaves.
+//
+// It satisfies the common.Precompile interface and can be used in a generic
+// precompile execution framework, e.g. to check membership in a tree built
+// with PoseidonMerkleRoot in a single call.
+type PoseidonMerkleVerify struct{}
+
+// Name returns the human-readable name of the precompile.
+func (c *PoseidonMerkleVerify) Name() string {
+	return "PoseidonMerkleVerify"
+}
+
+// RequiredGas returns the gas cost of executing this precompile.
+//
+// Gas is calculated as:
+//
+//	PoseidonMerkleVerifyBaseGas + (depth * PoseidonMerklePerLevelGas)
+//
+// If the input is malformed, only the base gas is charged.
+func (c *PoseidonMerkleVerify) RequiredGas(input []byte) uint64 {
+	depth, err := parsePathLength(input)
+
+	if err != nil {
+		return PoseidonMerkleVerifyBaseGas
+	}
+
+	return PoseidonMerkleVerifyBaseGas + uint64(depth)*PoseidonMerklePerLevelGas
+}
+
+// Run executes the Poseidon Merkle verification precompile.
+//
+// The input is encoded as:
+//
+//	leaf || root || pathLen || (sibling_0 || dir_0) || ... || (sibling_{d-1} || dir_{d-1})
+//
+// Where:
+//   - leaf and root are big-endian field elements padded to
+//     PoseidonMerkleWordSize bytes.
+//   - pathLen is a PoseidonMerkleVerifyPathLengthSize-byte big-endian
+//     integer d, with 1 <= d <= PoseidonMerkleMaxDepth.
+//   - sibling_i is the sibling node at level i, starting from the leaf.
+//   - dir_i is a single byte: 0 if the node at level i is the left child,
+//     1 if it is the right child (sibling_i is hashed on the left).
+//
+// Returns []byte{1} if folding the path into leaf with poseidon(left, right)
+// yields root, []byte{0} otherwise.
+//
+// Returns an error if:
+//   - pathLen is out of bounds or does not match the input length.
+//   - Any direction byte is neither 0 nor 1.
+//   - Any field element is not inside the Poseidon field.
+func (c *PoseidonMerkleVerify) Run(input []byte) ([]byte, error) {
+	depth, err := parsePathLength(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	node, offset := commonUtils.ReadField(input, 0, PoseidonMerkleWordSize)
+	root, _ := commonUtils.ReadField(input, offset, PoseidonMerkleWordSize)
+	offset = PoseidonMerkleVerifyHeaderSize
+
+	for range depth {
+		var sibling *big.Int
+
+		sibling, offset = commonUtils.ReadField(input, offset, PoseidonMerkleWordSize)
+		direction := input[offset]
+		offset++
+
+		switch direction {
+		case 0:
+			node, err = poseidon.Hash([]*big.Int{node, sibling})
+		case 1:
+			node, err = poseidon.Hash([]*big.Int{sibling, node})
+		default:
+			return nil, ErrorPoseidonMerkleInvalidDirection
+		}
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if node.Cmp(root) == 0 {
+		return []byte{1}, nil
+	}
+
+	return []byte{0}, nil
+}
+
+// parsePathLength reads the path length of the PoseidonMerkleVerify input
+// and checks that it is within bounds and matches the input length.
+func parsePathLength(input []byte) (int, error) {
+	if len(input) < PoseidonMerkleVerifyHeaderSize {
+		return 0, ErrorPoseidonMerkleInvalidInputLength
+	}
+
+	depth := binary.BigEndian.Uint32(input[2*PoseidonMerkleWordSize:])
+
+	if depth == 0 || depth > PoseidonMerkleMaxDepth {
+		return 0, ErrorPoseidonMerkleInvalidInputLength
+	}
+
+	if len(input) != PoseidonMerkleVerifyHeaderSize+int(depth)*PoseidonMerkleVerifyLevelSize {
+		return 0, ErrorPoseidonMerkleInvalidInputLength
+	}
+
+	return int(depth), nil
+}
+
+// Ensure PoseidonMerkleVerify implements the common.Precompile interface.
+var _ common.Precompile = (*PoseidonMerkleVerify)(nil)
@@ -0,0 +1,183 @@
+package merkle
+
+import (
+	"encoding/binary"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/gen"
+	"github.com/leanovate/gopter/prop"
+	"github.com/privacy-ethereum/privacy-precompiles/babyjubjub/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPoseidonMerkleVerifyName(t *testing.T) {
+	precompile := PoseidonMerkleVerify{}
+
+	expected := "PoseidonMerkleVerify"
+	actual := precompile.Name()
+
+	assert.Equal(t, expected, actual)
+}
+
+func TestPoseidonMerkleVerify(t *testing.T) {
+	leaves := []*big.Int{big.NewInt(1), big.NewInt(2), big.NewInt(3), big.NewInt(4)}
+
+	rootBytes, err := (&PoseidonMerkleRoot{}).Run(prepareLeaves(4, leaves))
+	assert.Nil(t, err)
+
+	root := new(big.Int).SetBytes(rootBytes)
+	_, siblings := buildTree(leaves, 2)
+
+	tamperedSiblings := []*big.Int{siblings[0], new(big.Int).Add(siblings[1], big.NewInt(1))}
+
+	tests := []struct {
+		name          string
+		input         []byte
+		expected      []byte
+		expectedGas   uint64
+		expectedError error
+	}{
+		{
+			name:        "valid path",
+			input:       prepareVerifyInput(leaves[2], root, 2, siblings),
+			expected:    []byte{1},
+			expectedGas: PoseidonMerkleVerifyBaseGas + 2*PoseidonMerklePerLevelGas,
+		},
+		{
+			name:        "tampered sibling",
+			input:       prepareVerifyInput(leaves[2], root, 2, tamperedSiblings),
+			expected:    []byte{0},
+			expectedGas: PoseidonMerkleVerifyBaseGas + 2*PoseidonMerklePerLevelGas,
+		},
+		{
+			name:        "wrong directions",
+			input:       prepareVerifyInput(leaves[2], root, 1, siblings),
+			expected:    []byte{0},
+			expectedGas: PoseidonMerkleVerifyBaseGas + 2*PoseidonMerklePerLevelGas,
+		},
+		{
+			name:        "wrong leaf",
+			input:       prepareVerifyInput(leaves[3], root, 2, siblings),
+			expected:    []byte{0},
+			expectedGas: PoseidonMerkleVerifyBaseGas + 2*PoseidonMerklePerLevelGas,
+		},
+		{
+			name: "invalid direction byte",
+			input: func() []byte {
+				input := prepareVerifyInput(leaves[2], root, 2, siblings)
+				input[PoseidonMerkleVerifyHeaderSize+PoseidonMerkleWordSize] = 2
+
+				return input
+			}(),
+			expectedError: ErrorPoseidonMerkleInvalidDirection,
+		},
+		{
+			name: "path length larger than path",
+			input: func() []byte {
+				input := prepareVerifyInput(leaves[2], root, 2, siblings)
+				binary.BigEndian.PutUint32(input[2*PoseidonMerkleWordSize:], 3)
+
+				return input
+			}(),
+			expectedError: ErrorPoseidonMerkleInvalidInputLength,
+		},
+		{
+			name: "path length smaller than path",
+			input: func() []byte {
+				input := prepareVerifyInput(leaves[2], root, 2, siblings)
+				binary.BigEndian.PutUint32(input[2*PoseidonMerkleWordSize:], 1)
+
+				return input
+			}(),
+			expectedError: ErrorPoseidonMerkleInvalidInputLength,
+		},
+		{
+			name:          "zero path length",
+			input:         prepareVerifyInput(leaves[2], root, 0, nil),
+			expectedError: ErrorPoseidonMerkleInvalidInputLength,
+		},
+		{
+			name:          "path longer than max depth",
+			input:         prepareVerifyInput(leaves[2], root, 0, make([]*big.Int, PoseidonMerkleMaxDepth+1)),
+			expectedError: ErrorPoseidonMerkleInvalidInputLength,
+		},
+		{
+			name:          "truncated header",
+			input:         make([]byte, PoseidonMerkleVerifyHeaderSize-1),
+			expectedError: ErrorPoseidonMerkleInvalidInputLength,
+		},
+		{
+			name:          "sibling outside the field",
+			input:         prepareVerifyInput(leaves[2], root, 0, []*big.Int{new(big.Int).Add(utils.FieldPrime, big.NewInt(1))}),
+			expectedError: errors.New("inputs values not inside Finite Field"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			precompile := PoseidonMerkleVerify{}
+
+			actual, err := precompile.Run(tt.input)
+			gas := precompile.RequiredGas(tt.input)
+
+			if tt.expectedError != nil {
+				assert.NotNil(t, err)
+				assert.Equal(t, tt.expectedError, err)
+
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, tt.expected, actual)
+			assert.Equal(t, tt.expectedGas, gas)
+		})
+	}
+}
+
+func TestPoseidonMerkleVerifyProperties(t *testing.T) {
+	parameters := gopter.DefaultTestParameters()
+	properties := gopter.NewProperties(parameters)
+
+	properties.Property("Run accepts any leaf of a tree built by PoseidonMerkleRoot", prop.ForAll(
+		func(leaves []*big.Int, index int) bool {
+			rootBytes, err := (&PoseidonMerkleRoot{}).Run(prepareLeaves(int64(len(leaves)), leaves))
+
+			if err != nil {
+				return false
+			}
+
+			_, siblings := buildTree(leaves, index)
+
+			precompile := PoseidonMerkleVerify{}
+			result, err := precompile.Run(prepareVerifyInput(leaves[index], new(big.Int).SetBytes(rootBytes), uint32(index), siblings))
+
+			return err == nil && result[0] == 1
+		},
+		gen.SliceOfN(8, utils.ScalarGenerator()),
+		gen.IntRange(0, 7),
+	))
+
+	properties.TestingRun(t)
+}
+
+// prepareVerifyInput encodes a PoseidonMerkleVerify input. Bit i of
+// directions is used as the direction byte of level i.
+func prepareVerifyInput(leaf, root *big.Int, directions uint32, siblings []*big.Int) []byte {
+	input := leaf.FillBytes(make([]byte, PoseidonMerkleWordSize))
+	input = append(input, root.FillBytes(make([]byte, PoseidonMerkleWordSize))...)
+	input = binary.BigEndian.AppendUint32(input, uint32(len(siblings)))
+
+	for level, sibling := range siblings {
+		if sibling == nil {
+			sibling = big.NewInt(0)
+		}
+
+		input = append(input, sibling.FillBytes(make([]byte, PoseidonMerkleWordSize))...)
+		input = append(input, byte(directions>>level&1))
+	}
+
+	return input
+}
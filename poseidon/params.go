@@ -1,6 +1,9 @@
 package poseidon
 
-import "errors"
+import (
+	"errors"
+	"math/big"
+)
 
 // Poseidon hash precompile constants
 const (
@@ -11,6 +14,11 @@ const (
 	// to 32 bytes.
 	PoseidonInputWordSize = 32
 
+	// PoseidonOutputSize defines the fixed byte length of the output
+	// produced by the Poseidon precompile: a single field element encoded
+	// as a 32-byte big-endian word.
+	PoseidonOutputSize = PoseidonInputWordSize
+
 	// PoseidonMaxParams defines the maximum number of field elements
 	// accepted by the Poseidon precompile in a single invocation.
 	PoseidonMaxParams = 16
@@ -26,8 +34,43 @@ const (
 	//
 	//	PoseidonBaseGas + (number_of_words * PoseidonPerWordGas)
 	PoseidonPerWordGas uint64 = 5400
+
+	// PoseidonFramedLengthSize defines the byte length of the big-endian
+	// element count that prefixes the input of the framed Poseidon
+	// precompile.
+	PoseidonFramedLengthSize = 4
+
+	// PoseidonContinueStateSize defines the byte length of the prior digest
+	// that prefixes the input of the PoseidonContinue precompile.
+	PoseidonContinueStateSize = PoseidonInputWordSize
+
+	// PoseidonContinueDomainHex is the hexadecimal encoding of
+	// PoseidonContinueDomain: the ASCII string "poseidon.continue" read as
+	// a big-endian integer.
+	PoseidonContinueDomainHex = "706f736569646f6e2e636f6e74696e7565"
+
+	// PoseidonArityPrefixSize defines the byte length of the arity selector
+	// that prefixes the input of the PoseidonWithArity precompile.
+	PoseidonArityPrefixSize = 1
+
+	// PoseidonMinArity defines the smallest arity accepted by the
+	// PoseidonWithArity precompile, the iden3 parameter set of width t = 2.
+	PoseidonMinArity = 1
+
+	// PoseidonMaxArity defines the largest arity accepted by the
+	// PoseidonWithArity precompile, the iden3 parameter set of width t = 17.
+	PoseidonMaxArity = PoseidonMaxParams
 )
 
+// PoseidonContinueDomain is the initial capacity element of every
+// PoseidonContinue permutation.
+//
+// Poseidon and all other hashes of this package start from a zero capacity,
+// so a continued hash can never equal a fresh hash of the same words. The
+// value is reserved for continuation: the domain separated hash precompile
+// rejects it with ErrorPoseidonReservedDomain.
+var PoseidonContinueDomain, _ = new(big.Int).SetString(PoseidonContinueDomainHex, 16)
+
 var (
 	// ErrorPoseidonInvalidInputLength is returned when the input to the
 	// Poseidon precompile does not conform to the expected format.
@@ -36,5 +79,20 @@ var (
 	//   - The input length is zero.
 	//   - The input length is not a multiple of PoseidonInputWordSize.
 	//   - The number of input words exceeds PoseidonMaxParams.
+	//   - The element count prefix of a framed input does not match the
+	//     number of words that follow it.
+	//   - A PoseidonContinue input carries no words after the prior digest.
+	//   - A PoseidonWithArity input selects an unsupported arity or carries
+	//     more words than the selected arity.
+	//   - A PoseidonMerkleRoot leaf count is not a power of two, exceeds
+	//     the maximum, or does not match the leaves provided.
 	ErrorPoseidonInvalidInputLength = errors.New("invalid input length")
+
+	// ErrorPoseidonInputNotInField is returned when an input word is equal
+	// to or greater than the BN254 scalar field modulus.
+	ErrorPoseidonInputNotInField = errors.New("inputs values not inside Finite Field")
+
+	// ErrorPoseidonReservedDomain is returned by the domain separated hash
+	// precompile when the requested domain is PoseidonContinueDomain.
+	ErrorPoseidonReservedDomain = errors.New("reserved domain")
 )
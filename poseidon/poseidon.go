@@ -4,6 +4,7 @@ import (
 	"math/big"
 
 	"github.com/iden3/go-iden3-crypto/poseidon"
+	"github.com/iden3/go-iden3-crypto/utils"
 	"github.com/privacy-ethereum/privacy-precompiles/common"
 	commonUtils "github.com/privacy-ethereum/privacy-precompiles/utils"
 )
@@ -27,12 +28,39 @@ func (c *Poseidon) Name() string {
 //	PoseidonBaseGas + (number_of_words * PoseidonPerWordGas)
 //
 // Where each word is a 32-byte field element.
+//
+// Inputs rejected by Run for their length, i.e. empty inputs, inputs that
+// are not a multiple of PoseidonInputWordSize and inputs of more than
+// PoseidonMaxParams words, cost 0.
 func (c *Poseidon) RequiredGas(input []byte) uint64 {
+	if len(input) == 0 ||
+		len(input)%PoseidonInputWordSize != 0 ||
+		len(input)/PoseidonInputWordSize > PoseidonMaxParams {
+		return 0
+	}
+
+	return InputGas(input)
+}
+
+// InputGas returns the Poseidon gas cost of hashing input, without
+// validating its length:
+//
+//	PoseidonBaseGas + (number_of_words * PoseidonPerWordGas)
+//
+// Where a trailing partial word is charged as a full word. Precompiles
+// built on Poseidon use it to price their input.
+func InputGas(input []byte) uint64 {
 	return uint64(len(input)+(PoseidonInputWordSize-1))/
 		PoseidonInputWordSize*PoseidonPerWordGas +
 		PoseidonBaseGas
 }
 
+// MaxOutputSize returns the byte length of the hash returned by Run,
+// which is PoseidonOutputSize regardless of the input.
+func (c *Poseidon) MaxOutputSize(input []byte) int {
+	return PoseidonOutputSize
+}
+
 // Run executes the Poseidon hash precompile.
 //
 // The input must consist of N field elements encoded as:
@@ -46,7 +74,8 @@ func (c *Poseidon) RequiredGas(input []byte) uint64 {
 //
 // Run performs the following steps:
 //  1. Validates input length and parameter bounds.
-//  2. Parses each field element using commonUtils.ReadField.
+//  2. Parses each field element using commonUtils.ReadField and checks it is
+//     below the BN254 scalar field modulus.
 //  3. Computes the Poseidon hash over the parsed elements.
 //  4. Returns the resulting field element encoded as a 32-byte big-endian value.
 //
@@ -54,8 +83,31 @@ func (c *Poseidon) RequiredGas(input []byte) uint64 {
 //   - The input length is zero.
 //   - The input length is not a multiple of PoseidonInputWordSize.
 //   - The number of elements exceeds PoseidonMaxParams.
+//   - Any element is not inside the field (ErrorPoseidonInputNotInField).
 //   - The underlying Poseidon hash function returns an error.
 func (c *Poseidon) Run(input []byte) ([]byte, error) {
+	elements, err := readElements(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	hash, err := poseidon.Hash(elements)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return hash.FillBytes(make([]byte, PoseidonInputWordSize)), nil
+}
+
+// readElements parses input as 1 to PoseidonMaxParams big-endian field
+// elements of PoseidonInputWordSize bytes each.
+//
+// Returns ErrorPoseidonInvalidInputLength if the input is empty, unaligned
+// or holds too many words, and ErrorPoseidonInputNotInField if any element
+// is not below the BN254 scalar field modulus.
+func readElements(input []byte) ([]*big.Int, error) {
 	if len(input) == 0 || len(input)%PoseidonInputWordSize != 0 {
 		return nil, ErrorPoseidonInvalidInputLength
 	}
@@ -75,17 +127,18 @@ func (c *Poseidon) Run(input []byte) ([]byte, error) {
 			PoseidonInputWordSize,
 		)
 
-		elements[index] = element
-	}
-
-	hash, err := poseidon.Hash(elements)
+		if !utils.CheckBigIntInField(element) {
+			return nil, ErrorPoseidonInputNotInField
+		}
 
-	if err != nil {
-		return nil, err
+		elements[index] = element
 	}
 
-	return hash.FillBytes(make([]byte, PoseidonInputWordSize)), nil
+	return elements, nil
 }
 
 // Ensure Poseidon implements the common.Precompile interface.
 var _ common.Precompile = (*Poseidon)(nil)
+
+// Ensure Poseidon implements the common.OutputSizer interface.
+var _ common.OutputSizer = (*Poseidon)(nil)
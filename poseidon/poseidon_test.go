@@ -6,10 +6,13 @@ import (
 	"math/big"
 	"testing"
 
+	"github.com/iden3/go-iden3-crypto/constants"
 	"github.com/leanovate/gopter"
 	"github.com/leanovate/gopter/gen"
 	"github.com/leanovate/gopter/prop"
 	"github.com/privacy-ethereum/privacy-precompiles/babyjubjub/utils"
+	"github.com/privacy-ethereum/privacy-precompiles/common"
+	"github.com/privacy-ethereum/privacy-precompiles/internal/commontest"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -22,6 +25,14 @@ func TestPoseidonName(t *testing.T) {
 	assert.Equal(t, expected, actual)
 }
 
+func TestPoseidonMaxOutputSize(t *testing.T) {
+	precompile := Poseidon{}
+
+	var sizer common.OutputSizer = &precompile
+
+	assert.Equal(t, 32, sizer.MaxOutputSize(nil))
+}
+
 func TestPoseidonHash(t *testing.T) {
 	tests := []struct {
 		name          string
@@ -59,7 +70,15 @@ func TestPoseidonHash(t *testing.T) {
 				0x97, 0x81, 0x6a, 0x91, 0x68, 0x71, 0xca, 0x8d,
 				0x3c, 0x20, 0x8c, 0x16, 0xd8, 0x7c, 0xfd, 0x47,
 			},
-			expectedError: errors.New("inputs values not inside Finite Field"),
+			expectedError: ErrorPoseidonInputNotInField,
+		},
+		{
+			name: "poseidon hash of word equal to the field modulus",
+			input: append(
+				make([]byte, PoseidonInputWordSize),
+				constants.Q.FillBytes(make([]byte, PoseidonInputWordSize))...,
+			),
+			expectedError: ErrorPoseidonInputNotInField,
 		},
 	}
 
@@ -72,7 +91,7 @@ func TestPoseidonHash(t *testing.T) {
 
 			if tt.expectedError != nil {
 				assert.NotNil(t, err)
-				assert.Equal(t, tt.expectedError, err)
+				assert.True(t, errors.Is(err, tt.expectedError))
 
 				return
 			}
@@ -84,6 +103,41 @@ func TestPoseidonHash(t *testing.T) {
 	}
 }
 
+func TestPoseidonRequiredGasInvalidLength(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []byte
+	}{
+		{
+			name:  "empty input",
+			input: []byte{},
+		},
+		{
+			name:  "partial word",
+			input: make([]byte, PoseidonInputWordSize-1),
+		},
+		{
+			name:  "trailing partial word",
+			input: make([]byte, PoseidonInputWordSize+1),
+		},
+		{
+			name:  "more words than PoseidonMaxParams",
+			input: make([]byte, PoseidonInputWordSize*(PoseidonMaxParams+1)),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			precompile := Poseidon{}
+
+			_, err := precompile.Run(tt.input)
+
+			assert.True(t, errors.Is(err, ErrorPoseidonInvalidInputLength))
+			assert.Equal(t, uint64(0), precompile.RequiredGas(tt.input))
+		})
+	}
+}
+
 func TestRunProperties(t *testing.T) {
 	parameters := gopter.DefaultTestParameters()
 	properties := gopter.NewProperties(parameters)
@@ -143,6 +197,10 @@ func TestRunProperties(t *testing.T) {
 
 				gas := precompile.RequiredGas(input)
 
+				if words > PoseidonMaxParams {
+					return gas == 0
+				}
+
 				expected :=
 					uint64(words)*PoseidonPerWordGas +
 						PoseidonBaseGas
@@ -167,3 +225,30 @@ func prepareInput(scalars []*big.Int) []byte {
 
 	return input
 }
+
+func BenchmarkPoseidon(b *testing.B) {
+	scalars := make([]*big.Int, PoseidonMaxParams)
+
+	for i := range scalars {
+		scalar, _ := utils.ScalarGenerator().Sample()
+		scalars[i] = scalar.(*big.Int)
+	}
+
+	commontest.BenchmarkGasRatio(b, &Poseidon{}, prepareInput(scalars))
+}
+
+func FuzzPoseidon(f *testing.F) {
+	f.Add(make([]byte, PoseidonInputWordSize))
+	f.Add(prepareInput([]*big.Int{big.NewInt(1), big.NewInt(2)}))
+	f.Add(constants.Q.FillBytes(make([]byte, PoseidonInputWordSize)))
+
+	f.Fuzz(func(t *testing.T, input []byte) {
+		precompile := Poseidon{}
+
+		actual, err := precompile.Run(input)
+
+		if err == nil {
+			assert.LessOrEqual(t, len(actual), precompile.MaxOutputSize(input))
+		}
+	})
+}
@@ -0,0 +1,15 @@
+package shuffle
+
+import "github.com/privacy-ethereum/privacy-precompiles/poseidon"
+
+// Poseidon shuffle seed precompile constants
+const (
+	// PoseidonShuffleSeedMaxCommitments defines the maximum number of
+	// commitments accepted by the shuffle seed precompile in a single
+	// invocation. It is bounded by the Poseidon arity.
+	PoseidonShuffleSeedMaxCommitments = poseidon.PoseidonMaxParams
+
+	// PoseidonShuffleSeedOutputSize defines the fixed byte length of the
+	// seed returned by the shuffle seed precompile.
+	PoseidonShuffleSeedOutputSize = poseidon.PoseidonInputWordSize
+)
@@ -0,0 +1,68 @@
+package shuffle
+
+import (
+	"math/big"
+
+	"github.com/iden3/go-iden3-crypto/babyjub"
+	"github.com/privacy-ethereum/privacy-precompiles/common"
+	"github.com/privacy-ethereum/privacy-precompiles/poseidon"
+)
+
+// PoseidonShuffleSeed implements a precompile deriving a deterministic
+// shuffle seed from a set of commitments.
+//
+// It satisfies the common.Precompile interface and can be used in a generic
+// precompile execution framework. The seed is usable as a Fiat-Shamir
+// challenge for a shuffle proof, binding the shuffle randomness to the
+// committed data.
+type PoseidonShuffleSeed struct{}
+
+// Name returns the human-readable name of the precompile.
+func (c *PoseidonShuffleSeed) Name() string {
+	return "PoseidonShuffleSeed"
+}
+
+// RequiredGas returns the gas cost of executing this precompile.
+//
+// The cost is identical to the Poseidon precompile over the same input,
+// since the final reduction is negligible.
+func (c *PoseidonShuffleSeed) RequiredGas(input []byte) uint64 {
+	return poseidon.InputGas(input)
+}
+
+// Run executes the shuffle seed precompile.
+//
+// The input must consist of N commitments encoded as:
+//
+//	c1 || c2 || ... || cN
+//
+// Where:
+//   - Each commitment is a big-endian field element padded to
+//     poseidon.PoseidonInputWordSize bytes.
+//   - 1 <= N <= PoseidonShuffleSeedMaxCommitments.
+//
+// The seed is computed as:
+//
+//	poseidon(c1, ..., cN) mod babyjub.SubOrder
+//
+// and returned as a 32-byte big-endian value.
+//
+// Returns an error if:
+//   - The input length is zero or not a multiple of the word size.
+//   - The number of commitments exceeds PoseidonShuffleSeedMaxCommitments.
+//   - Any commitment is not inside the Poseidon field.
+func (c *PoseidonShuffleSeed) Run(input []byte) ([]byte, error) {
+	hash, err := (&poseidon.Poseidon{}).Run(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	seed := new(big.Int).SetBytes(hash)
+	seed.Mod(seed, babyjub.SubOrder)
+
+	return seed.FillBytes(make([]byte, PoseidonShuffleSeedOutputSize)), nil
+}
+
+// Ensure PoseidonShuffleSeed implements the common.Precompile interface.
+var _ common.Precompile = (*PoseidonShuffleSeed)(nil)
@@ -0,0 +1,136 @@
+package shuffle
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	"github.com/iden3/go-iden3-crypto/babyjub"
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/gen"
+	"github.com/leanovate/gopter/prop"
+	"github.com/privacy-ethereum/privacy-precompiles/babyjubjub/utils"
+	"github.com/privacy-ethereum/privacy-precompiles/poseidon"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPoseidonShuffleSeedName(t *testing.T) {
+	precompile := PoseidonShuffleSeed{}
+
+	expected := "PoseidonShuffleSeed"
+	actual := precompile.Name()
+
+	assert.Equal(t, expected, actual)
+}
+
+func TestPoseidonShuffleSeed(t *testing.T) {
+	tests := []struct {
+		name          string
+		input         []byte
+		expectedGas   uint64
+		expectedError error
+	}{
+		{
+			name:        "single commitment",
+			input:       prepareInput([]*big.Int{big.NewInt(1)}),
+			expectedGas: poseidon.PoseidonBaseGas + poseidon.PoseidonPerWordGas,
+		},
+		{
+			name:        "maximum commitments",
+			input:       make([]byte, PoseidonShuffleSeedMaxCommitments*poseidon.PoseidonInputWordSize),
+			expectedGas: poseidon.PoseidonBaseGas + PoseidonShuffleSeedMaxCommitments*poseidon.PoseidonPerWordGas,
+		},
+		{
+			name:          "empty input",
+			input:         []byte{},
+			expectedError: poseidon.ErrorPoseidonInvalidInputLength,
+		},
+		{
+			name:          "unaligned input",
+			input:         make([]byte, poseidon.PoseidonInputWordSize+1),
+			expectedError: poseidon.ErrorPoseidonInvalidInputLength,
+		},
+		{
+			name:          "too many commitments",
+			input:         make([]byte, (PoseidonShuffleSeedMaxCommitments+1)*poseidon.PoseidonInputWordSize),
+			expectedError: poseidon.ErrorPoseidonInvalidInputLength,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			precompile := PoseidonShuffleSeed{}
+
+			actual, err := precompile.Run(tt.input)
+			gas := precompile.RequiredGas(tt.input)
+
+			if tt.expectedError != nil {
+				assert.NotNil(t, err)
+				assert.Equal(t, tt.expectedError, err)
+
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Len(t, actual, PoseidonShuffleSeedOutputSize)
+			assert.Equal(t, -1, new(big.Int).SetBytes(actual).Cmp(babyjub.SubOrder))
+			assert.Equal(t, tt.expectedGas, gas)
+		})
+	}
+}
+
+func TestRunProperties(t *testing.T) {
+	parameters := gopter.DefaultTestParameters()
+	properties := gopter.NewProperties(parameters)
+
+	properties.Property("Run is deterministic and returns a scalar below SubOrder", prop.ForAll(
+		func(commitments []*big.Int) bool {
+			precompile := PoseidonShuffleSeed{}
+			input := prepareInput(commitments)
+
+			seed1, err1 := precompile.Run(input)
+			seed2, err2 := precompile.Run(input)
+
+			if err1 != nil || err2 != nil {
+				return false
+			}
+
+			return bytes.Equal(seed1, seed2) &&
+				new(big.Int).SetBytes(seed1).Cmp(babyjub.SubOrder) < 0
+		},
+		gen.SliceOfN(PoseidonShuffleSeedMaxCommitments, utils.ScalarGenerator()),
+	))
+
+	properties.Property("Seed changes if any commitment changes", prop.ForAll(
+		func(commitments []*big.Int, index int) bool {
+			precompile := PoseidonShuffleSeed{}
+
+			seed1, err1 := precompile.Run(prepareInput(commitments))
+
+			changed := append([]*big.Int{}, commitments...)
+			changed[index] = new(big.Int).Add(commitments[index], big.NewInt(1))
+
+			seed2, err2 := precompile.Run(prepareInput(changed))
+
+			if err1 != nil || err2 != nil {
+				return false
+			}
+
+			return !bytes.Equal(seed1, seed2)
+		},
+		gen.SliceOfN(4, utils.ScalarGenerator()),
+		gen.IntRange(0, 3),
+	))
+
+	properties.TestingRun(t)
+}
+
+func prepareInput(commitments []*big.Int) []byte {
+	input := make([]byte, 0, len(commitments)*poseidon.PoseidonInputWordSize)
+
+	for _, commitment := range commitments {
+		input = append(input, commitment.FillBytes(make([]byte, poseidon.PoseidonInputWordSize))...)
+	}
+
+	return input
+}
@@ -0,0 +1,25 @@
+package sponge
+
+import (
+	"errors"
+
+	"github.com/privacy-ethereum/privacy-precompiles/poseidon"
+)
+
+// Poseidon sponge precompile constants
+const (
+	// PoseidonSpongeRate defines the number of words absorbed per permutation.
+	// The sponge uses the width 16 Poseidon permutation with a single
+	// capacity element, leaving 15 rate elements.
+	PoseidonSpongeRate = poseidon.PoseidonMaxParams - 1
+
+	// PoseidonSpongeOutputSize defines the fixed byte length of the digest
+	// returned by the sponge precompile.
+	PoseidonSpongeOutputSize = poseidon.PoseidonInputWordSize
+)
+
+var (
+	// ErrorPoseidonSpongeNotInField is returned when any input word is not
+	// inside the Poseidon finite field.
+	ErrorPoseidonSpongeNotInField = errors.New("inputs values not inside Finite Field")
+)
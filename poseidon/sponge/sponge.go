@@ -0,0 +1,125 @@
+package sponge
+
+import (
+	"math/big"
+
+	"github.com/iden3/go-iden3-crypto/constants"
+	iden3Poseidon "github.com/iden3/go-iden3-crypto/poseidon"
+	iden3Utils "github.com/iden3/go-iden3-crypto/utils"
+	"github.com/privacy-ethereum/privacy-precompiles/common"
+	"github.com/privacy-ethereum/privacy-precompiles/poseidon"
+	commonUtils "github.com/privacy-ethereum/privacy-precompiles/utils"
+)
+
+// PoseidonSponge implements a Poseidon sponge hash precompile for inputs of
+// arbitrary length.
+//
+// It satisfies the common.Precompile interface and can be used in a generic
+// precompile execution framework to hash payloads larger than
+// poseidon.PoseidonMaxParams words.
+//
+// The sponge runs over the width 16 Poseidon permutation with one capacity
+// element c and PoseidonSpongeRate rate elements r_1..r_15:
+//
+//  1. The state starts as all zeros.
+//  2. The input words are followed by a single word 1 and then zero words
+//     until the length is a multiple of PoseidonSpongeRate (10* padding).
+//  3. Each chunk of PoseidonSpongeRate words is added to r_1..r_15 and the
+//     state is permuted.
+//  4. The digest is r_1 of the final state.
+//
+// Because of the padding, the digest differs from the Poseidon precompile
+// output for any input, including inputs of at most poseidon.PoseidonMaxParams
+// words.
+type PoseidonSponge struct{}
+
+// Name returns the human-readable name of the precompile.
+func (c *PoseidonSponge) Name() string {
+	return "PoseidonSponge"
+}
+
+// RequiredGas returns the gas cost of executing this precompile.
+//
+// Gas is calculated as:
+//
+//	PoseidonBaseGas + (number_of_words * PoseidonPerWordGas)
+//
+// Where each word is a 32-byte field element.
+func (c *PoseidonSponge) RequiredGas(input []byte) uint64 {
+	return poseidon.InputGas(input)
+}
+
+// Run executes the Poseidon sponge precompile.
+//
+// The input must consist of N >= 1 words encoded as:
+//
+//	e1 || e2 || ... || eN
+//
+// Where each word is a big-endian field element padded to
+// poseidon.PoseidonInputWordSize bytes.
+//
+// The digest is returned as a 32-byte big-endian value.
+//
+// Returns an error if:
+//   - The input length is zero or not a multiple of poseidon.PoseidonInputWordSize.
+//   - Any word is not inside the Poseidon field.
+func (c *PoseidonSponge) Run(input []byte) ([]byte, error) {
+	if len(input) == 0 || len(input)%poseidon.PoseidonInputWordSize != 0 {
+		return nil, poseidon.ErrorPoseidonInvalidInputLength
+	}
+
+	numberOfWords := len(input) / poseidon.PoseidonInputWordSize
+	numberOfChunks := numberOfWords/PoseidonSpongeRate + 1
+
+	state := make([]*big.Int, PoseidonSpongeRate+1)
+
+	for index := range state {
+		state[index] = big.NewInt(0)
+	}
+
+	for chunk := range numberOfChunks {
+		for position := range PoseidonSpongeRate {
+			word, err := readPaddedWord(input, chunk*PoseidonSpongeRate+position, numberOfWords)
+
+			if err != nil {
+				return nil, err
+			}
+
+			state[position+1].Add(state[position+1], word)
+			state[position+1].Mod(state[position+1], constants.Q)
+		}
+
+		permuted, err := iden3Poseidon.HashWithStateEx(state[1:], state[0], len(state))
+
+		if err != nil {
+			return nil, err
+		}
+
+		state = permuted
+	}
+
+	return state[1].FillBytes(make([]byte, PoseidonSpongeOutputSize)), nil
+}
+
+// readPaddedWord returns the word at index of the padded input: the input
+// words, followed by a single word 1 and zero words.
+func readPaddedWord(input []byte, index, numberOfWords int) (*big.Int, error) {
+	if index == numberOfWords {
+		return big.NewInt(1), nil
+	}
+
+	if index > numberOfWords {
+		return big.NewInt(0), nil
+	}
+
+	word, _ := commonUtils.ReadField(input, index*poseidon.PoseidonInputWordSize, poseidon.PoseidonInputWordSize)
+
+	if !iden3Utils.CheckBigIntInField(word) {
+		return nil, ErrorPoseidonSpongeNotInField
+	}
+
+	return word, nil
+}
+
+// Ensure PoseidonSponge implements the common.Precompile interface.
+var _ common.Precompile = (*PoseidonSponge)(nil)
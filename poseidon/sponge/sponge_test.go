@@ -0,0 +1,169 @@
+package sponge
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/gen"
+	"github.com/leanovate/gopter/prop"
+	"github.com/privacy-ethereum/privacy-precompiles/babyjubjub/utils"
+	"github.com/privacy-ethereum/privacy-precompiles/poseidon"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPoseidonSpongeName(t *testing.T) {
+	precompile := PoseidonSponge{}
+
+	expected := "PoseidonSponge"
+	actual := precompile.Name()
+
+	assert.Equal(t, expected, actual)
+}
+
+func TestPoseidonSponge(t *testing.T) {
+	tests := []struct {
+		name          string
+		input         []byte
+		expectedGas   uint64
+		expectedError error
+	}{
+		{
+			name:        "single word",
+			input:       prepareInput(1),
+			expectedGas: poseidon.PoseidonBaseGas + poseidon.PoseidonPerWordGas,
+		},
+		{
+			name:        "exactly one rate chunk",
+			input:       prepareInput(PoseidonSpongeRate),
+			expectedGas: poseidon.PoseidonBaseGas + PoseidonSpongeRate*poseidon.PoseidonPerWordGas,
+		},
+		{
+			name:        "more words than the Poseidon precompile accepts",
+			input:       prepareInput(100),
+			expectedGas: poseidon.PoseidonBaseGas + 100*poseidon.PoseidonPerWordGas,
+		},
+		{
+			name: "word not in field",
+			input: append(
+				prepareInput(20),
+				utils.FieldPrime.FillBytes(make([]byte, poseidon.PoseidonInputWordSize))...,
+			),
+			expectedError: ErrorPoseidonSpongeNotInField,
+		},
+		{
+			name:          "unaligned input",
+			input:         prepareInput(2)[1:],
+			expectedError: poseidon.ErrorPoseidonInvalidInputLength,
+		},
+		{
+			name:          "empty input",
+			input:         []byte{},
+			expectedError: poseidon.ErrorPoseidonInvalidInputLength,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			precompile := PoseidonSponge{}
+
+			actual, err := precompile.Run(tt.input)
+			gas := precompile.RequiredGas(tt.input)
+
+			if tt.expectedError != nil {
+				assert.NotNil(t, err)
+				assert.Equal(t, tt.expectedError, err)
+
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Len(t, actual, PoseidonSpongeOutputSize)
+			assert.Equal(t, tt.expectedGas, gas)
+		})
+	}
+}
+
+func TestPoseidonSpongeDiffersFromPoseidon(t *testing.T) {
+	for numberOfWords := 1; numberOfWords <= poseidon.PoseidonMaxParams; numberOfWords++ {
+		input := prepareInput(numberOfWords)
+
+		sponge, err1 := (&PoseidonSponge{}).Run(input)
+		fixed, err2 := (&poseidon.Poseidon{}).Run(input)
+
+		assert.Nil(t, err1)
+		assert.Nil(t, err2)
+		assert.NotEqual(t, fixed, sponge)
+	}
+}
+
+func TestRunProperties(t *testing.T) {
+	parameters := gopter.DefaultTestParameters()
+	properties := gopter.NewProperties(parameters)
+
+	properties.Property("Run is deterministic", prop.ForAll(
+		func(words []*big.Int) bool {
+			precompile := PoseidonSponge{}
+
+			digest1, err1 := precompile.Run(prepareWords(words))
+			digest2, err2 := precompile.Run(prepareWords(words))
+
+			return err1 == nil && err2 == nil && bytes.Equal(digest1, digest2)
+		},
+		gen.SliceOfN(40, utils.ScalarGenerator()),
+	))
+
+	properties.Property("Run is chainable", prop.ForAll(
+		func(first, second []*big.Int) bool {
+			precompile := PoseidonSponge{}
+
+			digest, err := precompile.Run(prepareWords(first))
+
+			if err != nil {
+				return false
+			}
+
+			chained1, err1 := precompile.Run(append(append([]byte{}, digest...), prepareWords(second)...))
+			chained2, err2 := precompile.Run(append(append([]byte{}, digest...), prepareWords(second)...))
+
+			return err1 == nil && err2 == nil && bytes.Equal(chained1, chained2) && !bytes.Equal(chained1, digest)
+		},
+		gen.SliceOfN(20, utils.ScalarGenerator()),
+		gen.SliceOfN(20, utils.ScalarGenerator()),
+	))
+
+	properties.Property("Appending a zero word changes the digest", prop.ForAll(
+		func(words []*big.Int) bool {
+			precompile := PoseidonSponge{}
+
+			digest1, err1 := precompile.Run(prepareWords(words))
+			digest2, err2 := precompile.Run(prepareWords(append(words, big.NewInt(0))))
+
+			return err1 == nil && err2 == nil && !bytes.Equal(digest1, digest2)
+		},
+		gen.SliceOfN(PoseidonSpongeRate-1, utils.ScalarGenerator()),
+	))
+
+	properties.TestingRun(t)
+}
+
+func prepareInput(numberOfWords int) []byte {
+	words := make([]*big.Int, numberOfWords)
+
+	for index := range words {
+		words[index] = big.NewInt(int64(index + 1))
+	}
+
+	return prepareWords(words)
+}
+
+func prepareWords(words []*big.Int) []byte {
+	input := make([]byte, 0, len(words)*poseidon.PoseidonInputWordSize)
+
+	for _, word := range words {
+		input = append(input, word.FillBytes(make([]byte, poseidon.PoseidonInputWordSize))...)
+	}
+
+	return input
+}
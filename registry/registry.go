@@ -0,0 +1,39 @@
+// Package registry wires the precompiles of this module into a
+// common.Registry.
+package registry
+
+import (
+	"github.com/privacy-ethereum/privacy-precompiles/babyjubjub/add"
+	"github.com/privacy-ethereum/privacy-precompiles/babyjubjub/eddsa"
+	"github.com/privacy-ethereum/privacy-precompiles/babyjubjub/mul"
+	"github.com/privacy-ethereum/privacy-precompiles/babyjubjub/validation"
+	"github.com/privacy-ethereum/privacy-precompiles/common"
+	"github.com/privacy-ethereum/privacy-precompiles/poseidon"
+	"github.com/privacy-ethereum/privacy-precompiles/verifier/groth16"
+)
+
+// DefaultRegistry returns a registry holding the BabyJubJub add, mul,
+// point validation and EdDSA precompiles, Poseidon and the BN254 Groth16
+// verifier, at the addresses defined in the common package.
+func DefaultRegistry() *common.Registry {
+	precompiles := []struct {
+		address    uint64
+		precompile common.Precompile
+	}{
+		{common.BabyJubJubAddAddress, &add.BabyJubJubCurveAdd{}},
+		{common.BabyJubJubMulAddress, &mul.BabyJubJubCurveMul{}},
+		{common.BabyJubJubValidateAddress, &validation.BabyJubJubCurveValidatePoint{}},
+		{common.BabyJubJubEdDSAAddress, &eddsa.BabyJubJubCurveEdDSAVerify{}},
+		{common.PoseidonAddress, &poseidon.Poseidon{}},
+		{common.Groth16BN254Address, groth16.NewGroth16BN254Verify()},
+	}
+
+	registry := common.NewRegistry()
+
+	for _, entry := range precompiles {
+		// Addresses and names above are distinct, so registration cannot fail.
+		_ = registry.Register(common.Address(entry.address), entry.precompile)
+	}
+
+	return registry
+}
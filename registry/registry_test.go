@@ -0,0 +1,64 @@
+package registry
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/privacy-ethereum/privacy-precompiles/babyjubjub/eddsa"
+	"github.com/privacy-ethereum/privacy-precompiles/common"
+	"github.com/privacy-ethereum/privacy-precompiles/poseidon"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDefaultRegistry(t *testing.T) {
+	registry := DefaultRegistry()
+
+	tests := []struct {
+		name    string
+		address uint64
+	}{
+		{"BabyJubJubCurveAdd", common.BabyJubJubAddAddress},
+		{"BabyJubJubMul", common.BabyJubJubMulAddress},
+		{"BabyJubJubCurveValidatePoint", common.BabyJubJubValidateAddress},
+		{"BabyJubJubEdDSAVerify", common.BabyJubJubEdDSAAddress},
+		{"Poseidon", common.PoseidonAddress},
+		{"bn254Groth16Verify", common.Groth16BN254Address},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			byName, ok := registry.ByName(tt.name)
+			assert.True(t, ok)
+
+			byAddress, ok := registry.ByAddress(common.Address(tt.address))
+			assert.True(t, ok)
+			assert.Same(t, byName, byAddress)
+		})
+	}
+}
+
+func TestDefaultRegistryRunErrors(t *testing.T) {
+	registry := DefaultRegistry()
+
+	tests := []struct {
+		name          string
+		address       uint64
+		expectedName  string
+		expectedError error
+	}{
+		{"Poseidon", common.PoseidonAddress, "Poseidon", poseidon.ErrorPoseidonInvalidInputLength},
+		{"EdDSA", common.BabyJubJubEdDSAAddress, "BabyJubJubEdDSAVerify", eddsa.ErrorBabyJubJubCurveEdDSAVerifyInvalidInputLength},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := registry.Run(common.Address(tt.address), []byte{1})
+
+			var precompileError *common.PrecompileError
+
+			assert.True(t, errors.As(err, &precompileError))
+			assert.Equal(t, tt.expectedName, precompileError.Name)
+			assert.True(t, errors.Is(err, tt.expectedError))
+		})
+	}
+}
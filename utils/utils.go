@@ -1,6 +1,9 @@
 package utils
 
-import "math/big"
+import (
+	"crypto/subtle"
+	"math/big"
+)
 
 // safeSlice returns a subslice of data from start (inclusive) to end (exclusive)
 // in a panic-free manner.
@@ -53,3 +56,63 @@ func ReadField(input []byte, offset, size int) (*big.Int, int) {
 
 	return new(big.Int).SetBytes(slice), offset + size
 }
+
+// ReadFields returns count consecutive field elements of size bytes each,
+// starting at offset, along with the next unread offset.
+//
+// The boolean result is false if count is negative or if any element is out
+// of bounds, in which case ReadFields returns (nil, offset, false). A count
+// of zero yields an empty slice.
+//
+// As with ReadField, the returned values are not reduced or validated.
+func ReadFields(input []byte, offset, size, count int) ([]*big.Int, int, bool) {
+	if count < 0 {
+		return nil, offset, false
+	}
+
+	fields := make([]*big.Int, count)
+	next := offset
+
+	for index := range fields {
+		fields[index], next = ReadField(input, next, size)
+
+		if fields[index] == nil {
+			return nil, offset, false
+		}
+	}
+
+	return fields, next, true
+}
+
+// ConstantTimeLess reports whether a < b, comparing their size-byte
+// big-endian encodings in time that depends only on size.
+//
+// Unlike big.Int.Cmp, the comparison does not exit at the first differing
+// word, so it does not leak the position of that difference. It is meant
+// for checks on signer-controlled values, such as an EdDSA S scalar
+// against the subgroup order.
+//
+// a and b must be non-negative and fit in size bytes; otherwise
+// ConstantTimeLess returns false.
+func ConstantTimeLess(a, b *big.Int, size int) bool {
+	if a.Sign() < 0 || b.Sign() < 0 || a.BitLen() > 8*size || b.BitLen() > 8*size {
+		return false
+	}
+
+	left := a.FillBytes(make([]byte, size))
+	right := b.FillBytes(make([]byte, size))
+
+	less, decided := 0, 0
+
+	for index := range size {
+		x, y := int(left[index]), int(right[index])
+
+		isLess := subtle.ConstantTimeLessOrEq(x+1, y)
+		isGreater := subtle.ConstantTimeLessOrEq(y+1, x)
+
+		less |= isLess &^ decided
+		decided |= isLess | isGreater
+	}
+
+	return less == 1
+}
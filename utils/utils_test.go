@@ -3,6 +3,7 @@ package utils
 import (
 	"bytes"
 	"math/big"
+	"slices"
 	"testing"
 
 	"github.com/leanovate/gopter"
@@ -180,3 +181,140 @@ func TestReadFieldProperties(t *testing.T) {
 
 	properties.TestingRun(t)
 }
+
+func TestReadFieldsProperties(t *testing.T) {
+	parameters := gopter.DefaultTestParameters()
+	properties := gopter.NewProperties(parameters)
+
+	properties.Property("ReadFields matches consecutive ReadField calls", prop.ForAll(
+		func(data []byte, offset, count int) bool {
+			actual, newOffset, ok := ReadFields(data, offset, fieldByteSize, count)
+
+			if offset < 0 || offset+count*fieldByteSize > len(data) {
+				return !ok && actual == nil && newOffset == offset
+			}
+
+			if !ok || len(actual) != count || newOffset != offset+count*fieldByteSize {
+				return false
+			}
+
+			next := offset
+
+			for _, field := range actual {
+				var expected *big.Int
+
+				expected, next = ReadField(data, next, fieldByteSize)
+
+				if field.Cmp(expected) != 0 {
+					return false
+				}
+			}
+
+			return true
+		},
+		gen.SliceOfN(fieldByteSize*10, gen.UInt8()),
+		gen.IntRange(-1, fieldByteSize*10),
+		gen.IntRange(0, 12),
+	))
+
+	properties.Property("ReadFields with zero count returns an empty slice", prop.ForAll(
+		func(data []byte, offset int) bool {
+			actual, newOffset, ok := ReadFields(data, offset, fieldByteSize, 0)
+
+			return ok && actual != nil && len(actual) == 0 && newOffset == offset
+		},
+		gen.SliceOf(gen.UInt8()),
+		gen.IntRange(0, 1000),
+	))
+
+	properties.Property("ReadFields rejects negative counts", prop.ForAll(
+		func(data []byte, count int) bool {
+			actual, newOffset, ok := ReadFields(data, 0, fieldByteSize, count)
+
+			return !ok && actual == nil && newOffset == 0
+		},
+		gen.SliceOf(gen.UInt8()),
+		gen.IntRange(-100, -1),
+	))
+
+	properties.TestingRun(t)
+}
+
+func TestConstantTimeLess(t *testing.T) {
+	const size = 32
+
+	subOrder, _ := new(big.Int).SetString("2736030358979909402780800718157159386076813972158567259200215660948447373041", 10)
+	maxValue := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 8*size), big.NewInt(1))
+
+	offset := func(value *big.Int, delta int64) *big.Int {
+		return new(big.Int).Add(value, big.NewInt(delta))
+	}
+
+	values := []*big.Int{
+		big.NewInt(0),
+		big.NewInt(1),
+		big.NewInt(255),
+		big.NewInt(256),
+		offset(subOrder, -1),
+		subOrder,
+		offset(subOrder, 1),
+		new(big.Int).Lsh(big.NewInt(1), 8*size-1),
+		offset(maxValue, -1),
+		maxValue,
+	}
+
+	for _, a := range values {
+		for _, b := range values {
+			assert.Equal(t, a.Cmp(b) < 0, ConstantTimeLess(a, b, size), "%v < %v", a, b)
+		}
+	}
+
+	tests := []struct {
+		name string
+		a    *big.Int
+		b    *big.Int
+	}{
+		{"negative a", big.NewInt(-1), big.NewInt(1)},
+		{"negative b", big.NewInt(0), big.NewInt(-1)},
+		{"a wider than size", new(big.Int).Lsh(big.NewInt(1), 8*size), maxValue},
+		{"b wider than size", big.NewInt(0), new(big.Int).Lsh(big.NewInt(1), 8*size)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, false, ConstantTimeLess(tt.a, tt.b, size))
+		})
+	}
+}
+
+func TestConstantTimeLessProperties(t *testing.T) {
+	const size = 32
+
+	parameters := gopter.DefaultTestParameters()
+	properties := gopter.NewProperties(parameters)
+
+	properties.Property("ConstantTimeLess matches big.Int.Cmp", prop.ForAll(
+		func(left, right []byte) bool {
+			a := new(big.Int).SetBytes(left)
+			b := new(big.Int).SetBytes(right)
+
+			return ConstantTimeLess(a, b, size) == (a.Cmp(b) < 0)
+		},
+		gen.SliceOfN(size, gen.UInt8()),
+		gen.SliceOfN(size, gen.UInt8()),
+	))
+
+	properties.Property("ConstantTimeLess matches big.Int.Cmp on values sharing a prefix", prop.ForAll(
+		func(prefix []byte, x, y uint8) bool {
+			a := new(big.Int).SetBytes(append(slices.Clone(prefix), x))
+			b := new(big.Int).SetBytes(append(slices.Clone(prefix), y))
+
+			return ConstantTimeLess(a, b, size) == (a.Cmp(b) < 0)
+		},
+		gen.SliceOfN(size-1, gen.UInt8()),
+		gen.UInt8(),
+		gen.UInt8(),
+	))
+
+	properties.TestingRun(t)
+}
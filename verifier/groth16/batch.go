@@ -0,0 +1,204 @@
+package groth16
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/consensys/gnark/backend/groth16"
+	"github.com/privacy-ethereum/privacy-precompiles/common"
+	"github.com/privacy-ethereum/privacy-precompiles/utils"
+)
+
+// Name returns the human-readable identifier of the Groth16 batch
+// verification precompile, following the format:
+//
+//	<CurveName>Groth16BatchVerify
+func (c *Groth16BatchVerify) Name() string {
+	return fmt.Sprintf("%sGroth16BatchVerify", c.curveID.String())
+}
+
+// RequiredGas returns the gas cost required to execute the Groth16 batch
+// verification precompile.
+//
+// Gas is calculated as:
+//
+//	Groth16BatchVerifyBaseGas +
+//	  number_of_proofs * curve_base_gas +
+//	  number_of_public_inputs * curve_per_public_input_gas
+//
+// Where the public inputs are summed across all records.
+//
+// As for Groth16Verify.RequiredGas, if the curve is unsupported, or the
+// input is structurally invalid and would be rejected by Run with
+// ErrorGroth16VerifyInvalidInputLength, this function returns 0.
+func (c *Groth16BatchVerify) RequiredGas(input []byte) uint64 {
+	params, ok := Groth16Params[c.curveID]
+
+	if !ok {
+		return 0
+	}
+
+	records, err := splitBatchRecords(input, &params)
+
+	if err != nil {
+		return 0
+	}
+
+	numberOfPublicInputs := 0
+
+	for _, record := range records {
+		numberOfPublicInputs += numberOfRecordPublicInputs(record, &params)
+	}
+
+	return Groth16BatchVerifyBaseGas +
+		uint64(len(records))*uint64(params.baseGas) +
+		uint64(params.perPublicInputGas)*uint64(numberOfPublicInputs)
+}
+
+// Run verifies a batch of Groth16 proofs over the configured curve.
+//
+// Expected input layout:
+//
+//	[ Length_0 || Record_0 || Length_1 || Record_1 || ... ]
+//
+// Where:
+//   - Length_i is a Groth16BatchVerifyRecordLengthSize-byte big-endian
+//     length of Record_i.
+//   - Record_i uses the Run layout [ Proof || VerifyingKey || PublicInputs ],
+//     and its number of public inputs is derived from its length.
+//   - 1 <= N <= Groth16BatchVerifyMaxProofs.
+//
+// Records are verified in order with groth16.Verify. When consecutive
+// records carry the same verifying key bytes, the parsed and precomputed
+// key is reused instead of being parsed again.
+//
+// Return value:
+//   - []byte{1} if every proof is valid.
+//   - []byte{0} if any proof is invalid. Verification stops at the first
+//     invalid proof.
+//   - ErrorGroth16VerifyInvalidInputLength if the framing is malformed,
+//     a record is misaligned or the batch size is out of range.
+//   - A parse error if an inspected record cannot be decoded.
+func (c *Groth16BatchVerify) Run(input []byte) (ret []byte, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			ret = nil
+			err = ErrorPanicGroth16Verify
+		}
+	}()
+
+	params, ok := Groth16Params[c.curveID]
+
+	if !ok {
+		return nil, ErrorGroth16VerifyUnsupportedCurve
+	}
+
+	records, err := splitBatchRecords(input, &params)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var vk groth16.VerifyingKey
+	var previousVkBytes []byte
+
+	for _, record := range records {
+		numberOfPublicInputs := numberOfRecordPublicInputs(record, &params)
+		proofAndVkSize := params.proofSize + params.vkSize + params.g1Size*(numberOfPublicInputs+1)
+
+		proofBytes, _ := utils.SafeSlice(record, 0, params.proofSize)
+		vkBytes, _ := utils.SafeSlice(record, params.proofSize, proofAndVkSize)
+		publicWitnessBytes, _ := utils.SafeSlice(record, proofAndVkSize, len(record))
+
+		proof, err := c.parser.ParseProof(proofBytes)
+
+		if err != nil {
+			return nil, ErrorGroth16VerifyInvalidProof
+		}
+
+		if vk == nil || !bytes.Equal(vkBytes, previousVkBytes) {
+			vk, err = c.parser.ParseVerifyingKey(vkBytes, numberOfPublicInputs)
+
+			if err != nil {
+				return nil, ErrorGroth16VerifyInvalidVerifyingKey
+			}
+
+			previousVkBytes = vkBytes
+		}
+
+		publicWitness, err := c.parser.ParsePublicWitness(publicWitnessBytes, numberOfPublicInputs)
+
+		if err != nil {
+			return nil, ErrorGroth16VerifyInvalidPublicWitness
+		}
+
+		if err := groth16.Verify(proof, vk, publicWitness); err != nil {
+			return []byte{0}, nil
+		}
+	}
+
+	return []byte{1}, nil
+}
+
+// splitBatchRecords splits a length-prefixed batch into its records.
+//
+// Every record must be aligned to the curve parameters and carry between
+// 1 and Groth16MaxPublicInputs public inputs, and the batch must contain
+// between 1 and Groth16BatchVerifyMaxProofs records with no trailing bytes.
+func splitBatchRecords(input []byte, params *Groth16CurveParams) ([][]byte, error) {
+	records := make([][]byte, 0)
+	offset := 0
+
+	for offset < len(input) {
+		if len(records) == Groth16BatchVerifyMaxProofs {
+			return nil, ErrorGroth16VerifyInvalidInputLength
+		}
+
+		prefix, ok := utils.SafeSlice(input, offset, offset+Groth16BatchVerifyRecordLengthSize)
+
+		if !ok {
+			return nil, ErrorGroth16VerifyInvalidInputLength
+		}
+
+		offset += Groth16BatchVerifyRecordLengthSize
+		length := int(binary.BigEndian.Uint32(prefix))
+		record, ok := utils.SafeSlice(input, offset, offset+length)
+
+		if !ok || !isAlignedRecord(record, params) {
+			return nil, ErrorGroth16VerifyInvalidInputLength
+		}
+
+		records = append(records, record)
+		offset += length
+	}
+
+	if len(records) == 0 {
+		return nil, ErrorGroth16VerifyInvalidInputLength
+	}
+
+	return records, nil
+}
+
+// isAlignedRecord reports whether record has exactly the length of a
+// proof, a verifying key and between 1 and Groth16MaxPublicInputs
+// public inputs.
+func isAlignedRecord(record []byte, params *Groth16CurveParams) bool {
+	variableSize := len(record) - params.proofSize - params.vkSize - params.g1Size
+	perInputSize := params.g1Size + params.singlePublicInputSize
+
+	if variableSize <= 0 || variableSize%perInputSize != 0 {
+		return false
+	}
+
+	return variableSize/perInputSize <= Groth16MaxPublicInputs
+}
+
+// numberOfRecordPublicInputs returns the number of public inputs encoded
+// in a single aligned batch record.
+func numberOfRecordPublicInputs(record []byte, params *Groth16CurveParams) int {
+	return (len(record) - params.proofSize - params.vkSize - params.g1Size) / (params.g1Size + params.singlePublicInputSize)
+}
+
+// Ensure Groth16BatchVerify implements the common.Precompile interface.
+var _ common.Precompile = (*Groth16BatchVerify)(nil)
@@ -0,0 +1,225 @@
+package groth16
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark/backend/groth16"
+	groth16bn254 "github.com/consensys/gnark/backend/groth16/bn254"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/frontend/cs/r1cs"
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/gen"
+	"github.com/leanovate/gopter/prop"
+	"github.com/privacy-ethereum/privacy-precompiles/verifier/groth16/bn254"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGroth16BatchVerifyName(t *testing.T) {
+	precompile := NewGroth16BN254BatchVerify()
+
+	expected := "bn254Groth16BatchVerify"
+	actual := precompile.Name()
+
+	assert.Equal(t, expected, actual)
+}
+
+func TestGroth16BatchVerifyUnsupportedCurve(t *testing.T) {
+	parser := SolidityProofParsers[ecc.BN254]
+	precompile := newGroth16BatchVerify(ecc.BW6_761, parser)
+
+	result, err := precompile.Run([]byte{})
+	gas := precompile.RequiredGas([]byte{})
+
+	assert.Nil(t, result)
+	assert.Equal(t, ErrorGroth16VerifyUnsupportedCurve, err)
+	assert.Equal(t, uint64(0), gas)
+}
+
+func TestGroth16BatchVerify(t *testing.T) {
+	oneInputRecords := proveBatchRecords(t, &onePublicInputCircuit{}, &onePublicInputCircuit{X: 1}, 2)
+	twoInputRecords := proveBatchRecords(t, &twoPublicInputCircuit{}, &twoPublicInputCircuit{X: 1, Y: 2}, 1)
+
+	invalidRecord := append([]byte{}, oneInputRecords[1]...)
+	invalidRecord[len(invalidRecord)-1] ^= 1
+
+	offCurveRecord := append([]byte{}, oneInputRecords[1]...)
+	offCurveRecord[bn254.BN254Groth16ProofSize-1] ^= 1
+
+	operationsCost := uint64(bn254.BN254Groth16PerPublicInputGas)
+
+	tests := []struct {
+		name          string
+		input         []byte
+		expected      []byte
+		expectedGas   uint64
+		expectedError error
+	}{
+		{
+			name:        "single valid proof",
+			input:       frameBatchRecords(oneInputRecords[0]),
+			expected:    []byte{1},
+			expectedGas: Groth16BatchVerifyBaseGas + bn254.BN254Groth16VerifyBaseGas + operationsCost,
+		},
+		{
+			name:        "valid proofs sharing a verifying key",
+			input:       frameBatchRecords(oneInputRecords...),
+			expected:    []byte{1},
+			expectedGas: Groth16BatchVerifyBaseGas + 2*bn254.BN254Groth16VerifyBaseGas + 2*operationsCost,
+		},
+		{
+			name:        "valid proofs with different verifying keys",
+			input:       frameBatchRecords(oneInputRecords[0], twoInputRecords[0], oneInputRecords[1]),
+			expected:    []byte{1},
+			expectedGas: Groth16BatchVerifyBaseGas + 3*bn254.BN254Groth16VerifyBaseGas + 4*operationsCost,
+		},
+		{
+			name:        "valid proof followed by invalid proof",
+			input:       frameBatchRecords(oneInputRecords[0], invalidRecord),
+			expected:    []byte{0},
+			expectedGas: Groth16BatchVerifyBaseGas + 2*bn254.BN254Groth16VerifyBaseGas + 2*operationsCost,
+		},
+		{
+			name:        "invalid proof followed by valid proof",
+			input:       frameBatchRecords(invalidRecord, twoInputRecords[0]),
+			expected:    []byte{0},
+			expectedGas: Groth16BatchVerifyBaseGas + 2*bn254.BN254Groth16VerifyBaseGas + 3*operationsCost,
+		},
+		{
+			name:          "off-curve proof point",
+			input:         frameBatchRecords(oneInputRecords[0], offCurveRecord),
+			expectedError: ErrorGroth16VerifyInvalidProof,
+		},
+		{
+			name:          "empty input",
+			input:         []byte{},
+			expectedError: ErrorGroth16VerifyInvalidInputLength,
+		},
+		{
+			name:          "truncated length prefix",
+			input:         append(frameBatchRecords(oneInputRecords[0]), 0, 0),
+			expectedError: ErrorGroth16VerifyInvalidInputLength,
+		},
+		{
+			name: "record shorter than its length prefix",
+			input: func() []byte {
+				input := frameBatchRecords(oneInputRecords[0])
+
+				return input[:len(input)-1]
+			}(),
+			expectedError: ErrorGroth16VerifyInvalidInputLength,
+		},
+		{
+			name:          "misaligned record",
+			input:         frameBatchRecords(oneInputRecords[0][:len(oneInputRecords[0])-1]),
+			expectedError: ErrorGroth16VerifyInvalidInputLength,
+		},
+		{
+			name:          "record without public inputs",
+			input:         frameBatchRecords(make([]byte, bn254.BN254Groth16ProofSize+bn254.BN254Groth16VerifyVerifyingKeySize+bn254.BN254Groth16G1Size)),
+			expectedError: ErrorGroth16VerifyInvalidInputLength,
+		},
+		{
+			name: "too many records",
+			input: func() []byte {
+				records := make([][]byte, Groth16BatchVerifyMaxProofs+1)
+
+				for index := range records {
+					records[index] = oneInputRecords[0]
+				}
+
+				return frameBatchRecords(records...)
+			}(),
+			expectedError: ErrorGroth16VerifyInvalidInputLength,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			precompile := NewGroth16BN254BatchVerify()
+
+			actual, err := precompile.Run(tt.input)
+			gas := precompile.RequiredGas(tt.input)
+
+			if tt.expectedError != nil {
+				assert.NotNil(t, err)
+				assert.Equal(t, tt.expectedError, err)
+
+				if tt.expectedError == ErrorGroth16VerifyInvalidInputLength {
+					assert.Equal(t, uint64(0), gas)
+				} else {
+					assert.NotZero(t, gas)
+				}
+
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, tt.expected, actual)
+			assert.Equal(t, tt.expectedGas, gas)
+		})
+	}
+}
+
+func TestGroth16BatchVerifyRunProperties(t *testing.T) {
+	parameters := gopter.DefaultTestParameters()
+	properties := gopter.NewProperties(parameters)
+
+	records := proveBatchRecords(t, &onePublicInputCircuit{}, &onePublicInputCircuit{X: 1}, 1)
+
+	properties.Property("Run accepts any batch of valid proofs", prop.ForAll(
+		func(size int) bool {
+			batch := make([][]byte, size)
+
+			for index := range batch {
+				batch[index] = records[0]
+			}
+
+			precompile := NewGroth16BN254BatchVerify()
+			result, err := precompile.Run(frameBatchRecords(batch...))
+
+			return err == nil && bytes.Equal(result, []byte{1})
+		},
+		gen.IntRange(1, Groth16BatchVerifyMaxProofs),
+	))
+
+	properties.TestingRun(t)
+}
+
+// proveBatchRecords compiles circuit once and returns count records, each
+// holding an independent proof for assignment under the same verifying key.
+func proveBatchRecords(t *testing.T, circuit, assignment frontend.Circuit, count int) [][]byte {
+	ccs, _ := frontend.Compile(ecc.BN254.ScalarField(), r1cs.NewBuilder, circuit)
+	pk, vk, _ := groth16.Setup(ccs)
+	witness, _ := frontend.NewWitness(assignment, ecc.BN254.ScalarField())
+	witnessPublic, _ := witness.Public()
+	witnessBytes, _ := witnessPublic.MarshalBinary()
+	publicInputs, _ := bn254.StripWitnessHeader(witnessBytes)
+	vkBytes := bn254.SerializeVerifyingKey(vk.(*groth16bn254.VerifyingKey))
+
+	records := make([][]byte, count)
+
+	for index := range records {
+		proof, err := groth16.Prove(ccs, pk, witness)
+		assert.Nil(t, err)
+
+		proofBytes := bn254.SerializeProof(proof.(*groth16bn254.Proof))
+		records[index] = append(append(proofBytes, vkBytes...), publicInputs...)
+	}
+
+	return records
+}
+
+// frameBatchRecords prefixes each record with its length and concatenates them.
+func frameBatchRecords(records ...[]byte) []byte {
+	out := make([]byte, 0)
+
+	for _, record := range records {
+		out = binary.BigEndian.AppendUint32(out, uint32(len(record)))
+		out = append(out, record...)
+	}
+
+	return out
+}
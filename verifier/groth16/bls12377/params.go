@@ -0,0 +1,81 @@
+package bls12377
+
+// BLS12-377 Groth16 Verifier precompile constants
+const (
+	// BLS12377Groth16PairingGas defines the gas cost of the pairing check
+	// of a Groth16 verification over BLS12-377. No EVM precompile prices
+	// BLS12-377, so it mirrors the EIP-2537 BLS12-381 price of
+	// 37700 + 32600 per pair, for four pairs.
+	BLS12377Groth16PairingGas = 37700 + 4*32600
+
+	// BLS12377Groth16G2ParseGas defines the gas cost of parsing one G2
+	// point, dominated by its prime-order subgroup check. A verification
+	// parses four G2 points.
+	BLS12377Groth16G2ParseGas = 22975
+
+	// BLS12377Groth16VerifyBaseGas defines the base gas cost for executing
+	// the Groth16 verification precompile over the BLS12-377 curve.
+	//
+	// It is the pairing check plus the parsing of the four G2 points. The
+	// value does not include the dynamic cost of public input processing,
+	// see BLS12377Groth16PerPublicInputGas.
+	BLS12377Groth16VerifyBaseGas = BLS12377Groth16PairingGas + 4*BLS12377Groth16G2ParseGas
+
+	// BLS12377Groth16PerPublicInputGas defines the gas cost charged per
+	// public input for its term of the IC linear combination, mirroring
+	// the EIP-2537 BLS12-381 prices of one G1 multiplication (12000) and
+	// one G1 addition (375).
+	BLS12377Groth16PerPublicInputGas = 12000 + 375
+
+	// BLS12377Groth16ProofSize defines the expected byte size of a serialized
+	// Groth16 proof over BLS12-377.
+	//
+	// A Groth16 proof consists of:
+	//   - G1 element A
+	//   - G2 element B
+	//   - G1 element C
+	//
+	// Each element is encoded in uncompressed affine form.
+	BLS12377Groth16ProofSize = 384
+
+	// BLS12377Groth16VerifyVerifyingKeySize defines the expected byte size
+	// of a serialized Groth16 verifying key over BLS12-377.
+	//
+	// This includes:
+	//   - Alpha (G1)
+	//   - Beta (G2)
+	//   - Gamma (G2)
+	//   - Delta (G2)
+	//
+	// Additional IC elements corresponding to public inputs may be
+	// appended dynamically depending on the circuit.
+	BLS12377Groth16VerifyVerifyingKeySize = 672
+
+	// BLS12377Groth16G1Size defines the byte size of a serialized BLS12-377
+	// G1 affine point in uncompressed form.
+	//
+	// A G1 point consists of two field elements (X, Y),
+	// each occupying 48 bytes.
+	BLS12377Groth16G1Size = 96
+
+	// BLS12377Groth16G2Size defines the byte size of a serialized BLS12-377
+	// G2 affine point in uncompressed form.
+	//
+	// A G2 point consists of two field elements (X, Y),
+	// where each field element contains two 48-byte field elements.
+	BLS12377Groth16G2Size = 192
+
+	// BLS12377Groth16SinglePublicInputSize defines the byte size of a single
+	// public input field element for BLS12-377.
+	//
+	// Public inputs live in the 253-bit scalar field and are encoded
+	// as 32-byte big-endian field elements.
+	BLS12377Groth16SinglePublicInputSize = 32
+
+	// BLS12377Groth16FieldSize defines the byte size of a single base field
+	// element in BLS12-377.
+	//
+	// BLS12-377 operates over a 377-bit prime field, which is encoded using
+	// 48 bytes in big-endian representation.
+	BLS12377Groth16FieldSize = 48
+)
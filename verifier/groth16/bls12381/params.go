@@ -0,0 +1,80 @@
+package bls12381
+
+// BLS12-381 Groth16 Verifier precompile constants
+const (
+	// BLS12381Groth16PairingGas defines the gas cost of the pairing check
+	// of a Groth16 verification over BLS12-381: the EIP-2537 pairing price
+	// of 37700 + 32600 per pair, for four pairs.
+	BLS12381Groth16PairingGas = 37700 + 4*32600
+
+	// BLS12381Groth16G2ParseGas defines the gas cost of parsing one G2
+	// point, dominated by its prime-order subgroup check. A verification
+	// parses four G2 points.
+	BLS12381Groth16G2ParseGas = 22975
+
+	// BLS12381Groth16VerifyBaseGas defines the base gas cost for executing
+	// the Groth16 verification precompile over the BLS12-381 curve.
+	//
+	// It is the pairing check plus the parsing of the four G2 points, and
+	// is higher than the BN254 base cost to account for the larger base
+	// field. The value does not include the dynamic cost of public input
+	// processing, see BLS12381Groth16PerPublicInputGas.
+	BLS12381Groth16VerifyBaseGas = BLS12381Groth16PairingGas + 4*BLS12381Groth16G2ParseGas
+
+	// BLS12381Groth16PerPublicInputGas defines the gas cost charged per
+	// public input for its term of the IC linear combination: the EIP-2537
+	// prices of one G1 multiplication (12000) and one G1 addition (375).
+	BLS12381Groth16PerPublicInputGas = 12000 + 375
+
+	// BLS12381Groth16ProofSize defines the expected byte size of a serialized
+	// Groth16 proof over BLS12-381.
+	//
+	// A Groth16 proof consists of:
+	//   - G1 element A
+	//   - G2 element B
+	//   - G1 element C
+	//
+	// Each element is encoded in uncompressed affine form.
+	BLS12381Groth16ProofSize = 384
+
+	// BLS12381Groth16VerifyVerifyingKeySize defines the expected byte size
+	// of a serialized Groth16 verifying key over BLS12-381.
+	//
+	// This includes:
+	//   - Alpha (G1)
+	//   - Beta (G2)
+	//   - Gamma (G2)
+	//   - Delta (G2)
+	//
+	// Additional IC elements corresponding to public inputs may be
+	// appended dynamically depending on the circuit.
+	BLS12381Groth16VerifyVerifyingKeySize = 672
+
+	// BLS12381Groth16G1Size defines the byte size of a serialized BLS12-381
+	// G1 affine point in uncompressed form.
+	//
+	// A G1 point consists of two field elements (X, Y),
+	// each occupying 48 bytes.
+	BLS12381Groth16G1Size = 96
+
+	// BLS12381Groth16G2Size defines the byte size of a serialized BLS12-381
+	// G2 affine point in uncompressed form.
+	//
+	// A G2 point consists of two field elements (X, Y),
+	// where each field element contains two 48-byte field elements.
+	BLS12381Groth16G2Size = 192
+
+	// BLS12381Groth16SinglePublicInputSize defines the byte size of a single
+	// public input field element for BLS12-381.
+	//
+	// Public inputs live in the 255-bit scalar field and are encoded
+	// as 32-byte big-endian field elements.
+	BLS12381Groth16SinglePublicInputSize = 32
+
+	// BLS12381Groth16FieldSize defines the byte size of a single base field
+	// element in BLS12-381.
+	//
+	// BLS12-381 operates over a 381-bit prime field, which is encoded using
+	// 48 bytes in big-endian representation.
+	BLS12381Groth16FieldSize = 48
+)
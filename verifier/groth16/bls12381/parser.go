@@ -0,0 +1,284 @@
+package bls12381
+
+import (
+	"github.com/consensys/gnark-crypto/ecc"
+	bls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381"
+	"github.com/consensys/gnark/backend/groth16"
+	groth16bls12381 "github.com/consensys/gnark/backend/groth16/bls12-381"
+	"github.com/consensys/gnark/backend/witness"
+	"github.com/privacy-ethereum/privacy-precompiles/common"
+	"github.com/privacy-ethereum/privacy-precompiles/utils"
+)
+
+// SolidityBLS12381Parser implements SolidityGroth16ByteParser for the BLS12-381 curve.
+//
+// It is responsible for decoding Solidity-compatible byte encodings of:
+//   - Groth16 proofs
+//   - Groth16 verifying keys
+//   - Public witness inputs
+//
+// All elements are expected to be encoded in uncompressed affine form,
+// using big-endian field element representation.
+type SolidityBLS12381Parser struct{}
+
+// ParseG1 parses a BLS12-381 G1 affine point from data starting at the given offset.
+//
+// The expected encoding is:
+//   - 48 bytes X coordinate (big-endian)
+//   - 48 bytes Y coordinate (big-endian)
+//
+// It writes the parsed point into destination and returns the new offset.
+// An error is returned if the byte slice is out of bounds.
+func ParseG1(
+	data []byte,
+	offset int,
+	destination *bls12381.G1Affine,
+) (int, error) {
+	if slice, ok := utils.SafeSlice(data, offset, offset+BLS12381Groth16FieldSize); ok {
+		destination.X.SetBytes(slice)
+	} else {
+		return offset, common.ErrorInvalidG1
+	}
+
+	if slice, ok := utils.SafeSlice(data, offset+BLS12381Groth16FieldSize, offset+2*BLS12381Groth16FieldSize); ok {
+		destination.Y.SetBytes(slice)
+	} else {
+		return offset, common.ErrorInvalidG1
+	}
+
+	return offset + BLS12381Groth16G1Size, nil
+}
+
+// ParseG2 parses a BLS12-381 G2 affine point from data starting at the given offset.
+//
+// The expected encoding is:
+//   - 48 bytes X.A1
+//   - 48 bytes X.A0
+//   - 48 bytes Y.A1
+//   - 48 bytes Y.A0
+//
+// Each component is a field element encoded in big-endian format.
+// The function writes the parsed point into destination and returns
+// the updated offset. An error is returned if the byte slice is invalid.
+func ParseG2(
+	data []byte,
+	offset int,
+	destination *bls12381.G2Affine,
+) (int, error) {
+	if slice, ok := utils.SafeSlice(data, offset, offset+BLS12381Groth16FieldSize); ok {
+		destination.X.A1.SetBytes(slice)
+	} else {
+		return offset, common.ErrorInvalidG2
+	}
+
+	if slice, ok := utils.SafeSlice(data, offset+BLS12381Groth16FieldSize, offset+2*BLS12381Groth16FieldSize); ok {
+		destination.X.A0.SetBytes(slice)
+	} else {
+		return offset, common.ErrorInvalidG2
+	}
+
+	if slice, ok := utils.SafeSlice(data, offset+2*BLS12381Groth16FieldSize, offset+3*BLS12381Groth16FieldSize); ok {
+		destination.Y.A1.SetBytes(slice)
+	} else {
+		return offset, common.ErrorInvalidG2
+	}
+
+	if slice, ok := utils.SafeSlice(data, offset+3*BLS12381Groth16FieldSize, offset+BLS12381Groth16G2Size); ok {
+		destination.Y.A0.SetBytes(slice)
+	} else {
+		return offset, common.ErrorInvalidG2
+	}
+
+	return offset + BLS12381Groth16G2Size, nil
+}
+
+// ParseValidatedG1 parses a BLS12-381 G1 affine point using ParseG1 and
+// additionally checks that it lies on the curve and in the prime-order
+// subgroup.
+//
+// It returns common.ErrorInvalidG1 if either check fails.
+func ParseValidatedG1(
+	data []byte,
+	offset int,
+	destination *bls12381.G1Affine,
+) (int, error) {
+	next, err := ParseG1(data, offset, destination)
+
+	if err != nil {
+		return offset, err
+	}
+
+	if !destination.IsOnCurve() || !destination.IsInSubGroup() {
+		return offset, common.ErrorInvalidG1
+	}
+
+	return next, nil
+}
+
+// ParseValidatedG2 parses a BLS12-381 G2 affine point using ParseG2 and
+// additionally checks that it lies on the curve and in the prime-order
+// subgroup.
+//
+// It returns common.ErrorInvalidG2 if either check fails.
+func ParseValidatedG2(
+	data []byte,
+	offset int,
+	destination *bls12381.G2Affine,
+) (int, error) {
+	next, err := ParseG2(data, offset, destination)
+
+	if err != nil {
+		return offset, err
+	}
+
+	if !destination.IsOnCurve() || !destination.IsInSubGroup() {
+		return offset, common.ErrorInvalidG2
+	}
+
+	return next, nil
+}
+
+// ParseProof parses a serialized Groth16 proof over BLS12-381.
+//
+// The expected layout is:
+//   - G1 element Ar
+//   - G2 element Bs
+//   - G1 element Krs
+//
+// Each element must be encoded in uncompressed affine form and must be a
+// valid curve point in the prime-order subgroup. An error is returned if
+// parsing or validation fails at any step.
+func (p *SolidityBLS12381Parser) ParseProof(data []byte) (groth16.Proof, error) {
+	var proof groth16bls12381.Proof
+	var err error
+	var offset int = 0
+
+	offset, err = ParseValidatedG1(data, offset, &proof.Ar)
+
+	if err != nil {
+		return nil, err
+	}
+
+	offset, err = ParseValidatedG2(data, offset, &proof.Bs)
+
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = ParseValidatedG1(data, offset, &proof.Krs)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &proof, nil
+}
+
+// ParseVerifyingKey parses a serialized Groth16 verifying key over BLS12-381.
+//
+// The expected layout is:
+//   - G1 Alpha
+//   - G2 Beta
+//   - G2 Gamma
+//   - G2 Delta
+//   - (numberOfPublicInputs + 1) G1 elements for the IC (input commitments)
+//
+// The data must end right after the IC points: common.ErrorInvalidG1 is
+// returned if it holds more or fewer than numberOfPublicInputs + 1 of them.
+//
+// Every point must lie on the curve and in the prime-order subgroup.
+// After parsing, vk.Precompute() is called to prepare internal pairing
+// values (e.g., gammaNeg, deltaNeg). An error is returned if parsing or
+// precomputation fails.
+func (p *SolidityBLS12381Parser) ParseVerifyingKey(data []byte, numberOfPublicInputs int) (groth16.VerifyingKey, error) {
+	var vk groth16bls12381.VerifyingKey
+	var err error
+	var offset int = 0
+
+	offset, err = ParseValidatedG1(data, offset, &vk.G1.Alpha)
+
+	if err != nil {
+		return nil, err
+	}
+
+	offset, err = ParseValidatedG2(data, offset, &vk.G2.Beta)
+
+	if err != nil {
+		return nil, err
+	}
+
+	offset, err = ParseValidatedG2(data, offset, &vk.G2.Gamma)
+
+	if err != nil {
+		return nil, err
+	}
+
+	offset, err = ParseValidatedG2(data, offset, &vk.G2.Delta)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if numberOfPublicInputs < 0 || len(data)-offset != (numberOfPublicInputs+1)*BLS12381Groth16G1Size {
+		return nil, common.ErrorInvalidG1
+	}
+
+	vk.G1.K = make([]bls12381.G1Affine, numberOfPublicInputs+1)
+
+	for index := range vk.G1.K {
+		offset, err = ParseValidatedG1(data, offset, &vk.G1.K[index])
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Precompute the necessary values (e, gammaNeg, deltaNeg)
+	if err := vk.Precompute(); err != nil {
+		// Cannot fail through this parser
+		// Alpha and Beta points are checked before calling precompute function
+		return nil, err
+	}
+
+	return &vk, nil
+}
+
+// ParsePublicWitness parses serialized public inputs into a gnark Witness
+// compatible with the specified curve.
+//
+// Each public input must be encoded as a 32-byte big-endian field element.
+// The numberOfPublicInputs parameter defines how many inputs are expected.
+//
+// The inputs are read with utils.ReadFields, then handed to w.Fill()
+// through a channel buffered to hold all of them. An error is returned if
+// any slice is invalid or if witness construction fails.
+func (p *SolidityBLS12381Parser) ParsePublicWitness(
+	data []byte,
+	numberOfPublicInputs int,
+) (witness.Witness, error) {
+	publicWitness, _ := witness.New(ecc.BLS12_381.ScalarField())
+
+	values, _, ok := utils.ReadFields(data, 0, BLS12381Groth16SinglePublicInputSize, numberOfPublicInputs)
+
+	if !ok {
+		return nil, common.ErrorInvalidPublicWitnessSlice
+	}
+
+	// The channel is sized from the parsed values, so sending them all never blocks.
+	channel := make(chan any, len(values))
+
+	for _, value := range values {
+		channel <- value
+	}
+
+	close(channel)
+
+	if err := publicWitness.Fill(len(values), 0, channel); err != nil {
+		// Cannot fail through this parser
+		// 1. Channel always contains exactly len(values) elements
+		// 2. All elements are *big.Int, set always succeeds (SetBigInt reduces modulo field)
+		return nil, err
+	}
+
+	return publicWitness, nil
+}
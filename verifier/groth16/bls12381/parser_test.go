@@ -0,0 +1,602 @@
+package bls12381
+
+import (
+	"bytes"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	bls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381"
+	"github.com/consensys/gnark/backend/groth16"
+	groth16bls12381 "github.com/consensys/gnark/backend/groth16/bls12-381"
+	"github.com/consensys/gnark/backend/witness"
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/prop"
+	"github.com/privacy-ethereum/privacy-precompiles/common"
+	"github.com/privacy-ethereum/privacy-precompiles/verifier/groth16/bn254"
+	"github.com/stretchr/testify/assert"
+)
+
+// field encodes a small value as a 48-byte big-endian base field element.
+func field(value byte) []byte {
+	out := make([]byte, BLS12381Groth16FieldSize)
+	out[BLS12381Groth16FieldSize-1] = value
+
+	return out
+}
+
+// repeat concatenates count copies of data.
+func repeat(data []byte, count int) []byte {
+	return bytes.Repeat(data, count)
+}
+
+// g1Bytes and g2Bytes encode (0, 1) coordinates, which are well-formed
+// but do not lie on the curve.
+var (
+	g1Bytes = slices.Concat(field(0), field(1))
+	g2Bytes = slices.Concat(field(0), field(1), field(0), field(1))
+)
+
+func TestParseG1(t *testing.T) {
+	expectedPoint := func() *bls12381.G1Affine {
+		point := &bls12381.G1Affine{}
+		_, _ = point.X.SetString("0")
+		_, _ = point.Y.SetString("1")
+
+		return point
+	}()
+
+	tests := []struct {
+		name           string
+		data           []byte
+		offset         int
+		expectedPoint  *bls12381.G1Affine
+		expectedOffset int
+		expectedError  error
+	}{
+		{
+			name:           "normal g1 parse",
+			data:           g1Bytes,
+			offset:         0,
+			expectedOffset: BLS12381Groth16G1Size,
+			expectedPoint:  expectedPoint,
+		},
+		{
+			name:           "normal g1 parse with offset",
+			data:           repeat(g1Bytes, 2),
+			offset:         BLS12381Groth16G1Size,
+			expectedOffset: 2 * BLS12381Groth16G1Size,
+			expectedPoint:  expectedPoint,
+		},
+		{
+			name:           "invalid g1 parse for first part",
+			data:           []byte{},
+			offset:         0,
+			expectedOffset: 0,
+			expectedError:  common.ErrorInvalidG1,
+		},
+		{
+			name:           "invalid g1 parse for second part",
+			data:           make([]byte, BLS12381Groth16FieldSize),
+			offset:         0,
+			expectedOffset: 0,
+			expectedError:  common.ErrorInvalidG1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			destination := &bls12381.G1Affine{}
+			offset, err := ParseG1(tt.data, tt.offset, destination)
+
+			if tt.expectedError != nil {
+				assert.NotNil(t, err)
+				assert.Equal(t, tt.expectedError, err)
+
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, tt.expectedOffset, offset)
+			assert.Equal(t, tt.expectedPoint, destination)
+		})
+	}
+}
+
+func TestParseG1Properties(t *testing.T) {
+	parameters := gopter.DefaultTestParameters()
+	properties := gopter.NewProperties(parameters)
+
+	properties.Property("ParseG1 returns correct G1 affine point", prop.ForAll(
+		func(point *bls12381.G1Affine) bool {
+			destination := bls12381.G1Affine{}
+			x := point.X.Bytes()
+			y := point.Y.Bytes()
+			data := append(x[:], y[:]...)
+
+			result, err := ParseG1(data, 0, &destination)
+
+			if err != nil {
+				return false
+			}
+
+			return result == BLS12381Groth16G1Size && destination.Equal(point)
+		},
+		G1AffineGenerator(),
+	))
+
+	properties.TestingRun(t)
+}
+
+func TestParseG2(t *testing.T) {
+	expectedPoint := func() *bls12381.G2Affine {
+		point := &bls12381.G2Affine{}
+		_, _ = point.X.A1.SetString("0")
+		_, _ = point.X.A0.SetString("1")
+		_, _ = point.Y.A1.SetString("0")
+		_, _ = point.Y.A0.SetString("1")
+
+		return point
+	}()
+
+	tests := []struct {
+		name           string
+		data           []byte
+		offset         int
+		expectedPoint  *bls12381.G2Affine
+		expectedOffset int
+		expectedError  error
+	}{
+		{
+			name:           "normal g2 parse",
+			data:           g2Bytes,
+			offset:         0,
+			expectedOffset: BLS12381Groth16G2Size,
+			expectedPoint:  expectedPoint,
+		},
+		{
+			name:           "normal g2 parse with offset",
+			data:           repeat(g2Bytes, 2),
+			offset:         BLS12381Groth16G2Size,
+			expectedOffset: 2 * BLS12381Groth16G2Size,
+			expectedPoint:  expectedPoint,
+		},
+		{
+			name:           "invalid g2 parse for first part",
+			data:           []byte{},
+			offset:         0,
+			expectedOffset: 0,
+			expectedError:  common.ErrorInvalidG2,
+		},
+		{
+			name:           "invalid g2 parse for second part",
+			data:           make([]byte, BLS12381Groth16FieldSize),
+			offset:         0,
+			expectedOffset: 0,
+			expectedError:  common.ErrorInvalidG2,
+		},
+		{
+			name:           "invalid g2 parse for third part",
+			data:           make([]byte, 2*BLS12381Groth16FieldSize),
+			offset:         0,
+			expectedOffset: 0,
+			expectedError:  common.ErrorInvalidG2,
+		},
+		{
+			name:           "invalid g2 parse for last part",
+			data:           make([]byte, 3*BLS12381Groth16FieldSize),
+			offset:         0,
+			expectedOffset: 0,
+			expectedError:  common.ErrorInvalidG2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			destination := &bls12381.G2Affine{}
+			offset, err := ParseG2(tt.data, tt.offset, destination)
+
+			if tt.expectedError != nil {
+				assert.NotNil(t, err)
+				assert.Equal(t, tt.expectedError, err)
+
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, tt.expectedOffset, offset)
+			assert.Equal(t, tt.expectedPoint, destination)
+		})
+	}
+}
+
+func TestParseG2Properties(t *testing.T) {
+	parameters := gopter.DefaultTestParameters()
+	properties := gopter.NewProperties(parameters)
+
+	properties.Property("ParseG2 returns correct G2 affine point", prop.ForAll(
+		func(point *bls12381.G2Affine) bool {
+			destination := bls12381.G2Affine{}
+			x1 := point.X.A1.Bytes()
+			x0 := point.X.A0.Bytes()
+			y1 := point.Y.A1.Bytes()
+			y0 := point.Y.A0.Bytes()
+			data := append(append(append(x1[:], x0[:]...), y1[:]...), y0[:]...)
+
+			result, err := ParseG2(data, 0, &destination)
+
+			if err != nil {
+				return false
+			}
+
+			return result == BLS12381Groth16G2Size && destination.Equal(point)
+		},
+		G2AffineGenerator(),
+	))
+
+	properties.TestingRun(t)
+}
+
+func TestParseProof(t *testing.T) {
+	tests := []struct {
+		name          string
+		data          []byte
+		expected      groth16.Proof
+		expectedError error
+	}{
+		{
+			name: "normal proof parse",
+			data: slices.Concat(generatorG1Bytes(), generatorG2Bytes(), generatorG1Bytes()),
+			expected: func() groth16.Proof {
+				var proof groth16bls12381.Proof
+
+				_, _, proof.Ar, proof.Bs = bls12381.Generators()
+				proof.Krs = proof.Ar
+
+				return &proof
+			}(),
+		},
+		{
+			name:          "invalid proof parse (Ar)",
+			data:          []byte{},
+			expectedError: errors.New("invalid G1 point"),
+		},
+		{
+			name:          "invalid proof parse (Bs)",
+			data:          generatorG1Bytes(),
+			expectedError: errors.New("invalid G2 point"),
+		},
+		{
+			name:          "invalid proof parse (Krs)",
+			data:          slices.Concat(generatorG1Bytes(), generatorG2Bytes()),
+			expectedError: errors.New("invalid G1 point"),
+		},
+		{
+			name:          "off-curve proof parse (Ar)",
+			data:          slices.Concat(g1Bytes, generatorG2Bytes(), generatorG1Bytes()),
+			expectedError: common.ErrorInvalidG1,
+		},
+		{
+			name:          "off-curve proof parse (Bs)",
+			data:          slices.Concat(generatorG1Bytes(), g2Bytes, generatorG1Bytes()),
+			expectedError: common.ErrorInvalidG2,
+		},
+		{
+			name:          "proof parse with Bs outside of subgroup",
+			data:          slices.Concat(generatorG1Bytes(), g2NotInSubgroupBytes(), generatorG1Bytes()),
+			expectedError: common.ErrorInvalidG2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			parser := SolidityBLS12381Parser{}
+			proof, err := parser.ParseProof(tt.data)
+
+			if tt.expectedError != nil {
+				assert.NotNil(t, err)
+				assert.Equal(t, tt.expectedError, err)
+
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, tt.expected, proof)
+		})
+	}
+}
+
+func TestParseProofProperties(t *testing.T) {
+	parameters := gopter.DefaultTestParameters()
+	properties := gopter.NewProperties(parameters)
+
+	properties.Property("ParseProof returns correct Groth16 proof", prop.ForAll(
+		func(input []byte) bool {
+			parser := SolidityBLS12381Parser{}
+
+			proof, err := parser.ParseProof(input)
+
+			if err != nil {
+				return false
+			}
+
+			return bytes.Equal(input, SerializeProof(proof.(*groth16bls12381.Proof)))
+		},
+		ProofBytesGenerator(),
+	))
+
+	properties.TestingRun(t)
+}
+
+func TestParseVerifyingKey(t *testing.T) {
+	fixed := slices.Concat(generatorG1Bytes(), generatorG2Bytes(), repeat(generatorG2Bytes(), 2))
+
+	tests := []struct {
+		name                 string
+		data                 []byte
+		numberOfPublicInputs int
+		expectedK            int
+		expectedError        error
+	}{
+		{
+			name:                 "normal verifying key parse",
+			data:                 slices.Concat(fixed, repeat(generatorG1Bytes(), 2)),
+			numberOfPublicInputs: 1,
+			expectedK:            2,
+		},
+		{
+			name:                 "verifying key parse with zero public inputs",
+			data:                 slices.Concat(fixed, generatorG1Bytes()),
+			numberOfPublicInputs: 0,
+			expectedK:            1,
+		},
+		{
+			name:                 "invalid verifying key parse with empty data",
+			data:                 []byte{},
+			numberOfPublicInputs: 1,
+			expectedError:        common.ErrorInvalidG1,
+		},
+		{
+			name:                 "invalid verifying key parse with empty beta point",
+			data:                 generatorG1Bytes(),
+			numberOfPublicInputs: 1,
+			expectedError:        common.ErrorInvalidG2,
+		},
+		{
+			name:                 "invalid verifying key parse with empty gamma point",
+			data:                 slices.Concat(generatorG1Bytes(), generatorG2Bytes()),
+			numberOfPublicInputs: 1,
+			expectedError:        common.ErrorInvalidG2,
+		},
+		{
+			name:                 "invalid verifying key parse with empty delta point",
+			data:                 slices.Concat(generatorG1Bytes(), repeat(generatorG2Bytes(), 2)),
+			numberOfPublicInputs: 1,
+			expectedError:        common.ErrorInvalidG2,
+		},
+		{
+			name:                 "invalid verifying key parse with empty k point",
+			data:                 fixed,
+			numberOfPublicInputs: 1,
+			expectedError:        common.ErrorInvalidG1,
+		},
+		{
+			name:                 "invalid verifying key parse with greater number of public inputs",
+			data:                 slices.Concat(fixed, repeat(generatorG1Bytes(), 2)),
+			numberOfPublicInputs: 2,
+			expectedError:        common.ErrorInvalidG1,
+		},
+		{
+			name:                 "invalid verifying key parse with one extra k point",
+			data:                 slices.Concat(fixed, repeat(generatorG1Bytes(), 3)),
+			numberOfPublicInputs: 1,
+			expectedError:        common.ErrorInvalidG1,
+		},
+		{
+			name:                 "off-curve verifying key k point",
+			data:                 slices.Concat(fixed, generatorG1Bytes(), g1Bytes),
+			numberOfPublicInputs: 1,
+			expectedError:        common.ErrorInvalidG1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			parser := SolidityBLS12381Parser{}
+			vk, err := parser.ParseVerifyingKey(tt.data, tt.numberOfPublicInputs)
+
+			if tt.expectedError != nil {
+				assert.NotNil(t, err)
+				assert.Equal(t, tt.expectedError, err)
+
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, tt.expectedK, len(vk.(*groth16bls12381.VerifyingKey).G1.K))
+			assert.Equal(t, tt.data, SerializeVerifyingKey(vk.(*groth16bls12381.VerifyingKey)))
+		})
+	}
+}
+
+func TestParseVerifyingKeyProperties(t *testing.T) {
+	parameters := gopter.DefaultTestParameters()
+	properties := gopter.NewProperties(parameters)
+	max := 8
+
+	for index := range max {
+		properties.Property("ParseVerifyingKey returns correct verifying key", prop.ForAll(
+			func(input []byte) bool {
+				parser := SolidityBLS12381Parser{}
+
+				verifyingKey1, err := parser.ParseVerifyingKey(input, index)
+
+				if err != nil {
+					return false
+				}
+
+				serialized1 := SerializeVerifyingKey(verifyingKey1.(*groth16bls12381.VerifyingKey))
+				verifyingKey2, err := parser.ParseVerifyingKey(serialized1, index)
+
+				if err != nil {
+					return false
+				}
+
+				return !verifyingKey1.IsDifferent(verifyingKey2)
+			},
+			VerifyingKeyGenerator(index),
+		))
+	}
+
+	properties.TestingRun(t)
+}
+
+func TestParsePublicWitness(t *testing.T) {
+	tests := []struct {
+		name                 string
+		data                 []byte
+		numberOfPublicInputs int
+		witness              witness.Witness
+		expectedError        error
+	}{
+		{
+			name:                 "normal public witness parse",
+			data:                 make([]byte, BLS12381Groth16SinglePublicInputSize),
+			numberOfPublicInputs: 1,
+			witness: func() witness.Witness {
+				w, _ := witness.New(ecc.BLS12_381.ScalarField())
+
+				data := append(
+					[]byte{
+						0, 0, 0, 1, // nbPublic
+						0, 0, 0, 0, // nbSecret
+						0, 0, 0, 1, // vector length
+					},
+					make([]byte, BLS12381Groth16SinglePublicInputSize)..., // 32-byte zero field element
+				)
+
+				_ = w.UnmarshalBinary(data)
+
+				return w
+			}(),
+		},
+		{
+			name:                 "invalid public witness parse with greater number of public inputs",
+			data:                 make([]byte, BLS12381Groth16SinglePublicInputSize),
+			numberOfPublicInputs: 2,
+			expectedError:        common.ErrorInvalidPublicWitnessSlice,
+		},
+		{
+			name:                 "invalid public witness parse with empty input",
+			data:                 []byte{},
+			numberOfPublicInputs: 1,
+			expectedError:        common.ErrorInvalidPublicWitnessSlice,
+		},
+		{
+			name:                 "invalid public witness parse with negative number of public inputs",
+			data:                 make([]byte, BLS12381Groth16SinglePublicInputSize),
+			numberOfPublicInputs: -1,
+			expectedError:        common.ErrorInvalidPublicWitnessSlice,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			parser := SolidityBLS12381Parser{}
+			result, err := parser.ParsePublicWitness(tt.data, tt.numberOfPublicInputs)
+
+			if tt.expectedError != nil {
+				assert.NotNil(t, err)
+				assert.True(t, errors.Is(err, tt.expectedError))
+
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, tt.witness, result)
+		})
+	}
+}
+
+func TestParsePublicWitnessProperties(t *testing.T) {
+	parameters := gopter.DefaultTestParameters()
+	properties := gopter.NewProperties(parameters)
+
+	properties.Property("ParsePublicWitness returns correct public witness", prop.ForAll(
+		func(input []byte) bool {
+			if len(input) == 0 || len(input)%BLS12381Groth16SinglePublicInputSize != 0 {
+				return true
+			}
+
+			parser := SolidityBLS12381Parser{}
+
+			result, err := parser.ParsePublicWitness(input, len(input)/BLS12381Groth16SinglePublicInputSize)
+
+			if err != nil {
+				return false
+			}
+
+			parsed, err := result.MarshalBinary()
+
+			if err != nil {
+				return false
+			}
+
+			publicInputs, err := bn254.StripWitnessHeader(parsed)
+
+			if err != nil {
+				return false
+			}
+
+			return bytes.Equal(input, publicInputs)
+		},
+		WitnessBytesGenerator(),
+	))
+
+	properties.TestingRun(t)
+}
+
+// generatorG1Bytes returns the encoding of the BLS12-381 G1 generator.
+func generatorG1Bytes() []byte {
+	_, _, g1, _ := bls12381.Generators()
+
+	return g1.Marshal()
+}
+
+// generatorG2Bytes returns the encoding of the BLS12-381 G2 generator.
+func generatorG2Bytes() []byte {
+	_, _, _, g2 := bls12381.Generators()
+
+	return g2.Marshal()
+}
+
+// g2NotInSubgroupBytes returns the encoding of a point on the BLS12-381 G2 twist
+// that lies outside of the prime-order subgroup.
+func g2NotInSubgroupBytes() []byte {
+	_, _, _, g2 := bls12381.Generators()
+
+	// Recover the twist coefficient b' = y^2 - x^3 from the generator.
+	var b, x3 bls12381.E2
+	b.Square(&g2.Y)
+	x3.Square(&g2.X).Mul(&x3, &g2.X)
+	b.Sub(&b, &x3)
+
+	for i := uint64(1); ; i++ {
+		var point bls12381.G2Affine
+		var rhs bls12381.E2
+
+		point.X.A0.SetUint64(i)
+		rhs.Square(&point.X).Mul(&rhs, &point.X).Add(&rhs, &b)
+
+		if rhs.Legendre() != 1 {
+			continue
+		}
+
+		point.Y.Sqrt(&rhs)
+
+		if point.IsOnCurve() && !point.IsInSubGroup() {
+			return point.Marshal()
+		}
+	}
+}
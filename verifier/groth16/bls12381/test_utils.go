@@ -0,0 +1,203 @@
+package bls12381
+
+import (
+	"math"
+	"math/big"
+	"reflect"
+
+	bls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+	groth16bls12381 "github.com/consensys/gnark/backend/groth16/bls12-381"
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/gen"
+)
+
+// G1AffineGenerator returns a gopter generator for random BLS12-381 G1 affine points.
+// It generates a non-zero uint64 scalar and multiplies the G1 generator by it, so
+// every produced point lies on the curve and in the prime-order subgroup.
+func G1AffineGenerator() gopter.Gen {
+	return gen.UInt64Range(1, math.MaxUint64).Map(func(value uint64) *bls12381.G1Affine {
+		point := &bls12381.G1Affine{}
+		point.ScalarMultiplicationBase(new(big.Int).SetUint64(value))
+
+		return point
+	})
+}
+
+// G2AffineGenerator returns a gopter generator for random BLS12-381 G2 affine points.
+// It generates a non-zero uint64 scalar and multiplies the G2 generator by it, so
+// every produced point lies on the curve and in the prime-order subgroup.
+func G2AffineGenerator() gopter.Gen {
+	return gen.UInt64Range(1, math.MaxUint64).Map(func(value uint64) *bls12381.G2Affine {
+		point := &bls12381.G2Affine{}
+		point.ScalarMultiplicationBase(new(big.Int).SetUint64(value))
+
+		return point
+	})
+}
+
+// ProofStruct represents the Groth16 proof
+type ProofStruct struct {
+	Ar  *bls12381.G1Affine
+	Bs  *bls12381.G2Affine
+	Krs *bls12381.G1Affine
+}
+
+// ProofBytesGenerator returns a gopter generator that produces a byte slice
+// representing a Groth16 proof in the form [G1 | G2 | G1] for the BLS12-381 curve.
+func ProofBytesGenerator() gopter.Gen {
+	return gen.Struct(reflect.TypeOf(ProofStruct{}), map[string]gopter.Gen{
+		"Ar":  G1AffineGenerator(),
+		"Bs":  G2AffineGenerator(),
+		"Krs": G1AffineGenerator(),
+	}).Map(func(value ProofStruct) []byte {
+		proof := &groth16bls12381.Proof{}
+
+		proof.Ar = *value.Ar
+		proof.Bs = *value.Bs
+		proof.Krs = *value.Krs
+
+		return SerializeProof(proof)
+	})
+}
+
+// SerializeProof converts a gnark Groth16 proof into a byte slice.
+func SerializeProof(value *groth16bls12381.Proof) []byte {
+	out := make([]byte, 0)
+
+	x := value.Ar.X.Bytes()
+	y := value.Ar.Y.Bytes()
+	out = append(out, x[:]...)
+	out = append(out, y[:]...)
+
+	x1 := value.Bs.X.A1.Bytes()
+	x0 := value.Bs.X.A0.Bytes()
+	y1 := value.Bs.Y.A1.Bytes()
+	y0 := value.Bs.Y.A0.Bytes()
+	out = append(out, x1[:]...)
+	out = append(out, x0[:]...)
+	out = append(out, y1[:]...)
+	out = append(out, y0[:]...)
+
+	x = value.Krs.X.Bytes()
+	y = value.Krs.Y.Bytes()
+	out = append(out, x[:]...)
+	out = append(out, y[:]...)
+
+	return out
+}
+
+// G1Struct represents the G1 components of a Groth16 verifying key.
+type G1Struct struct {
+	Alpha, Beta, Delta *bls12381.G1Affine   // Key points in G1
+	K                  []*bls12381.G1Affine // Array of G1 points corresponding to public inputs + 1
+}
+
+// G2Struct represents the G2 components of a Groth16 verifying key.
+type G2Struct struct {
+	Beta, Delta, Gamma *bls12381.G2Affine // Key points in G2
+}
+
+// VKStruct combines G1 and G2 parts for property-based testing.
+type VKStruct struct {
+	G1 G1Struct
+	G2 G2Struct
+}
+
+// VerifyingKeyGenerator generates randomized Groth16 verifying keys for property tests.
+func VerifyingKeyGenerator(numberOfPublicInputs int) gopter.Gen {
+	return gen.Struct(reflect.TypeOf(VKStruct{}), map[string]gopter.Gen{
+		"G1": gen.Struct(reflect.TypeOf(G1Struct{}), map[string]gopter.Gen{
+			"Alpha": G1AffineGenerator(),
+			"Beta":  G1AffineGenerator(),
+			"Delta": G1AffineGenerator(),
+			"K":     gen.SliceOfN(numberOfPublicInputs+1, G1AffineGenerator()),
+		}),
+		"G2": gen.Struct(reflect.TypeOf(G2Struct{}), map[string]gopter.Gen{
+			"Beta":  G2AffineGenerator(),
+			"Delta": G2AffineGenerator(),
+			"Gamma": G2AffineGenerator(),
+		}),
+	}).Map(func(value VKStruct) []byte {
+		vk := &groth16bls12381.VerifyingKey{}
+
+		vk.G1.Alpha = *value.G1.Alpha
+		vk.G1.Beta = *value.G1.Beta
+		vk.G1.Delta = *value.G1.Delta
+
+		vk.G1.K = make([]bls12381.G1Affine, len(value.G1.K))
+
+		for i, k := range value.G1.K {
+			vk.G1.K[i] = *k
+		}
+
+		vk.G2.Beta = *value.G2.Beta
+		vk.G2.Gamma = *value.G2.Gamma
+		vk.G2.Delta = *value.G2.Delta
+
+		return SerializeVerifyingKey(vk)
+	})
+}
+
+// SerializeVerifyingKey converts a gnark Groth16 verifying key into a byte slice.
+func SerializeVerifyingKey(value *groth16bls12381.VerifyingKey) []byte {
+	out := make([]byte, 0)
+
+	serializeG1 := func(p bls12381.G1Affine) {
+		x := p.X.Bytes()
+		y := p.Y.Bytes()
+		out = append(out, x[:]...)
+		out = append(out, y[:]...)
+	}
+
+	serializeG2 := func(p bls12381.G2Affine) {
+		x1 := p.X.A1.Bytes()
+		x0 := p.X.A0.Bytes()
+		y1 := p.Y.A1.Bytes()
+		y0 := p.Y.A0.Bytes()
+
+		out = append(out, x1[:]...)
+		out = append(out, x0[:]...)
+		out = append(out, y1[:]...)
+		out = append(out, y0[:]...)
+	}
+
+	serializeG1(value.G1.Alpha)
+	serializeG2(value.G2.Beta)
+	serializeG2(value.G2.Gamma)
+	serializeG2(value.G2.Delta)
+
+	for _, k := range value.G1.K {
+		serializeG1(k)
+	}
+
+	return out
+}
+
+// ScalarGenerator returns a gopter generator for random BLS12-381 scalar
+// field elements. It draws BLS12381Groth16SinglePublicInputSize random bytes and
+// reduces them modulo the scalar field order, so every produced value is a
+// valid public input.
+func ScalarGenerator() gopter.Gen {
+	return gen.SliceOfN(BLS12381Groth16SinglePublicInputSize, gen.UInt8()).Map(func(bytes []byte) *big.Int {
+		x := new(big.Int).SetBytes(bytes)
+
+		return x.Mod(x, fr.Modulus())
+	})
+}
+
+// WitnessBytesGenerator returns a gopter generator that produces byte slices
+// representing sequences of BLS12-381 field elements suitable for use as public witnesses.
+func WitnessBytesGenerator() gopter.Gen {
+	return gen.SliceOf(ScalarGenerator().Map(func(v *big.Int) []byte {
+		return v.FillBytes(make([]byte, BLS12381Groth16SinglePublicInputSize))
+	})).Map(func(chunks [][]byte) []byte {
+		out := make([]byte, 0, len(chunks)*BLS12381Groth16SinglePublicInputSize)
+
+		for _, chunk := range chunks {
+			out = append(out, chunk...)
+		}
+
+		return out
+	})
+}
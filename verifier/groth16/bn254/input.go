@@ -0,0 +1,86 @@
+package bn254
+
+import (
+	"encoding/binary"
+
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	groth16bn254 "github.com/consensys/gnark/backend/groth16/bn254"
+	"github.com/consensys/gnark/backend/witness"
+)
+
+// BuildVerifyInput assembles the BN254Groth16Verify Run input for a gnark
+// proof, verifying key and public witness.
+//
+// The public inputs are taken from the witness vector and encoded as
+// BN254Groth16SinglePublicInputSize-byte big-endian field elements, so the
+// binary witness header never has to be stripped by hand.
+//
+// Proofs and verifying keys without commitments use the plain layout:
+//
+//	[ Proof || VerifyingKey || PublicInputs ]
+//
+// Otherwise the commitment extensions are emitted with the extended
+// layout, whose leading BN254Groth16ExtendedInputFlag byte and
+// BN254Groth16ExtendedInputLengthSize-byte lengths match the
+// Groth16Verify constants:
+//
+//	[ Flag || ProofLength || Proof || VerifyingKeyLength || VerifyingKey || PublicInputs ]
+//
+// Returns ErrorInvalidVerifyInput if any argument is nil, the witness is
+// not a BN254 witness, or its number of elements does not match the
+// public inputs of the verifying key.
+func BuildVerifyInput(
+	proof *groth16bn254.Proof,
+	vk *groth16bn254.VerifyingKey,
+	pub witness.Witness,
+) ([]byte, error) {
+	if proof == nil || vk == nil || pub == nil {
+		return nil, ErrorInvalidVerifyInput
+	}
+
+	values, ok := pub.Vector().(fr.Vector)
+	numberOfPublicInputs := len(vk.G1.K) - 1 - len(vk.CommitmentKeys)
+
+	if !ok || len(values) != numberOfPublicInputs {
+		return nil, ErrorInvalidVerifyInput
+	}
+
+	proofBytes := SerializeProof(proof)
+	vkBytes := SerializeVerifyingKey(vk)
+
+	out := make([]byte, 0, 1+2*BN254Groth16ExtendedInputLengthSize+len(proofBytes)+len(vkBytes)+len(values)*BN254Groth16SinglePublicInputSize)
+
+	if len(proof.Commitments) == 0 && len(vk.CommitmentKeys) == 0 {
+		out = append(out, proofBytes...)
+		out = append(out, vkBytes...)
+	} else {
+		out = append(out, BN254Groth16ExtendedInputFlag)
+		out = binary.BigEndian.AppendUint32(out, uint32(len(proofBytes)))
+		out = append(out, proofBytes...)
+		out = binary.BigEndian.AppendUint32(out, uint32(len(vkBytes)))
+		out = append(out, vkBytes...)
+	}
+
+	for _, value := range values {
+		bytes := value.Bytes()
+		out = append(out, bytes[:]...)
+	}
+
+	return out, nil
+}
+
+// StripWitnessHeader returns the field elements of a witness serialized
+// with gnark's witness.Witness MarshalBinary, dropping the
+// BN254Groth16WitnessHeaderSize-byte header. The result is the
+// PublicInputs section of the Run input layout.
+//
+// The returned slice shares its backing array with b.
+//
+// Returns ErrorInvalidWitnessHeader if b is shorter than the header.
+func StripWitnessHeader(b []byte) ([]byte, error) {
+	if len(b) < BN254Groth16WitnessHeaderSize {
+		return nil, ErrorInvalidWitnessHeader
+	}
+
+	return b[BN254Groth16WitnessHeaderSize:], nil
+}
@@ -0,0 +1,71 @@
+package bn254
+
+import (
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark/frontend"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStripWitnessHeader(t *testing.T) {
+	assignment := &VariablePublicCircuit{Public: []frontend.Variable{1, 2}}
+	publicWitness, _ := frontend.NewWitness(assignment, ecc.BN254.ScalarField(), frontend.PublicOnly())
+	witnessBytes, _ := publicWitness.MarshalBinary()
+
+	one := make([]byte, BN254Groth16SinglePublicInputSize)
+	one[BN254Groth16SinglePublicInputSize-1] = 1
+
+	two := make([]byte, BN254Groth16SinglePublicInputSize)
+	two[BN254Groth16SinglePublicInputSize-1] = 2
+
+	tests := []struct {
+		name          string
+		input         []byte
+		expected      []byte
+		expectedError error
+	}{
+		{
+			name:     "well-formed witness",
+			input:    witnessBytes,
+			expected: append(one, two...),
+		},
+		{
+			name:     "header only",
+			input:    make([]byte, BN254Groth16WitnessHeaderSize),
+			expected: []byte{},
+		},
+		{
+			name:          "empty input",
+			input:         []byte{},
+			expectedError: ErrorInvalidWitnessHeader,
+		},
+		{
+			name:          "nil input",
+			input:         nil,
+			expectedError: ErrorInvalidWitnessHeader,
+		},
+		{
+			name:          "11 bytes",
+			input:         make([]byte, BN254Groth16WitnessHeaderSize-1),
+			expectedError: ErrorInvalidWitnessHeader,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, err := StripWitnessHeader(tt.input)
+
+			if tt.expectedError != nil {
+				assert.NotNil(t, err)
+				assert.Equal(t, tt.expectedError, err)
+				assert.Nil(t, actual)
+
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
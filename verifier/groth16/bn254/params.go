@@ -1,13 +1,33 @@
 package bn254
 
+import "errors"
+
 // BN254 Groth16 Verifier precompile constants
 const (
+	// BN254Groth16PairingGas defines the gas cost of the pairing check of
+	// a Groth16 verification over BN254: the EIP-1108 ecPairing price of
+	// 45000 + 34000 per pair, for four pairs.
+	BN254Groth16PairingGas = 45000 + 4*34000
+
+	// BN254Groth16G2ParseGas defines the gas cost of parsing one G2 point,
+	// dominated by its prime-order subgroup check. A verification parses
+	// four G2 points: Bs from the proof and Beta, Gamma and Delta from the
+	// verifying key.
+	BN254Groth16G2ParseGas = 9750
+
 	// BN254Groth16VerifyBaseGas defines the base gas cost for executing
 	// the Groth16 verification precompile over the BN254 curve.
 	//
-	// The value is fixed and does not include additional dynamic costs
-	// related to public input processing.
-	BN254Groth16VerifyBaseGas = 220000
+	// It is the pairing check plus the parsing of the four G2 points; G1
+	// checks are negligible since the BN254 G1 cofactor is 1. The value
+	// does not include the dynamic cost of public input processing, see
+	// BN254Groth16PerPublicInputGas.
+	BN254Groth16VerifyBaseGas = BN254Groth16PairingGas + 4*BN254Groth16G2ParseGas
+
+	// BN254Groth16PerPublicInputGas defines the gas cost charged per public
+	// input for its term of the IC linear combination: the EIP-1108 prices
+	// of one ecMul (6000) and one ecAdd (150).
+	BN254Groth16PerPublicInputGas = 6000 + 150
 
 	// BN254Groth16ProofSize defines the expected byte size of a serialized
 	// Groth16 proof over BN254.
@@ -33,6 +53,22 @@ const (
 	// appended dynamically depending on the circuit.
 	BN254Groth16VerifyVerifyingKeySize = 448
 
+	// BN254Groth16ProofCompressedSize defines the expected byte size of a
+	// serialized Groth16 proof over BN254 in compressed form.
+	//
+	// The proof holds the same A, B and C elements as
+	// BN254Groth16ProofSize, each encoded in gnark's compressed form.
+	BN254Groth16ProofCompressedSize = 2*BN254Groth16G1CompressedSize + BN254Groth16G2CompressedSize
+
+	// BN254Groth16VerifyingKeyCompressedSize defines the expected byte size
+	// of the fixed part of a serialized Groth16 verifying key over BN254 in
+	// compressed form.
+	//
+	// It holds the same Alpha, Beta, Gamma and Delta elements as
+	// BN254Groth16VerifyVerifyingKeySize, each encoded in gnark's compressed
+	// form. The IC points follow as compressed G1 elements.
+	BN254Groth16VerifyingKeyCompressedSize = BN254Groth16G1CompressedSize + 3*BN254Groth16G2CompressedSize
+
 	// BN254Groth16G1Size defines the byte size of a serialized BN254
 	// G1 affine point in uncompressed form.
 	//
@@ -47,6 +83,16 @@ const (
 	// where each field element contains two 32-byte field elements.
 	BN254Groth16G2Size = 128
 
+	// BN254Groth16G1CompressedSize defines the byte size of a serialized
+	// BN254 G1 affine point in gnark's compressed form: the X coordinate
+	// with the sign of Y and the infinity flag packed into its top bits.
+	BN254Groth16G1CompressedSize = 32
+
+	// BN254Groth16G2CompressedSize defines the byte size of a serialized
+	// BN254 G2 affine point in gnark's compressed form: X.A1 || X.A0 with
+	// the sign of Y and the infinity flag packed into the top bits of X.A1.
+	BN254Groth16G2CompressedSize = 64
+
 	// BN254Groth16SinglePublicInputSize defines the byte size of a single
 	// public input field element for BN254.
 	//
@@ -59,4 +105,73 @@ const (
 	// BN254 operates over a 254-bit prime field, which is encoded using
 	// 32 bytes in big-endian representation.
 	BN254Groth16FieldSize = 32
+
+	// BN254Groth16CommitmentCountSize defines the byte size of the
+	// big-endian commitment count that introduces the optional commitment
+	// extension of a proof or verifying key.
+	//
+	// Proofs and verifying keys without the extension keep the plain
+	// layout, so existing encodings remain valid.
+	BN254Groth16CommitmentCountSize = 4
+
+	// BN254Groth16CommitmentIndexSize defines the byte size of a single
+	// big-endian entry of the commitment-committed index metadata.
+	BN254Groth16CommitmentIndexSize = 4
+
+	// BN254Groth16CommitmentKeySize defines the byte size of a serialized
+	// Pedersen commitment verifying key, made of the G and GSigmaNeg G2
+	// points.
+	BN254Groth16CommitmentKeySize = 2 * BN254Groth16G2Size
+
+	// BN254Groth16MaxCommitments defines the maximum number of Pedersen
+	// commitments accepted in a proof or verifying key extension.
+	BN254Groth16MaxCommitments = 8
+
+	// BN254Groth16ExtendedInputFlag defines the leading byte of the
+	// extended Run input layout emitted by BuildVerifyInput. It must match
+	// groth16.Groth16ExtendedInputFlag, which cannot be imported here.
+	BN254Groth16ExtendedInputFlag = 0x80
+
+	// BN254Groth16ExtendedInputLengthSize defines the byte size of the
+	// big-endian proof and verifying key lengths of the extended Run input
+	// layout. It must match groth16.Groth16ExtendedInputLengthSize.
+	BN254Groth16ExtendedInputLengthSize = 4
+
+	// BN254Groth16WitnessHeaderSize defines the byte size of the header that
+	// gnark's witness.Witness MarshalBinary prepends to the field elements:
+	//
+	//	nbPublic || nbSecret || length
+	//
+	// Where each value is a 4-byte big-endian integer and length is the
+	// number of elements that follow. The layout does not depend on the
+	// curve, so the same header precedes BLS12-381 and BLS12-377 witnesses.
+	BN254Groth16WitnessHeaderSize = 12
+)
+
+var (
+	// ErrorInvalidCommitmentExtension is returned when the commitment
+	// extension of a proof or verifying key is truncated, has trailing
+	// bytes, declares too many commitments, or references public inputs
+	// that do not exist.
+	ErrorInvalidCommitmentExtension = errors.New("invalid commitment extension")
+
+	// ErrorInvalidVerifyInput is returned by BuildVerifyInput when the
+	// proof, verifying key or public witness is missing, or the witness
+	// does not hold one BN254 scalar per public input of the verifying key.
+	ErrorInvalidVerifyInput = errors.New("invalid verify input")
+
+	// ErrorInvalidWitnessHeader is returned by StripWitnessHeader when the
+	// witness bytes are shorter than BN254Groth16WitnessHeaderSize.
+	ErrorInvalidWitnessHeader = errors.New("invalid witness header")
+
+	// ErrorPublicWitnessOutOfField is returned by ParsePublicWitness on a
+	// parser with StrictPublicInputs set when a public input is greater than
+	// or equal to the BN254 scalar field modulus.
+	ErrorPublicWitnessOutOfField = errors.New("public witness out of field")
+
+	// ErrorInvalidSnarkjsJSON is returned by the snarkjs importers when the
+	// JSON cannot be decoded, is not a Groth16 BN254 artifact, is missing a
+	// field, or holds a coordinate that is not a canonical decimal field
+	// element.
+	ErrorInvalidSnarkjsJSON = errors.New("invalid snarkjs json")
 )
@@ -1,11 +1,13 @@
 package bn254
 
 import (
+	"encoding/binary"
 	"errors"
-	"math/big"
 
 	"github.com/consensys/gnark-crypto/ecc"
 	"github.com/consensys/gnark-crypto/ecc/bn254"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr/pedersen"
 	"github.com/consensys/gnark/backend/groth16"
 	groth16bn254 "github.com/consensys/gnark/backend/groth16/bn254"
 	"github.com/consensys/gnark/backend/witness"
@@ -21,8 +23,15 @@ import (
 //   - Public witness inputs
 //
 // All elements are expected to be encoded in uncompressed affine form,
-// using big-endian field element representation.
-type SolidityBN254Parser struct{}
+// using big-endian field element representation. G2 points use the EIP-197
+// ordering described in ParseG2EIP197, so the arguments of a snarkjs
+// Solidity verifier call can be concatenated as is.
+type SolidityBN254Parser struct {
+	// StrictPublicInputs makes ParsePublicWitness reject public inputs
+	// greater than or equal to the scalar field modulus with
+	// ErrorPublicWitnessOutOfField, instead of reducing them.
+	StrictPublicInputs bool
+}
 
 // ParseG1 parses a BN254 G1 affine point from data starting at the given offset.
 //
@@ -60,7 +69,8 @@ func ParseG1(
 //   - 32 bytes Y.A1
 //   - 32 bytes Y.A0
 //
-// Each component is a field element encoded in big-endian format.
+// Each component is a field element encoded in big-endian format, with the
+// imaginary part first as specified by EIP-197 (see ParseG2EIP197).
 // The function writes the parsed point into destination and returns
 // the updated offset. An error is returned if the byte slice is invalid.
 func ParseG2(
@@ -95,6 +105,125 @@ func ParseG2(
 	return offset + BN254Groth16G2Size, nil
 }
 
+// ParseG2EIP197 parses a BN254 G2 affine point encoded as for the EVM
+// alt_bn128 pairing precompile (EIP-197), starting at the given offset.
+//
+// EIP-197 places the imaginary part of each Fp2 coordinate first:
+//
+//	X.A1 || X.A0 || Y.A1 || Y.A0
+//
+// This is the ordering already read by ParseG2, produced by gnark's Marshal
+// and MarshalSolidity, and passed as [[x1, x0], [y1, y0]] to snarkjs
+// Solidity verifiers by snarkjs' exportSolidityCallData. It differs from
+// the pi_b field of a snarkjs proof.json, which lists [x0, x1] and
+// [y0, y1] with the real part first and must be swapped before parsing.
+//
+// The function writes the parsed point into destination and returns the
+// updated offset. An error is returned if the byte slice is invalid.
+func ParseG2EIP197(
+	data []byte,
+	offset int,
+	destination *bn254.G2Affine,
+) (int, error) {
+	return ParseG2(data, offset, destination)
+}
+
+// ParseValidatedG1 parses a BN254 G1 affine point using ParseG1 and
+// additionally checks that it lies on the curve and in the prime-order
+// subgroup.
+//
+// It returns common.ErrorInvalidG1 if either check fails.
+func ParseValidatedG1(
+	data []byte,
+	offset int,
+	destination *bn254.G1Affine,
+) (int, error) {
+	next, err := ParseG1(data, offset, destination)
+
+	if err != nil {
+		return offset, err
+	}
+
+	if !destination.IsOnCurve() || !destination.IsInSubGroup() {
+		return offset, common.ErrorInvalidG1
+	}
+
+	return next, nil
+}
+
+// ParseValidatedG2 parses a BN254 G2 affine point using ParseG2 and
+// additionally checks that it lies on the curve and in the prime-order
+// subgroup.
+//
+// It returns common.ErrorInvalidG2 if either check fails.
+func ParseValidatedG2(
+	data []byte,
+	offset int,
+	destination *bn254.G2Affine,
+) (int, error) {
+	next, err := ParseG2(data, offset, destination)
+
+	if err != nil {
+		return offset, err
+	}
+
+	if !destination.IsOnCurve() || !destination.IsInSubGroup() {
+		return offset, common.ErrorInvalidG2
+	}
+
+	return next, nil
+}
+
+// ParseCompressedG1 parses a BN254 G1 affine point in gnark's compressed
+// form from data starting at the given offset, using G1Affine.SetBytes.
+//
+// It writes the parsed point into destination and returns the new offset.
+// common.ErrorInvalidG1 is returned if the slice is out of bounds, is not a
+// compressed encoding, or does not decode to a point in the prime-order
+// subgroup.
+func ParseCompressedG1(
+	data []byte,
+	offset int,
+	destination *bn254.G1Affine,
+) (int, error) {
+	slice, ok := utils.SafeSlice(data, offset, offset+BN254Groth16G1CompressedSize)
+
+	if !ok {
+		return offset, common.ErrorInvalidG1
+	}
+
+	if n, err := destination.SetBytes(slice); err != nil || n != BN254Groth16G1CompressedSize {
+		return offset, common.ErrorInvalidG1
+	}
+
+	return offset + BN254Groth16G1CompressedSize, nil
+}
+
+// ParseCompressedG2 parses a BN254 G2 affine point in gnark's compressed
+// form from data starting at the given offset, using G2Affine.SetBytes.
+//
+// It writes the parsed point into destination and returns the new offset.
+// common.ErrorInvalidG2 is returned if the slice is out of bounds, is not a
+// compressed encoding, or does not decode to a point in the prime-order
+// subgroup.
+func ParseCompressedG2(
+	data []byte,
+	offset int,
+	destination *bn254.G2Affine,
+) (int, error) {
+	slice, ok := utils.SafeSlice(data, offset, offset+BN254Groth16G2CompressedSize)
+
+	if !ok {
+		return offset, common.ErrorInvalidG2
+	}
+
+	if n, err := destination.SetBytes(slice); err != nil || n != BN254Groth16G2CompressedSize {
+		return offset, common.ErrorInvalidG2
+	}
+
+	return offset + BN254Groth16G2CompressedSize, nil
+}
+
 // ParseProof parses a serialized Groth16 proof over BN254.
 //
 // The expected layout is:
@@ -102,34 +231,121 @@ func ParseG2(
 //   - G2 element Bs
 //   - G1 element Krs
 //
-// Each element must be encoded in uncompressed affine form.
-// An error is returned if parsing fails at any step.
+// Proofs of circuits using gnark commitments (api.Commit) append the
+// commitment extension parsed by parseProofCommitments. Data ending right
+// after Krs is a proof without commitments.
+//
+// Each element must be encoded in uncompressed affine form and must be a
+// valid curve point in the prime-order subgroup. An error is returned if
+// parsing or validation fails at any step.
 func (p *SolidityBN254Parser) ParseProof(data []byte) (groth16.Proof, error) {
 	var proof groth16bn254.Proof
 	var err error
 	var offset int = 0
 
-	offset, err = ParseG1(data, offset, &proof.Ar)
+	offset, err = ParseValidatedG1(data, offset, &proof.Ar)
 
 	if err != nil {
 		return nil, err
 	}
 
-	offset, err = ParseG2(data, offset, &proof.Bs)
+	offset, err = ParseValidatedG2(data, offset, &proof.Bs)
 
 	if err != nil {
 		return nil, err
 	}
 
-	_, err = ParseG1(data, offset, &proof.Krs)
+	offset, err = ParseValidatedG1(data, offset, &proof.Krs)
 
 	if err != nil {
 		return nil, err
 	}
 
+	if offset < len(data) {
+		if err := parseProofCommitments(data, offset, &proof); err != nil {
+			return nil, err
+		}
+	}
+
 	return &proof, nil
 }
 
+// ParseProofCompressed parses a serialized Groth16 proof over BN254 whose
+// elements use gnark's compressed point encoding.
+//
+// The expected layout is:
+//   - compressed G1 element Ar
+//   - compressed G2 element Bs
+//   - compressed G1 element Krs
+//
+// The data must be exactly BN254Groth16ProofCompressedSize bytes long;
+// proofs with commitments are not supported in compressed form. An error
+// is returned if parsing or validation fails at any step.
+func (p *SolidityBN254Parser) ParseProofCompressed(data []byte) (groth16.Proof, error) {
+	var proof groth16bn254.Proof
+	var err error
+	var offset int = 0
+
+	if len(data) != BN254Groth16ProofCompressedSize {
+		return nil, common.ErrorInvalidG1
+	}
+
+	offset, err = ParseCompressedG1(data, offset, &proof.Ar)
+
+	if err != nil {
+		return nil, err
+	}
+
+	offset, err = ParseCompressedG2(data, offset, &proof.Bs)
+
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = ParseCompressedG1(data, offset, &proof.Krs)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &proof, nil
+}
+
+// parseProofCommitments parses the commitment extension of a proof
+// starting at offset.
+//
+// The expected layout is:
+//   - BN254Groth16CommitmentCountSize bytes commitment count c (big-endian)
+//   - c G1 elements Commitments
+//   - G1 element CommitmentPok
+//
+// The extension must end exactly at the end of data.
+func parseProofCommitments(data []byte, offset int, proof *groth16bn254.Proof) error {
+	count, offset, err := readCommitmentCount(data, offset)
+
+	if err != nil {
+		return err
+	}
+
+	if len(data) != offset+(count+1)*BN254Groth16G1Size {
+		return ErrorInvalidCommitmentExtension
+	}
+
+	proof.Commitments = make([]bn254.G1Affine, count)
+
+	for index := range proof.Commitments {
+		offset, err = ParseValidatedG1(data, offset, &proof.Commitments[index])
+
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err = ParseValidatedG1(data, offset, &proof.CommitmentPok)
+
+	return err
+}
+
 // ParseVerifyingKey parses a serialized Groth16 verifying key over BN254.
 //
 // The expected layout is:
@@ -139,6 +355,15 @@ func (p *SolidityBN254Parser) ParseProof(data []byte) (groth16.Proof, error) {
 //   - G2 Delta
 //   - (numberOfPublicInputs + 1) G1 elements for the IC (input commitments)
 //
+// Verifying keys of circuits using gnark commitments (api.Commit) append
+// the commitment extension parsed by parseVerifyingKeyCommitments. Data
+// ending right after the IC points is a verifying key without commitments.
+//
+// The IC point count must match numberOfPublicInputs: common.ErrorInvalidG1
+// is returned if data holds fewer IC points, or if the bytes following them
+// are whole G1 points rather than a valid commitment extension.
+//
+// Every point must lie on the curve and in the prime-order subgroup.
 // After parsing, vk.Precompute() is called to prepare internal pairing
 // values (e.g., gammaNeg, deltaNeg). An error is returned if parsing or
 // precomputation fails.
@@ -147,40 +372,56 @@ func (p *SolidityBN254Parser) ParseVerifyingKey(data []byte, numberOfPublicInput
 	var err error
 	var offset int = 0
 
-	offset, err = ParseG1(data, offset, &vk.G1.Alpha)
+	offset, err = ParseValidatedG1(data, offset, &vk.G1.Alpha)
 
 	if err != nil {
 		return nil, err
 	}
 
-	offset, err = ParseG2(data, offset, &vk.G2.Beta)
+	offset, err = ParseValidatedG2(data, offset, &vk.G2.Beta)
 
 	if err != nil {
 		return nil, err
 	}
 
-	offset, err = ParseG2(data, offset, &vk.G2.Gamma)
+	offset, err = ParseValidatedG2(data, offset, &vk.G2.Gamma)
 
 	if err != nil {
 		return nil, err
 	}
 
-	offset, err = ParseG2(data, offset, &vk.G2.Delta)
+	offset, err = ParseValidatedG2(data, offset, &vk.G2.Delta)
 
 	if err != nil {
 		return nil, err
 	}
 
+	if numberOfPublicInputs < 0 || len(data)-offset < (numberOfPublicInputs+1)*BN254Groth16G1Size {
+		return nil, common.ErrorInvalidG1
+	}
+
 	vk.G1.K = make([]bn254.G1Affine, numberOfPublicInputs+1)
 
 	for index := range vk.G1.K {
-		offset, err = ParseG1(data, offset, &vk.G1.K[index])
+		offset, err = ParseValidatedG1(data, offset, &vk.G1.K[index])
 
 		if err != nil {
 			return nil, err
 		}
 	}
 
+	if offset < len(data) {
+		if err := parseVerifyingKeyCommitments(data, offset, numberOfPublicInputs, &vk); err != nil {
+			// Whole G1 points that do not form a commitment extension are
+			// IC points beyond the expected count.
+			if errors.Is(err, ErrorInvalidCommitmentExtension) && (len(data)-offset)%BN254Groth16G1Size == 0 {
+				return nil, common.ErrorInvalidG1
+			}
+
+			return nil, err
+		}
+	}
+
 	// Precompute the necessary values (e, gammaNeg, deltaNeg)
 	if err := vk.Precompute(); err != nil {
 		// Cannot fail through this parser
@@ -191,39 +432,237 @@ func (p *SolidityBN254Parser) ParseVerifyingKey(data []byte, numberOfPublicInput
 	return &vk, nil
 }
 
+// ParseVerifyingKeyCompressed parses a serialized Groth16 verifying key
+// over BN254 whose elements use gnark's compressed point encoding.
+//
+// The expected layout is:
+//   - compressed G1 Alpha
+//   - compressed G2 Beta
+//   - compressed G2 Gamma
+//   - compressed G2 Delta
+//   - (numberOfPublicInputs + 1) compressed G1 elements for the IC
+//
+// The data must end right after the IC points; verifying keys with
+// commitments are not supported in compressed form. As with
+// ParseVerifyingKey, vk.Precompute() is called after decoding. An error is
+// returned if parsing, validation or precomputation fails.
+func (p *SolidityBN254Parser) ParseVerifyingKeyCompressed(data []byte, numberOfPublicInputs int) (groth16.VerifyingKey, error) {
+	var vk groth16bn254.VerifyingKey
+	var err error
+	var offset int = 0
+
+	if numberOfPublicInputs < 0 ||
+		len(data) != BN254Groth16VerifyingKeyCompressedSize+(numberOfPublicInputs+1)*BN254Groth16G1CompressedSize {
+		return nil, common.ErrorInvalidG1
+	}
+
+	offset, err = ParseCompressedG1(data, offset, &vk.G1.Alpha)
+
+	if err != nil {
+		return nil, err
+	}
+
+	offset, err = ParseCompressedG2(data, offset, &vk.G2.Beta)
+
+	if err != nil {
+		return nil, err
+	}
+
+	offset, err = ParseCompressedG2(data, offset, &vk.G2.Gamma)
+
+	if err != nil {
+		return nil, err
+	}
+
+	offset, err = ParseCompressedG2(data, offset, &vk.G2.Delta)
+
+	if err != nil {
+		return nil, err
+	}
+
+	vk.G1.K = make([]bn254.G1Affine, numberOfPublicInputs+1)
+
+	for index := range vk.G1.K {
+		offset, err = ParseCompressedG1(data, offset, &vk.G1.K[index])
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Precompute the necessary values (e, gammaNeg, deltaNeg)
+	if err := vk.Precompute(); err != nil {
+		// Cannot fail through this parser
+		// Alpha and Beta points are checked before calling precompute function
+		return nil, err
+	}
+
+	return &vk, nil
+}
+
+// parseVerifyingKeyCommitments parses the commitment extension of a
+// verifying key starting at offset.
+//
+// The expected layout is:
+//   - BN254Groth16CommitmentCountSize bytes commitment count c (big-endian)
+//   - c G1 elements, the IC points of the commitment wires, appended to K
+//   - c commitment keys, each made of the G and GSigmaNeg G2 elements
+//   - c index lists, each encoded as a BN254Groth16CommitmentIndexSize-byte
+//     length m followed by m BN254Groth16CommitmentIndexSize-byte indexes
+//     of committed public inputs, in the range [1, numberOfPublicInputs]
+//
+// The extension must end exactly at the end of data.
+func parseVerifyingKeyCommitments(
+	data []byte,
+	offset int,
+	numberOfPublicInputs int,
+	vk *groth16bn254.VerifyingKey,
+) error {
+	count, offset, err := readCommitmentCount(data, offset)
+
+	if err != nil {
+		return err
+	}
+
+	commitmentWires := make([]bn254.G1Affine, count)
+
+	for index := range commitmentWires {
+		offset, err = ParseValidatedG1(data, offset, &commitmentWires[index])
+
+		if err != nil {
+			return err
+		}
+	}
+
+	vk.G1.K = append(vk.G1.K, commitmentWires...)
+	vk.CommitmentKeys = make([]pedersen.VerifyingKey, count)
+
+	for index := range vk.CommitmentKeys {
+		offset, err = ParseValidatedG2(data, offset, &vk.CommitmentKeys[index].G)
+
+		if err != nil {
+			return err
+		}
+
+		offset, err = ParseValidatedG2(data, offset, &vk.CommitmentKeys[index].GSigmaNeg)
+
+		if err != nil {
+			return err
+		}
+	}
+
+	vk.PublicAndCommitmentCommitted = make([][]int, count)
+
+	for index := range vk.PublicAndCommitmentCommitted {
+		var size int
+
+		size, offset, err = readCommitmentIndex(data, offset)
+
+		if err != nil || size > numberOfPublicInputs {
+			return ErrorInvalidCommitmentExtension
+		}
+
+		committed := make([]int, size)
+
+		for position := range committed {
+			committed[position], offset, err = readCommitmentIndex(data, offset)
+
+			if err != nil || committed[position] < 1 || committed[position] > numberOfPublicInputs {
+				return ErrorInvalidCommitmentExtension
+			}
+		}
+
+		vk.PublicAndCommitmentCommitted[index] = committed
+	}
+
+	if offset != len(data) {
+		return ErrorInvalidCommitmentExtension
+	}
+
+	return nil
+}
+
+// readCommitmentCount reads the commitment count introducing a commitment
+// extension and returns it together with the new offset. The count must be
+// between 1 and BN254Groth16MaxCommitments.
+func readCommitmentCount(data []byte, offset int) (int, int, error) {
+	slice, ok := utils.SafeSlice(data, offset, offset+BN254Groth16CommitmentCountSize)
+
+	if !ok {
+		return 0, offset, ErrorInvalidCommitmentExtension
+	}
+
+	count := binary.BigEndian.Uint32(slice)
+
+	if count == 0 || count > BN254Groth16MaxCommitments {
+		return 0, offset, ErrorInvalidCommitmentExtension
+	}
+
+	return int(count), offset + BN254Groth16CommitmentCountSize, nil
+}
+
+// readCommitmentIndex reads a single big-endian entry of the
+// commitment-committed index metadata and returns it together with the
+// new offset.
+func readCommitmentIndex(data []byte, offset int) (int, int, error) {
+	slice, ok := utils.SafeSlice(data, offset, offset+BN254Groth16CommitmentIndexSize)
+
+	if !ok {
+		return 0, offset, ErrorInvalidCommitmentExtension
+	}
+
+	return int(binary.BigEndian.Uint32(slice)), offset + BN254Groth16CommitmentIndexSize, nil
+}
+
 // ParsePublicWitness parses serialized public inputs into a gnark Witness
 // compatible with the specified curve.
 //
 // Each public input must be encoded as a 32-byte big-endian field element.
 // The numberOfPublicInputs parameter defines how many inputs are expected.
+// The data carries no gnark witness header; use StripWitnessHeader to
+// obtain it from witness.Witness MarshalBinary output.
+//
+// By default inputs greater than or equal to the scalar field modulus are
+// reduced modulo the field. With StrictPublicInputs set they are rejected
+// with ErrorPublicWitnessOutOfField.
 //
-// The parsed inputs are streamed into the witness using a channel and
-// populated via w.Fill(). An error is returned if any slice is invalid
-// or if witness construction fails.
+// The inputs are read with utils.ReadFields, then handed to w.Fill()
+// through a channel buffered to hold all of them. An error is returned if
+// any slice is invalid or if witness construction fails.
 func (p *SolidityBN254Parser) ParsePublicWitness(
 	data []byte,
 	numberOfPublicInputs int,
 ) (witness.Witness, error) {
 	publicWitness, _ := witness.New(ecc.BN254.ScalarField())
 
-	channel := make(chan any, numberOfPublicInputs)
-	offset := 0
+	values, _, ok := utils.ReadFields(data, 0, BN254Groth16FieldSize, numberOfPublicInputs)
+
+	if !ok {
+		return nil, common.ErrorInvalidPublicWitnessSlice
+	}
 
-	for range numberOfPublicInputs {
-		if slice, ok := utils.SafeSlice(data, offset, offset+BN254Groth16FieldSize); ok {
-			channel <- new(big.Int).SetBytes(slice)
-		} else {
-			return nil, errors.New("invalid slice")
+	if p.StrictPublicInputs {
+		modulus := fr.Modulus()
+
+		for _, value := range values {
+			if value.Cmp(modulus) >= 0 {
+				return nil, ErrorPublicWitnessOutOfField
+			}
 		}
+	}
+
+	// The channel is sized from the parsed values, so sending them all never blocks.
+	channel := make(chan any, len(values))
 
-		offset += BN254Groth16FieldSize
+	for _, value := range values {
+		channel <- value
 	}
 
 	close(channel)
 
-	if err := publicWitness.Fill(numberOfPublicInputs, 0, channel); err != nil {
+	if err := publicWitness.Fill(len(values), 0, channel); err != nil {
 		// Cannot fail through this parser
-		// 1. Channel always contains exactly numberOfPublicInputs elements
+		// 1. Channel always contains exactly len(values) elements
 		// 2. All elements are *big.Int, set always succeeds (SetBigInt reduces modulo field)
 		return nil, err
 	}
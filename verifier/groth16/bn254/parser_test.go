@@ -2,14 +2,22 @@ package bn254
 
 import (
 	"bytes"
+	"encoding/binary"
 	"errors"
+	"math/big"
+	"slices"
 	"testing"
 
 	"github.com/consensys/gnark-crypto/ecc"
 	"github.com/consensys/gnark-crypto/ecc/bn254"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fp"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr/pedersen"
 	"github.com/consensys/gnark/backend/groth16"
 	groth16bn254 "github.com/consensys/gnark/backend/groth16/bn254"
 	"github.com/consensys/gnark/backend/witness"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/frontend/cs/r1cs"
 	"github.com/iden3/go-iden3-crypto/babyjub"
 	"github.com/leanovate/gopter"
 	"github.com/leanovate/gopter/prop"
@@ -231,6 +239,9 @@ func TestParseG2Properties(t *testing.T) {
 }
 
 func TestParseProof(t *testing.T) {
+	offCurveG1 := utils.MarshalPoint(babyjub.NewPoint())
+	offCurveG2 := slices.Concat(offCurveG1, offCurveG1)
+
 	tests := []struct {
 		name          string
 		data          []byte
@@ -239,20 +250,12 @@ func TestParseProof(t *testing.T) {
 	}{
 		{
 			name: "normal proof parse",
-			data: func() []byte {
-				points := make([]byte, 0)
-				for i := 0; i < 4; i++ {
-					points = append(points, utils.MarshalPoint(babyjub.NewPoint())...)
-				}
-
-				return points
-			}(),
+			data: slices.Concat(generatorG1Bytes(), generatorG2Bytes(), generatorG1Bytes()),
 			expected: func() groth16.Proof {
 				var proof groth16bn254.Proof
 
-				_, _ = ParseG1(utils.MarshalPoint(babyjub.NewPoint()), 0, &proof.Ar)
-				_, _ = ParseG2(append(utils.MarshalPoint(babyjub.NewPoint()), utils.MarshalPoint(babyjub.NewPoint())...), 0, &proof.Bs)
-				_, _ = ParseG1(utils.MarshalPoint(babyjub.NewPoint()), 0, &proof.Krs)
+				_, _, proof.Ar, proof.Bs = bn254.Generators()
+				proof.Krs = proof.Ar
 
 				return &proof
 			}(),
@@ -264,21 +267,34 @@ func TestParseProof(t *testing.T) {
 		},
 		{
 			name:          "invalid proof parse (Bs)",
-			data:          utils.MarshalPoint(babyjub.NewPoint()),
+			data:          generatorG1Bytes(),
 			expectedError: errors.New("invalid G2 point"),
 		},
 		{
-			name: "invalid proof parse (Krs)",
-			data: func() []byte {
-				points := make([]byte, 0)
-				for i := 0; i < 3; i++ {
-					points = append(points, utils.MarshalPoint(babyjub.NewPoint())...)
-				}
-
-				return points
-			}(),
+			name:          "invalid proof parse (Krs)",
+			data:          slices.Concat(generatorG1Bytes(), generatorG2Bytes()),
 			expectedError: errors.New("invalid G1 point"),
 		},
+		{
+			name:          "off-curve proof parse (Ar)",
+			data:          slices.Concat(offCurveG1, generatorG2Bytes(), generatorG1Bytes()),
+			expectedError: common.ErrorInvalidG1,
+		},
+		{
+			name:          "off-curve proof parse (Bs)",
+			data:          slices.Concat(generatorG1Bytes(), offCurveG2, generatorG1Bytes()),
+			expectedError: common.ErrorInvalidG2,
+		},
+		{
+			name:          "proof parse with Bs outside of subgroup",
+			data:          slices.Concat(generatorG1Bytes(), g2NotInSubgroupBytes(), generatorG1Bytes()),
+			expectedError: common.ErrorInvalidG2,
+		},
+		{
+			name:          "off-curve proof parse (Krs)",
+			data:          slices.Concat(generatorG1Bytes(), generatorG2Bytes(), offCurveG1),
+			expectedError: common.ErrorInvalidG1,
+		},
 	}
 
 	for _, tt := range tests {
@@ -327,76 +343,275 @@ func TestParseProofProperties(t *testing.T) {
 		ProofBytesGenerator(),
 	))
 
+	properties.Property("ParseProof rejects Bs outside of the subgroup", prop.ForAll(
+		func(input []byte) bool {
+			parser := SolidityBN254Parser{}
+
+			copy(input[BN254Groth16G1Size:BN254Groth16G1Size+BN254Groth16G2Size], g2NotInSubgroupBytes())
+
+			_, err := parser.ParseProof(input)
+
+			return err == common.ErrorInvalidG2
+		},
+		ProofBytesGenerator(),
+	))
+
 	properties.TestingRun(t)
 }
 
-func TestParseVerifyingKey(t *testing.T) {
+func TestParseProofCompressed(t *testing.T) {
+	_, _, g1, g2 := bn254.Generators()
+	g1Compressed := g1.Bytes()
+	g2Compressed := g2.Bytes()
+
+	var notInSubgroup bn254.G2Affine
+	_, _ = ParseG2(g2NotInSubgroupBytes(), 0, &notInSubgroup)
+	notInSubgroupCompressed := notInSubgroup.Bytes()
+
 	tests := []struct {
-		name                 string
-		data                 []byte
-		numberOfPublicInputs int
-		expected             groth16.VerifyingKey
-		expectedError        error
+		name          string
+		data          []byte
+		expected      groth16.Proof
+		expectedError error
 	}{
 		{
-			name: "normal verifying key parse",
-			data: func() []byte {
-				points := make([]byte, 0)
-				for i := 0; i < 9; i++ {
-					points = append(points, utils.MarshalPoint(babyjub.NewPoint())...)
-				}
+			name: "normal compressed proof parse",
+			data: slices.Concat(g1Compressed[:], g2Compressed[:], g1Compressed[:]),
+			expected: &groth16bn254.Proof{
+				Ar:  g1,
+				Bs:  g2,
+				Krs: g1,
+			},
+		},
+		{
+			name:          "empty proof",
+			data:          []byte{},
+			expectedError: common.ErrorInvalidG1,
+		},
+		{
+			name:          "trailing bytes",
+			data:          slices.Concat(g1Compressed[:], g2Compressed[:], g1Compressed[:], []byte{0}),
+			expectedError: common.ErrorInvalidG1,
+		},
+		{
+			name:          "uncompressed Ar encoding",
+			data:          slices.Concat(generatorG1Bytes(), g2Compressed[:]),
+			expectedError: common.ErrorInvalidG1,
+		},
+		{
+			name:          "Ar without a curve point",
+			data:          slices.Concat(compressedG1WithoutPoint(), g2Compressed[:], g1Compressed[:]),
+			expectedError: common.ErrorInvalidG1,
+		},
+		{
+			name:          "Bs outside of subgroup",
+			data:          slices.Concat(g1Compressed[:], notInSubgroupCompressed[:], g1Compressed[:]),
+			expectedError: common.ErrorInvalidG2,
+		},
+		{
+			name:          "Krs without a curve point",
+			data:          slices.Concat(g1Compressed[:], g2Compressed[:], compressedG1WithoutPoint()),
+			expectedError: common.ErrorInvalidG1,
+		},
+	}
 
-				return points
-			}(),
-			numberOfPublicInputs: 1,
-			expected: func() groth16.VerifyingKey {
-				var vk groth16bn254.VerifyingKey
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			parser := SolidityBN254Parser{}
+			proof, err := parser.ParseProofCompressed(tt.data)
 
-				_, _ = ParseG1(utils.MarshalPoint(babyjub.NewPoint()), 0, &vk.G1.Alpha)
-				_, _ = ParseG2(append(utils.MarshalPoint(babyjub.NewPoint()), utils.MarshalPoint(babyjub.NewPoint())...), 0, &vk.G2.Beta)
-				_, _ = ParseG2(append(utils.MarshalPoint(babyjub.NewPoint()), utils.MarshalPoint(babyjub.NewPoint())...), 0, &vk.G2.Gamma)
-				_, _ = ParseG2(append(utils.MarshalPoint(babyjub.NewPoint()), utils.MarshalPoint(babyjub.NewPoint())...), 0, &vk.G2.Delta)
+			if tt.expectedError != nil {
+				assert.NotNil(t, err)
+				assert.Equal(t, tt.expectedError, err)
 
-				vk.G1.K = make([]bn254.G1Affine, 2)
+				return
+			}
 
-				for index := range vk.G1.K {
-					_, _ = ParseG1(utils.MarshalPoint(babyjub.NewPoint()), 0, &vk.G1.K[index])
-				}
+			assert.Nil(t, err)
+			assert.Equal(t, tt.expected, proof)
+		})
+	}
+}
 
-				_ = vk.Precompute()
+func TestParseProofCompressedProperties(t *testing.T) {
+	parameters := gopter.DefaultTestParameters()
+	properties := gopter.NewProperties(parameters)
 
-				return &vk
-			}(),
+	properties.Property("ParseProofCompressed matches ParseProof", prop.ForAll(
+		func(input []byte) bool {
+			parser := SolidityBN254Parser{}
+
+			expected, err := parser.ParseProof(input)
+
+			if err != nil {
+				return false
+			}
+
+			compressed := SerializeProofCompressed(expected.(*groth16bn254.Proof))
+			actual, err := parser.ParseProofCompressed(compressed)
+
+			if err != nil || len(compressed) != BN254Groth16ProofCompressedSize {
+				return false
+			}
+
+			return bytes.Equal(
+				expected.(*groth16bn254.Proof).MarshalSolidity(),
+				actual.(*groth16bn254.Proof).MarshalSolidity(),
+			)
 		},
-		{
-			name: "verifying key parse with zero public inputs",
-			data: func() []byte {
-				points := make([]byte, 0)
-				for i := 0; i < 8; i++ {
-					points = append(points, utils.MarshalPoint(babyjub.NewPoint())...)
-				}
+		ProofBytesGenerator(),
+	))
 
-				return points
-			}(),
-			numberOfPublicInputs: 0,
-			expected: func() groth16.VerifyingKey {
-				var vk groth16bn254.VerifyingKey
+	properties.TestingRun(t)
+}
 
-				_, _ = ParseG1(utils.MarshalPoint(babyjub.NewPoint()), 0, &vk.G1.Alpha)
-				_, _ = ParseG2(append(utils.MarshalPoint(babyjub.NewPoint()), utils.MarshalPoint(babyjub.NewPoint())...), 0, &vk.G2.Beta)
-				_, _ = ParseG2(append(utils.MarshalPoint(babyjub.NewPoint()), utils.MarshalPoint(babyjub.NewPoint())...), 0, &vk.G2.Gamma)
-				_, _ = ParseG2(append(utils.MarshalPoint(babyjub.NewPoint()), utils.MarshalPoint(babyjub.NewPoint())...), 0, &vk.G2.Delta)
+// commitmentTestCircuit commits to its private input using gnark's
+// Pedersen commitment extension.
+type commitmentTestCircuit struct {
+	X frontend.Variable `gnark:",public"`
+	Y frontend.Variable
+}
 
-				vk.G1.K = make([]bn254.G1Affine, 1)
+func (c *commitmentTestCircuit) Define(api frontend.API) error {
+	commitment, err := api.(frontend.Committer).Commit(c.Y)
 
-				for index := range vk.G1.K {
-					_, _ = ParseG1(utils.MarshalPoint(babyjub.NewPoint()), 0, &vk.G1.K[index])
-				}
+	if err != nil {
+		return err
+	}
 
-				_ = vk.Precompute()
+	api.AssertIsDifferent(commitment, 0)
+	api.AssertIsEqual(api.Mul(c.Y, c.Y), c.X)
 
-				return &vk
-			}(),
+	return nil
+}
+
+func TestParseG2EIP197(t *testing.T) {
+	_, _, _, generator := bn254.Generators()
+
+	x1 := generator.X.A1.Bytes()
+	x0 := generator.X.A0.Bytes()
+	y1 := generator.Y.A1.Bytes()
+	y0 := generator.Y.A0.Bytes()
+
+	eip197 := slices.Concat(x1[:], x0[:], y1[:], y0[:])
+	realFirst := slices.Concat(x0[:], x1[:], y0[:], y1[:])
+
+	var destination bn254.G2Affine
+	offset, err := ParseG2EIP197(eip197, 0, &destination)
+
+	assert.Nil(t, err)
+	assert.Equal(t, BN254Groth16G2Size, offset)
+	assert.Equal(t, generator, destination)
+	assert.Equal(t, generator.Marshal(), eip197)
+
+	_, err = ParseValidatedG2(realFirst, 0, &destination)
+
+	assert.Equal(t, common.ErrorInvalidG2, err)
+
+	_, err = ParseG2EIP197(eip197[:BN254Groth16G2Size-1], 0, &destination)
+
+	assert.Equal(t, common.ErrorInvalidG2, err)
+}
+
+func TestParseProofSnarkjsCalldata(t *testing.T) {
+	circuit := &VariablePublicCircuit{Public: make([]frontend.Variable, 2)}
+	assignment := &VariablePublicCircuit{Public: []frontend.Variable{3, 5}}
+
+	ccs, _ := frontend.Compile(ecc.BN254.ScalarField(), r1cs.NewBuilder, circuit)
+	pk, vk, _ := groth16.Setup(ccs)
+	fullWitness, _ := frontend.NewWitness(assignment, ecc.BN254.ScalarField())
+	publicWitness, _ := fullWitness.Public()
+
+	generated, err := groth16.Prove(ccs, pk, fullWitness)
+	assert.Nil(t, err)
+
+	original := generated.(*groth16bn254.Proof)
+
+	// pi_a, pi_b and pi_c as written by snarkjs to proof.json: decimal
+	// strings, with the real part of each Fp2 coordinate of pi_b first.
+	piA := []string{original.Ar.X.String(), original.Ar.Y.String()}
+	piB := [][]string{
+		{original.Bs.X.A0.String(), original.Bs.X.A1.String()},
+		{original.Bs.Y.A0.String(), original.Bs.Y.A1.String()},
+	}
+	piC := []string{original.Krs.X.String(), original.Krs.Y.String()}
+
+	// snarkjs exportSolidityCallData passes _pA, _pB and _pC to the
+	// Solidity verifier as [a0, a1], [[b01, b00], [b11, b10]], [c0, c1].
+	calldata := []string{
+		piA[0], piA[1],
+		piB[0][1], piB[0][0], piB[1][1], piB[1][0],
+		piC[0], piC[1],
+	}
+
+	encode := func(words []string) []byte {
+		data := make([]byte, 0, len(words)*BN254Groth16FieldSize)
+
+		for _, word := range words {
+			value, _ := new(big.Int).SetString(word, 10)
+			data = append(data, value.FillBytes(make([]byte, BN254Groth16FieldSize))...)
+		}
+
+		return data
+	}
+
+	parser := SolidityBN254Parser{}
+	parsed, err := parser.ParseProof(encode(calldata))
+
+	assert.Nil(t, err)
+	assert.Nil(t, groth16.Verify(parsed, vk, publicWitness))
+
+	proofJSON := []string{
+		piA[0], piA[1],
+		piB[0][0], piB[0][1], piB[1][0], piB[1][1],
+		piC[0], piC[1],
+	}
+
+	_, err = parser.ParseProof(encode(proofJSON))
+
+	assert.Equal(t, common.ErrorInvalidG2, err)
+}
+
+func TestParseVerifyingKey(t *testing.T) {
+	offCurveG1 := utils.MarshalPoint(babyjub.NewPoint())
+	fixed := slices.Concat(generatorG1Bytes(), generatorG2Bytes(), generatorG2Bytes(), generatorG2Bytes())
+
+	expectedVerifyingKey := func(numberOfPublicInputs int) groth16.VerifyingKey {
+		var vk groth16bn254.VerifyingKey
+
+		_, _, vk.G1.Alpha, vk.G2.Beta = bn254.Generators()
+		vk.G2.Gamma = vk.G2.Beta
+		vk.G2.Delta = vk.G2.Beta
+
+		vk.G1.K = make([]bn254.G1Affine, numberOfPublicInputs+1)
+
+		for index := range vk.G1.K {
+			vk.G1.K[index] = vk.G1.Alpha
+		}
+
+		_ = vk.Precompute()
+
+		return &vk
+	}
+
+	tests := []struct {
+		name                 string
+		data                 []byte
+		numberOfPublicInputs int
+		expected             groth16.VerifyingKey
+		expectedError        error
+	}{
+		{
+			name:                 "normal verifying key parse",
+			data:                 slices.Concat(fixed, generatorG1Bytes(), generatorG1Bytes()),
+			numberOfPublicInputs: 1,
+			expected:             expectedVerifyingKey(1),
+		},
+		{
+			name:                 "verifying key parse with zero public inputs",
+			data:                 slices.Concat(fixed, generatorG1Bytes()),
+			numberOfPublicInputs: 0,
+			expected:             expectedVerifyingKey(0),
 		},
 		{
 			name:                 "invalid verifying key parse with empty data",
@@ -405,70 +620,65 @@ func TestParseVerifyingKey(t *testing.T) {
 			expectedError:        common.ErrorInvalidG1,
 		},
 		{
-			name: "invalid verifying key parse with empty beta point",
-			data: func() []byte {
-				points := make([]byte, 0)
-				for i := 0; i < 1; i++ {
-					points = append(points, utils.MarshalPoint(babyjub.NewPoint())...)
-				}
-
-				return points
-			}(),
+			name:                 "invalid verifying key parse with empty beta point",
+			data:                 generatorG1Bytes(),
 			numberOfPublicInputs: 1,
 			expectedError:        common.ErrorInvalidG2,
 		},
 		{
-			name: "invalid verifying key parse with empty gamma point",
-			data: func() []byte {
-				points := make([]byte, 0)
-				for i := 0; i < 3; i++ {
-					points = append(points, utils.MarshalPoint(babyjub.NewPoint())...)
-				}
-
-				return points
-			}(),
+			name:                 "invalid verifying key parse with empty gamma point",
+			data:                 slices.Concat(generatorG1Bytes(), generatorG2Bytes()),
 			numberOfPublicInputs: 1,
 			expectedError:        common.ErrorInvalidG2,
 		},
 		{
-			name: "invalid verifying key parse with empty delta point",
-			data: func() []byte {
-				points := make([]byte, 0)
-				for i := 0; i < 5; i++ {
-					points = append(points, utils.MarshalPoint(babyjub.NewPoint())...)
-				}
-
-				return points
-			}(),
+			name:                 "invalid verifying key parse with empty delta point",
+			data:                 slices.Concat(generatorG1Bytes(), generatorG2Bytes(), generatorG2Bytes()),
 			numberOfPublicInputs: 1,
 			expectedError:        common.ErrorInvalidG2,
 		},
 		{
-			name: "invalid verifying key parse with empty k point",
-			data: func() []byte {
-				points := make([]byte, 0)
-				for i := 0; i < 8; i++ {
-					points = append(points, utils.MarshalPoint(babyjub.NewPoint())...)
-				}
-
-				return points
-			}(),
+			name:                 "invalid verifying key parse with empty k point",
+			data:                 fixed,
 			numberOfPublicInputs: 1,
 			expectedError:        common.ErrorInvalidG1,
 		},
 		{
-			name: "invalid verifying key parse with greater number of public inputs",
-			data: func() []byte {
-				points := make([]byte, 0)
-				for i := 0; i < 9; i++ {
-					points = append(points, utils.MarshalPoint(babyjub.NewPoint())...)
-				}
-
-				return points
-			}(),
+			name:                 "invalid verifying key parse with greater number of public inputs",
+			data:                 slices.Concat(fixed, generatorG1Bytes(), generatorG1Bytes()),
 			numberOfPublicInputs: 2,
 			expectedError:        common.ErrorInvalidG1,
 		},
+		{
+			name:                 "invalid verifying key parse with one extra k point",
+			data:                 slices.Concat(fixed, generatorG1Bytes(), generatorG1Bytes(), generatorG1Bytes()),
+			numberOfPublicInputs: 1,
+			expectedError:        common.ErrorInvalidG1,
+		},
+		{
+			name:                 "invalid verifying key parse with negative number of public inputs",
+			data:                 slices.Concat(fixed, generatorG1Bytes()),
+			numberOfPublicInputs: -1,
+			expectedError:        common.ErrorInvalidG1,
+		},
+		{
+			name:                 "off-curve verifying key alpha point",
+			data:                 slices.Concat(offCurveG1, fixed[BN254Groth16G1Size:], generatorG1Bytes(), generatorG1Bytes()),
+			numberOfPublicInputs: 1,
+			expectedError:        common.ErrorInvalidG1,
+		},
+		{
+			name:                 "verifying key delta point outside of subgroup",
+			data:                 slices.Concat(fixed[:BN254Groth16G1Size+2*BN254Groth16G2Size], g2NotInSubgroupBytes(), generatorG1Bytes(), generatorG1Bytes()),
+			numberOfPublicInputs: 1,
+			expectedError:        common.ErrorInvalidG2,
+		},
+		{
+			name:                 "off-curve verifying key k point",
+			data:                 slices.Concat(fixed, generatorG1Bytes(), offCurveG1),
+			numberOfPublicInputs: 1,
+			expectedError:        common.ErrorInvalidG1,
+		},
 	}
 
 	for _, tt := range tests {
@@ -521,6 +731,284 @@ func TestParseVerifyingKeyProperties(t *testing.T) {
 	properties.TestingRun(t)
 }
 
+func TestParseVerifyingKeyCompressed(t *testing.T) {
+	_, _, g1, g2 := bn254.Generators()
+	g1Compressed := g1.Bytes()
+	g2Compressed := g2.Bytes()
+
+	fixed := slices.Concat(g1Compressed[:], g2Compressed[:], g2Compressed[:], g2Compressed[:])
+
+	var notInSubgroup bn254.G2Affine
+	_, _ = ParseG2(g2NotInSubgroupBytes(), 0, &notInSubgroup)
+	notInSubgroupCompressed := notInSubgroup.Bytes()
+
+	tests := []struct {
+		name                 string
+		data                 []byte
+		numberOfPublicInputs int
+		expectedError        error
+	}{
+		{
+			name:                 "normal compressed verifying key parse",
+			data:                 slices.Concat(fixed, g1Compressed[:], g1Compressed[:]),
+			numberOfPublicInputs: 1,
+		},
+		{
+			name:                 "missing IC point",
+			data:                 slices.Concat(fixed, g1Compressed[:]),
+			numberOfPublicInputs: 1,
+			expectedError:        common.ErrorInvalidG1,
+		},
+		{
+			name:                 "trailing bytes",
+			data:                 slices.Concat(fixed, g1Compressed[:], g1Compressed[:], []byte{0}),
+			numberOfPublicInputs: 1,
+			expectedError:        common.ErrorInvalidG1,
+		},
+		{
+			name:                 "negative number of public inputs",
+			data:                 fixed,
+			numberOfPublicInputs: -1,
+			expectedError:        common.ErrorInvalidG1,
+		},
+		{
+			name:                 "alpha without a curve point",
+			data:                 slices.Concat(compressedG1WithoutPoint(), fixed[BN254Groth16G1CompressedSize:], g1Compressed[:], g1Compressed[:]),
+			numberOfPublicInputs: 1,
+			expectedError:        common.ErrorInvalidG1,
+		},
+		{
+			name:                 "delta outside of subgroup",
+			data:                 slices.Concat(fixed[:BN254Groth16G1CompressedSize+2*BN254Groth16G2CompressedSize], notInSubgroupCompressed[:], g1Compressed[:], g1Compressed[:]),
+			numberOfPublicInputs: 1,
+			expectedError:        common.ErrorInvalidG2,
+		},
+		{
+			name:                 "IC point without a curve point",
+			data:                 slices.Concat(fixed, g1Compressed[:], compressedG1WithoutPoint()),
+			numberOfPublicInputs: 1,
+			expectedError:        common.ErrorInvalidG1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			parser := SolidityBN254Parser{}
+			vk, err := parser.ParseVerifyingKeyCompressed(tt.data, tt.numberOfPublicInputs)
+
+			if tt.expectedError != nil {
+				assert.NotNil(t, err)
+				assert.Equal(t, tt.expectedError, err)
+
+				return
+			}
+
+			assert.Nil(t, err)
+
+			expected, err := parser.ParseVerifyingKey(
+				slices.Concat(generatorG1Bytes(), generatorG2Bytes(), generatorG2Bytes(), generatorG2Bytes(), generatorG1Bytes(), generatorG1Bytes()),
+				tt.numberOfPublicInputs,
+			)
+
+			assert.Nil(t, err)
+			assert.False(t, expected.IsDifferent(vk))
+		})
+	}
+}
+
+func TestParseVerifyingKeyCompressedProperties(t *testing.T) {
+	parameters := gopter.DefaultTestParameters()
+	// Each run decompresses 4+n points, so fewer runs per size keep this fast.
+	parameters.MinSuccessfulTests = 20
+	properties := gopter.NewProperties(parameters)
+	max := 64
+
+	for index := range max {
+		properties.Property("ParseVerifyingKeyCompressed returns correct verifying key", prop.ForAll(
+			func(input []byte) bool {
+				parser := SolidityBN254Parser{}
+
+				verifyingKey1, err := parser.ParseVerifyingKey(input, index)
+
+				if err != nil {
+					return false
+				}
+
+				compressed := SerializeVerifyingKeyCompressed(verifyingKey1.(*groth16bn254.VerifyingKey))
+				verifyingKey2, err := parser.ParseVerifyingKeyCompressed(compressed, index)
+
+				if err != nil {
+					return false
+				}
+
+				return !verifyingKey1.IsDifferent(verifyingKey2)
+			},
+			VerifyingKeyGenerator(index),
+		))
+	}
+
+	properties.TestingRun(t)
+}
+
+func TestParseVerifyingKeyFromGnark(t *testing.T) {
+	circuit := &VariablePublicCircuit{Public: make([]frontend.Variable, 2)}
+	ccs, _ := frontend.Compile(ecc.BN254.ScalarField(), r1cs.NewBuilder, circuit)
+	_, vk, _ := groth16.Setup(ccs)
+	original := vk.(*groth16bn254.VerifyingKey)
+
+	data := SerializeVerifyingKey(original)
+
+	// G2 points must use the same coordinate order as gnark: X.A1, X.A0, Y.A1, Y.A0.
+	betaOffset := BN254Groth16G1Size
+	assert.Equal(t, original.G2.Beta.Marshal(), data[betaOffset:betaOffset+BN254Groth16G2Size])
+
+	parser := SolidityBN254Parser{}
+	parsed, err := parser.ParseVerifyingKey(data, 2)
+
+	assert.Nil(t, err)
+	assert.False(t, original.IsDifferent(parsed))
+	assert.Equal(t, original.G2.Beta, parsed.(*groth16bn254.VerifyingKey).G2.Beta)
+	assert.Equal(t, original.G2.Gamma, parsed.(*groth16bn254.VerifyingKey).G2.Gamma)
+	assert.Equal(t, original.G2.Delta, parsed.(*groth16bn254.VerifyingKey).G2.Delta)
+}
+
+func TestParseCommitmentExtension(t *testing.T) {
+	_, _, g1, g2 := bn254.Generators()
+
+	proof := &groth16bn254.Proof{Ar: g1, Bs: g2, Krs: g1}
+	proof.Commitments = []bn254.G1Affine{g1}
+	proof.CommitmentPok = g1
+	proofBytes := SerializeProof(proof)
+
+	vk := &groth16bn254.VerifyingKey{}
+	vk.G1.Alpha = g1
+	vk.G2.Beta, vk.G2.Gamma, vk.G2.Delta = g2, g2, g2
+	vk.G1.K = []bn254.G1Affine{g1, g1, g1}
+	vk.CommitmentKeys = []pedersen.VerifyingKey{{G: g2, GSigmaNeg: g2}}
+	vk.PublicAndCommitmentCommitted = [][]int{{1}}
+	vkBytes := SerializeVerifyingKey(vk)
+	_ = vk.Precompute()
+
+	withCount := func(data []byte, offset int, count uint32) []byte {
+		out := slices.Clone(data)
+		binary.BigEndian.PutUint32(out[offset:], count)
+
+		return out
+	}
+
+	proofCountOffset := BN254Groth16ProofSize
+	vkCountOffset := BN254Groth16VerifyVerifyingKeySize + 2*BN254Groth16G1Size
+
+	tests := []struct {
+		name          string
+		proof         []byte
+		vk            []byte
+		expectedError error
+	}{
+		{
+			name:  "proof and verifying key with commitment",
+			proof: proofBytes,
+			vk:    vkBytes,
+		},
+		{
+			name:          "proof with zero commitments",
+			proof:         withCount(proofBytes, proofCountOffset, 0),
+			expectedError: ErrorInvalidCommitmentExtension,
+		},
+		{
+			name:          "proof with too many commitments",
+			proof:         withCount(proofBytes, proofCountOffset, BN254Groth16MaxCommitments+1),
+			expectedError: ErrorInvalidCommitmentExtension,
+		},
+		{
+			name:          "proof with trailing bytes",
+			proof:         append(slices.Clone(proofBytes), 0),
+			expectedError: ErrorInvalidCommitmentExtension,
+		},
+		{
+			name:          "proof with truncated count",
+			proof:         proofBytes[:BN254Groth16ProofSize+1],
+			expectedError: ErrorInvalidCommitmentExtension,
+		},
+		{
+			name:          "proof with off-curve commitment",
+			proof:         slices.Concat(proofBytes[:proofCountOffset+BN254Groth16CommitmentCountSize], utils.MarshalPoint(babyjub.NewPoint()), generatorG1Bytes()),
+			expectedError: common.ErrorInvalidG1,
+		},
+		{
+			name:          "verifying key with zero commitments",
+			vk:            withCount(vkBytes, vkCountOffset, 0),
+			expectedError: ErrorInvalidCommitmentExtension,
+		},
+		{
+			name:          "verifying key with trailing bytes",
+			vk:            append(slices.Clone(vkBytes), 0),
+			expectedError: ErrorInvalidCommitmentExtension,
+		},
+		{
+			name:          "verifying key with truncated index list",
+			vk:            vkBytes[:len(vkBytes)-1],
+			expectedError: ErrorInvalidCommitmentExtension,
+		},
+		{
+			name:          "verifying key with committed index zero",
+			vk:            withCount(vkBytes, len(vkBytes)-BN254Groth16CommitmentIndexSize, 0),
+			expectedError: ErrorInvalidCommitmentExtension,
+		},
+		{
+			name:          "verifying key with committed index out of range",
+			vk:            withCount(vkBytes, len(vkBytes)-BN254Groth16CommitmentIndexSize, 2),
+			expectedError: ErrorInvalidCommitmentExtension,
+		},
+		{
+			name:          "verifying key with too many committed indexes",
+			vk:            withCount(vkBytes, len(vkBytes)-2*BN254Groth16CommitmentIndexSize, 2),
+			expectedError: ErrorInvalidCommitmentExtension,
+		},
+		{
+			name: "verifying key with commitment key outside of subgroup",
+			vk: slices.Concat(
+				vkBytes[:vkCountOffset+BN254Groth16CommitmentCountSize+BN254Groth16G1Size],
+				g2NotInSubgroupBytes(),
+				vkBytes[vkCountOffset+BN254Groth16CommitmentCountSize+BN254Groth16G1Size+BN254Groth16G2Size:],
+			),
+			expectedError: common.ErrorInvalidG2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			parser := SolidityBN254Parser{}
+
+			if tt.proof != nil {
+				parsed, err := parser.ParseProof(tt.proof)
+
+				if tt.expectedError != nil {
+					assert.Equal(t, tt.expectedError, err)
+
+					return
+				}
+
+				assert.Nil(t, err)
+				assert.Equal(t, proof, parsed)
+			}
+
+			if tt.vk != nil {
+				parsed, err := parser.ParseVerifyingKey(tt.vk, 1)
+
+				if tt.expectedError != nil {
+					assert.Equal(t, tt.expectedError, err)
+
+					return
+				}
+
+				assert.Nil(t, err)
+				assert.Equal(t, vk, parsed)
+			}
+		})
+	}
+}
+
 func TestParsePublicWitness(t *testing.T) {
 	tests := []struct {
 		name                 string
@@ -572,13 +1060,19 @@ func TestParsePublicWitness(t *testing.T) {
 			name:                 "invalid public witness parse with greater number of public inputs",
 			data:                 make([]byte, BN254Groth16FieldSize),
 			numberOfPublicInputs: 2,
-			expectedError:        errors.New("invalid slice"),
+			expectedError:        common.ErrorInvalidPublicWitnessSlice,
 		},
 		{
 			name:                 "invalid public witness parse with empty input",
 			data:                 []byte{},
 			numberOfPublicInputs: 1,
-			expectedError:        errors.New("invalid slice"),
+			expectedError:        common.ErrorInvalidPublicWitnessSlice,
+		},
+		{
+			name:                 "invalid public witness parse with negative number of public inputs",
+			data:                 make([]byte, BN254Groth16FieldSize),
+			numberOfPublicInputs: -1,
+			expectedError:        common.ErrorInvalidPublicWitnessSlice,
 		},
 	}
 
@@ -589,7 +1083,7 @@ func TestParsePublicWitness(t *testing.T) {
 
 			if tt.expectedError != nil {
 				assert.NotNil(t, err)
-				assert.Equal(t, tt.expectedError, err)
+				assert.True(t, errors.Is(err, tt.expectedError))
 
 				return
 			}
@@ -600,6 +1094,72 @@ func TestParsePublicWitness(t *testing.T) {
 	}
 }
 
+func TestParsePublicWitnessStrict(t *testing.T) {
+	modulus := fr.Modulus().FillBytes(make([]byte, BN254Groth16FieldSize))
+	belowModulus := new(big.Int).Sub(fr.Modulus(), big.NewInt(1)).FillBytes(make([]byte, BN254Groth16FieldSize))
+
+	tests := []struct {
+		name          string
+		parser        SolidityBN254Parser
+		data          []byte
+		expectedError error
+	}{
+		{
+			name:   "strict accepts modulus minus one",
+			parser: SolidityBN254Parser{StrictPublicInputs: true},
+			data:   belowModulus,
+		},
+		{
+			name:          "strict rejects modulus",
+			parser:        SolidityBN254Parser{StrictPublicInputs: true},
+			data:          modulus,
+			expectedError: ErrorPublicWitnessOutOfField,
+		},
+		{
+			name:          "strict rejects modulus after valid input",
+			parser:        SolidityBN254Parser{StrictPublicInputs: true},
+			data:          slices.Concat(belowModulus, modulus),
+			expectedError: ErrorPublicWitnessOutOfField,
+		},
+		{
+			name:          "strict rejects all ones",
+			parser:        SolidityBN254Parser{StrictPublicInputs: true},
+			data:          bytes.Repeat([]byte{0xff}, BN254Groth16FieldSize),
+			expectedError: ErrorPublicWitnessOutOfField,
+		},
+		{
+			name:   "default reduces modulus",
+			parser: SolidityBN254Parser{},
+			data:   modulus,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := tt.parser.ParsePublicWitness(tt.data, len(tt.data)/BN254Groth16FieldSize)
+
+			if tt.expectedError != nil {
+				assert.NotNil(t, err)
+				assert.Equal(t, tt.expectedError, err)
+				assert.Nil(t, result)
+
+				return
+			}
+
+			assert.Nil(t, err)
+
+			values := result.Vector().(fr.Vector)
+
+			for index, value := range values {
+				expected := new(big.Int).SetBytes(tt.data[index*BN254Groth16FieldSize : (index+1)*BN254Groth16FieldSize])
+				expected.Mod(expected, fr.Modulus())
+
+				assert.Equal(t, 0, value.BigInt(new(big.Int)).Cmp(expected))
+			}
+		})
+	}
+}
+
 func TestParsePublicWitnessProperties(t *testing.T) {
 	parameters := gopter.DefaultTestParameters()
 	properties := gopter.NewProperties(parameters)
@@ -624,10 +1184,81 @@ func TestParsePublicWitnessProperties(t *testing.T) {
 				return false
 			}
 
-			return bytes.Equal(input, parsed[12:])
+			publicInputs, err := StripWitnessHeader(parsed)
+
+			if err != nil {
+				return false
+			}
+
+			return bytes.Equal(input, publicInputs)
 		},
 		WitnessBytesGenerator(),
 	))
 
 	properties.TestingRun(t)
 }
+
+// generatorG1Bytes returns the encoding of the BN254 G1 generator.
+func generatorG1Bytes() []byte {
+	_, _, g1, _ := bn254.Generators()
+
+	return g1.Marshal()
+}
+
+// generatorG2Bytes returns the encoding of the BN254 G2 generator.
+func generatorG2Bytes() []byte {
+	_, _, _, g2 := bn254.Generators()
+
+	return g2.Marshal()
+}
+
+// g2NotInSubgroupBytes returns the encoding of a point on the BN254 G2 twist
+// that lies outside of the prime-order subgroup.
+func g2NotInSubgroupBytes() []byte {
+	_, _, _, g2 := bn254.Generators()
+
+	// Recover the twist coefficient b' = y^2 - x^3 from the generator.
+	var b, x3 bn254.E2
+	b.Square(&g2.Y)
+	x3.Square(&g2.X).Mul(&x3, &g2.X)
+	b.Sub(&b, &x3)
+
+	for i := uint64(1); ; i++ {
+		var point bn254.G2Affine
+		var rhs bn254.E2
+
+		point.X.A0.SetUint64(i)
+		rhs.Square(&point.X).Mul(&rhs, &point.X).Add(&rhs, &b)
+
+		if rhs.Legendre() != 1 {
+			continue
+		}
+
+		point.Y.Sqrt(&rhs)
+
+		if point.IsOnCurve() && !point.IsInSubGroup() {
+			return point.Marshal()
+		}
+	}
+}
+
+// compressedG1WithoutPoint returns a compressed G1 encoding whose X
+// coordinate has no matching Y on the curve.
+func compressedG1WithoutPoint() []byte {
+	var x, ySquared, b fp.Element
+
+	b.SetUint64(3)
+
+	for {
+		x.Add(&x, new(fp.Element).SetOne())
+		ySquared.Square(&x).Mul(&ySquared, &x).Add(&ySquared, &b)
+
+		if ySquared.Legendre() == -1 {
+			out := x.Bytes()
+			// Mark the encoding as compressed, selecting the smallest Y.
+			out[0] |= 0b10 << 6
+
+			return out[:]
+		}
+	}
+}
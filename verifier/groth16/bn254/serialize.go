@@ -0,0 +1,170 @@
+package bn254
+
+import (
+	"encoding/binary"
+
+	"github.com/consensys/gnark-crypto/ecc/bn254"
+	groth16bn254 "github.com/consensys/gnark/backend/groth16/bn254"
+)
+
+// SerializeProof converts a gnark Groth16 proof into the byte layout
+// accepted by ParseProof and the BN254Groth16Verify Run input:
+//
+//	Ar || Bs || Krs
+//
+// G1 points are encoded as X || Y and G2 points as X.A1 || X.A0 || Y.A1 ||
+// Y.A0, the EIP-197 ordering, with every coordinate a 32-byte big-endian
+// field element. The layout matches gnark's MarshalSolidity.
+//
+// Proofs carrying Pedersen commitments are followed by the commitment
+// extension accepted by ParseProof.
+func SerializeProof(value *groth16bn254.Proof) []byte {
+	out := make([]byte, 0)
+
+	x := value.Ar.X.Bytes()
+	y := value.Ar.Y.Bytes()
+	out = append(out, x[:]...)
+	out = append(out, y[:]...)
+
+	x1 := value.Bs.X.A1.Bytes()
+	x0 := value.Bs.X.A0.Bytes()
+	y1 := value.Bs.Y.A1.Bytes()
+	y0 := value.Bs.Y.A0.Bytes()
+	out = append(out, x1[:]...)
+	out = append(out, x0[:]...)
+	out = append(out, y1[:]...)
+	out = append(out, y0[:]...)
+
+	x = value.Krs.X.Bytes()
+	y = value.Krs.Y.Bytes()
+	out = append(out, x[:]...)
+	out = append(out, y[:]...)
+
+	if len(value.Commitments) == 0 {
+		return out
+	}
+
+	out = binary.BigEndian.AppendUint32(out, uint32(len(value.Commitments)))
+
+	for _, commitment := range value.Commitments {
+		x = commitment.X.Bytes()
+		y = commitment.Y.Bytes()
+		out = append(out, x[:]...)
+		out = append(out, y[:]...)
+	}
+
+	x = value.CommitmentPok.X.Bytes()
+	y = value.CommitmentPok.Y.Bytes()
+	out = append(out, x[:]...)
+	out = append(out, y[:]...)
+
+	return out
+}
+
+// SerializeProofCompressed converts a gnark Groth16 proof without
+// commitments into the compressed byte layout accepted by
+// ParseProofCompressed.
+func SerializeProofCompressed(value *groth16bn254.Proof) []byte {
+	ar := value.Ar.Bytes()
+	bs := value.Bs.Bytes()
+	krs := value.Krs.Bytes()
+
+	out := make([]byte, 0, BN254Groth16ProofCompressedSize)
+	out = append(out, ar[:]...)
+	out = append(out, bs[:]...)
+	out = append(out, krs[:]...)
+
+	return out
+}
+
+// SerializeVerifyingKey converts a gnark Groth16 verifying key into the byte
+// layout accepted by ParseVerifyingKey:
+//
+//	Alpha || Beta || Gamma || Delta || K_0 || ... || K_n
+//
+// Points are encoded as in SerializeProof. The IC points K_i cover the
+// constant term and every public input.
+//
+// Verifying keys carrying commitment keys are followed by the commitment
+// extension accepted by ParseVerifyingKey.
+func SerializeVerifyingKey(value *groth16bn254.VerifyingKey) []byte {
+	out := make([]byte, 0)
+
+	serializeG1 := func(p bn254.G1Affine) {
+		x := p.X.Bytes()
+		y := p.Y.Bytes()
+		out = append(out, x[:]...)
+		out = append(out, y[:]...)
+	}
+
+	serializeG2 := func(p bn254.G2Affine) {
+		x1 := p.X.A1.Bytes()
+		x0 := p.X.A0.Bytes()
+		y1 := p.Y.A1.Bytes()
+		y0 := p.Y.A0.Bytes()
+
+		out = append(out, x1[:]...)
+		out = append(out, x0[:]...)
+		out = append(out, y1[:]...)
+		out = append(out, y0[:]...)
+	}
+
+	serializeG1(value.G1.Alpha)
+	serializeG2(value.G2.Beta)
+	serializeG2(value.G2.Gamma)
+	serializeG2(value.G2.Delta)
+
+	numberOfCommitments := len(value.CommitmentKeys)
+	publicWires := len(value.G1.K) - numberOfCommitments
+
+	for _, k := range value.G1.K[:publicWires] {
+		serializeG1(k)
+	}
+
+	if numberOfCommitments == 0 {
+		return out
+	}
+
+	out = binary.BigEndian.AppendUint32(out, uint32(numberOfCommitments))
+
+	for _, k := range value.G1.K[publicWires:] {
+		serializeG1(k)
+	}
+
+	for _, key := range value.CommitmentKeys {
+		serializeG2(key.G)
+		serializeG2(key.GSigmaNeg)
+	}
+
+	for _, committed := range value.PublicAndCommitmentCommitted {
+		out = binary.BigEndian.AppendUint32(out, uint32(len(committed)))
+
+		for _, index := range committed {
+			out = binary.BigEndian.AppendUint32(out, uint32(index))
+		}
+	}
+
+	return out
+}
+
+// SerializeVerifyingKeyCompressed converts a gnark Groth16 verifying key
+// without commitments into the compressed byte layout accepted by
+// ParseVerifyingKeyCompressed.
+func SerializeVerifyingKeyCompressed(value *groth16bn254.VerifyingKey) []byte {
+	out := make([]byte, 0, BN254Groth16VerifyingKeyCompressedSize+len(value.G1.K)*BN254Groth16G1CompressedSize)
+
+	alpha := value.G1.Alpha.Bytes()
+	out = append(out, alpha[:]...)
+
+	for _, p := range []bn254.G2Affine{value.G2.Beta, value.G2.Gamma, value.G2.Delta} {
+		compressed := p.Bytes()
+		out = append(out, compressed[:]...)
+	}
+
+	for _, k := range value.G1.K {
+		compressed := k.Bytes()
+		out = append(out, compressed[:]...)
+	}
+
+	return out
+}
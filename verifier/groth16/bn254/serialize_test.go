@@ -0,0 +1,107 @@
+package bn254
+
+import (
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark/backend/groth16"
+	groth16bn254 "github.com/consensys/gnark/backend/groth16/bn254"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/frontend/cs/r1cs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSerializeProofRoundTrip(t *testing.T) {
+	tests := []struct {
+		name       string
+		circuit    frontend.Circuit
+		assignment frontend.Circuit
+	}{
+		{
+			name:       "proof without commitments",
+			circuit:    &VariablePublicCircuit{Public: make([]frontend.Variable, 2)},
+			assignment: &VariablePublicCircuit{Public: []frontend.Variable{3, 5}},
+		},
+		{
+			name:       "proof with commitment",
+			circuit:    &commitmentTestCircuit{},
+			assignment: &commitmentTestCircuit{X: 9, Y: 3},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ccs, _ := frontend.Compile(ecc.BN254.ScalarField(), r1cs.NewBuilder, tt.circuit)
+			pk, _, _ := groth16.Setup(ccs)
+			witness, _ := frontend.NewWitness(tt.assignment, ecc.BN254.ScalarField())
+
+			generated, err := groth16.Prove(ccs, pk, witness)
+			assert.Nil(t, err)
+
+			original := generated.(*groth16bn254.Proof)
+			serialized := SerializeProof(original)
+
+			parser := SolidityBN254Parser{}
+			parsed, err := parser.ParseProof(serialized)
+
+			assert.Nil(t, err)
+
+			proof := parsed.(*groth16bn254.Proof)
+
+			assert.Equal(t, original.Ar, proof.Ar)
+			assert.Equal(t, original.Bs, proof.Bs)
+			assert.Equal(t, original.Krs, proof.Krs)
+			assert.ElementsMatch(t, original.Commitments, proof.Commitments)
+			assert.Equal(t, original.CommitmentPok, proof.CommitmentPok)
+			assert.Equal(t, serialized, SerializeProof(proof))
+
+			// The layout is identical to gnark's MarshalSolidity: Ar | Bs | Krs,
+			// followed, for proofs with commitments, by the uint32 commitment
+			// count, the commitments and the commitment proof of knowledge.
+			assert.Equal(t, original.MarshalSolidity(), serialized)
+		})
+	}
+}
+
+func TestSerializeVerifyingKeyRoundTrip(t *testing.T) {
+	tests := []struct {
+		name                 string
+		circuit              frontend.Circuit
+		numberOfPublicInputs int
+	}{
+		{
+			name:                 "verifying key without commitments",
+			circuit:              &VariablePublicCircuit{Public: make([]frontend.Variable, 2)},
+			numberOfPublicInputs: 2,
+		},
+		{
+			name:                 "verifying key with commitment",
+			circuit:              &commitmentTestCircuit{},
+			numberOfPublicInputs: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ccs, _ := frontend.Compile(ecc.BN254.ScalarField(), r1cs.NewBuilder, tt.circuit)
+			_, generated, _ := groth16.Setup(ccs)
+
+			original := generated.(*groth16bn254.VerifyingKey)
+			serialized := SerializeVerifyingKey(original)
+
+			parser := SolidityBN254Parser{}
+			parsed, err := parser.ParseVerifyingKey(serialized, tt.numberOfPublicInputs)
+
+			assert.Nil(t, err)
+
+			vk := parsed.(*groth16bn254.VerifyingKey)
+
+			assert.Equal(t, original.G1.Alpha, vk.G1.Alpha)
+			assert.Equal(t, original.G2.Beta, vk.G2.Beta)
+			assert.Equal(t, original.G2.Gamma, vk.G2.Gamma)
+			assert.Equal(t, original.G2.Delta, vk.G2.Delta)
+			assert.Equal(t, original.G1.K, vk.G1.K)
+			assert.Equal(t, serialized, SerializeVerifyingKey(vk))
+		})
+	}
+}
@@ -0,0 +1,315 @@
+package bn254
+
+import (
+	"encoding/json"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bn254"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fp"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	groth16bn254 "github.com/consensys/gnark/backend/groth16/bn254"
+	"github.com/consensys/gnark/backend/witness"
+	"github.com/privacy-ethereum/privacy-precompiles/common"
+)
+
+// snarkjsVerifyingKey mirrors the fields of a snarkjs Groth16
+// verification_key.json used to build a gnark verifying key.
+//
+// G1 points are [x, y, z] and G2 points [[x0, x1], [y0, y1], [z0, z1]],
+// every coordinate being a decimal string. Other fields, such as
+// vk_alphabeta_12, are ignored.
+type snarkjsVerifyingKey struct {
+	Protocol string     `json:"protocol"`
+	Curve    string     `json:"curve"`
+	NPublic  *int       `json:"nPublic"`
+	Alpha    []string   `json:"vk_alpha_1"`
+	Beta     [][]string `json:"vk_beta_2"`
+	Gamma    [][]string `json:"vk_gamma_2"`
+	Delta    [][]string `json:"vk_delta_2"`
+	IC       [][]string `json:"IC"`
+}
+
+// snarkjsProof mirrors the fields of a snarkjs Groth16 proof.json, using
+// the point encodings described in snarkjsVerifyingKey.
+type snarkjsProof struct {
+	Protocol string     `json:"protocol"`
+	Curve    string     `json:"curve"`
+	A        []string   `json:"pi_a"`
+	B        [][]string `json:"pi_b"`
+	C        []string   `json:"pi_c"`
+}
+
+// ParseVerifyingKeyFromSnarkjsJSON builds a gnark BN254 Groth16 verifying
+// key from the verification_key.json exported by snarkjs for a circom
+// circuit.
+//
+// The vk_alpha_1, vk_beta_2, vk_gamma_2, vk_delta_2 and IC fields are read.
+// Coordinates are decimal strings and points are given in projective form
+// with z = 1, or z = 0 for the point at infinity. G2 coordinates list the
+// real part first, [x0, x1], unlike the EIP-197 ordering of ParseG2EIP197.
+// When present, protocol must be "groth16", curve must be "bn128" and
+// nPublic must match the number of IC points minus one.
+//
+// Every point must lie on the curve and in the prime-order subgroup.
+// After parsing, vk.Precompute() is called as for ParseVerifyingKey.
+//
+// Returns ErrorInvalidSnarkjsJSON if data is not a valid snarkjs Groth16
+// BN254 verifying key, and common.ErrorInvalidG1 or common.ErrorInvalidG2
+// if a point fails validation.
+func ParseVerifyingKeyFromSnarkjsJSON(data []byte) (*groth16bn254.VerifyingKey, error) {
+	var decoded snarkjsVerifyingKey
+
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return nil, ErrorInvalidSnarkjsJSON
+	}
+
+	if err := checkSnarkjsHeader(decoded.Protocol, decoded.Curve); err != nil {
+		return nil, err
+	}
+
+	if len(decoded.IC) == 0 || (decoded.NPublic != nil && *decoded.NPublic != len(decoded.IC)-1) {
+		return nil, ErrorInvalidSnarkjsJSON
+	}
+
+	var vk groth16bn254.VerifyingKey
+
+	if err := parseSnarkjsG1(decoded.Alpha, &vk.G1.Alpha); err != nil {
+		return nil, err
+	}
+
+	if err := parseSnarkjsG2(decoded.Beta, &vk.G2.Beta); err != nil {
+		return nil, err
+	}
+
+	if err := parseSnarkjsG2(decoded.Gamma, &vk.G2.Gamma); err != nil {
+		return nil, err
+	}
+
+	if err := parseSnarkjsG2(decoded.Delta, &vk.G2.Delta); err != nil {
+		return nil, err
+	}
+
+	vk.G1.K = make([]bn254.G1Affine, len(decoded.IC))
+
+	for index, point := range decoded.IC {
+		if err := parseSnarkjsG1(point, &vk.G1.K[index]); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := vk.Precompute(); err != nil {
+		return nil, err
+	}
+
+	return &vk, nil
+}
+
+// ParseProofFromSnarkjsJSON builds a gnark BN254 Groth16 proof from the
+// proof.json produced by snarkjs for a circom circuit.
+//
+// The pi_a, pi_b and pi_c fields are read as Ar, Bs and Krs, using the
+// encodings described in ParseVerifyingKeyFromSnarkjsJSON: decimal string
+// coordinates, z = 1 and real-first G2 coordinates. When present, protocol
+// must be "groth16" and curve must be "bn128".
+//
+// Every point must lie on the curve and in the prime-order subgroup.
+//
+// Returns ErrorInvalidSnarkjsJSON if data is not a valid snarkjs Groth16
+// BN254 proof, and common.ErrorInvalidG1 or common.ErrorInvalidG2 if a
+// point fails validation.
+func ParseProofFromSnarkjsJSON(data []byte) (*groth16bn254.Proof, error) {
+	var decoded snarkjsProof
+
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return nil, ErrorInvalidSnarkjsJSON
+	}
+
+	if err := checkSnarkjsHeader(decoded.Protocol, decoded.Curve); err != nil {
+		return nil, err
+	}
+
+	var proof groth16bn254.Proof
+
+	if err := parseSnarkjsG1(decoded.A, &proof.Ar); err != nil {
+		return nil, err
+	}
+
+	if err := parseSnarkjsG2(decoded.B, &proof.Bs); err != nil {
+		return nil, err
+	}
+
+	if err := parseSnarkjsG1(decoded.C, &proof.Krs); err != nil {
+		return nil, err
+	}
+
+	return &proof, nil
+}
+
+// ParsePublicWitnessFromJSON builds a gnark BN254 public witness from the
+// public.json produced by snarkjs, a JSON array of decimal strings holding
+// the public signals of a circom circuit.
+//
+// The signals keep their order, which must be the order of the IC points
+// of the verifying key. Each value is reduced modulo the scalar field.
+//
+// Returns ErrorInvalidSnarkjsJSON if data is not a JSON array of
+// non-negative decimal strings.
+func ParsePublicWitnessFromJSON(data []byte) (witness.Witness, error) {
+	var signals []string
+
+	if err := json.Unmarshal(data, &signals); err != nil {
+		return nil, ErrorInvalidSnarkjsJSON
+	}
+
+	// The channel is sized from the signals, so sending them all never blocks.
+	channel := make(chan any, len(signals))
+
+	for _, signal := range signals {
+		value, ok := new(big.Int).SetString(signal, 10)
+
+		if !ok || value.Sign() < 0 {
+			return nil, ErrorInvalidSnarkjsJSON
+		}
+
+		channel <- value.Mod(value, fr.Modulus())
+	}
+
+	close(channel)
+
+	publicWitness, _ := witness.New(ecc.BN254.ScalarField())
+
+	if err := publicWitness.Fill(len(signals), 0, channel); err != nil {
+		return nil, err
+	}
+
+	return publicWitness, nil
+}
+
+// checkSnarkjsHeader returns ErrorInvalidSnarkjsJSON if the protocol or
+// curve of a snarkjs JSON file is set to anything other than Groth16 over
+// BN254, which snarkjs calls bn128.
+func checkSnarkjsHeader(protocol, curve string) error {
+	if protocol != "" && protocol != "groth16" {
+		return ErrorInvalidSnarkjsJSON
+	}
+
+	if curve != "" && curve != "bn128" {
+		return ErrorInvalidSnarkjsJSON
+	}
+
+	return nil
+}
+
+// parseSnarkjsG1 parses a snarkjs G1 point [x, y, z] into destination.
+//
+// z must be 1, or 0 for the point at infinity. Returns
+// ErrorInvalidSnarkjsJSON for a malformed point and common.ErrorInvalidG1
+// if the point is not on the curve or not in the prime-order subgroup.
+func parseSnarkjsG1(coordinates []string, destination *bn254.G1Affine) error {
+	if len(coordinates) != 3 {
+		return ErrorInvalidSnarkjsJSON
+	}
+
+	var z fp.Element
+
+	if err := parseSnarkjsField(coordinates[2], &z); err != nil {
+		return err
+	}
+
+	if z.IsZero() {
+		destination.SetInfinity()
+
+		return nil
+	}
+
+	if !z.IsOne() {
+		return ErrorInvalidSnarkjsJSON
+	}
+
+	if err := parseSnarkjsField(coordinates[0], &destination.X); err != nil {
+		return err
+	}
+
+	if err := parseSnarkjsField(coordinates[1], &destination.Y); err != nil {
+		return err
+	}
+
+	if !destination.IsOnCurve() || !destination.IsInSubGroup() {
+		return common.ErrorInvalidG1
+	}
+
+	return nil
+}
+
+// parseSnarkjsG2 parses a snarkjs G2 point [[x0, x1], [y0, y1], [z0, z1]]
+// into destination, where index 0 holds the real part (A0) and index 1 the
+// imaginary part (A1) of each coordinate.
+//
+// z must be [1, 0], or [0, 0] for the point at infinity. Returns
+// ErrorInvalidSnarkjsJSON for a malformed point and common.ErrorInvalidG2
+// if the point is not on the curve or not in the prime-order subgroup.
+func parseSnarkjsG2(coordinates [][]string, destination *bn254.G2Affine) error {
+	if len(coordinates) != 3 {
+		return ErrorInvalidSnarkjsJSON
+	}
+
+	for _, coordinate := range coordinates {
+		if len(coordinate) != 2 {
+			return ErrorInvalidSnarkjsJSON
+		}
+	}
+
+	var z bn254.E2
+
+	if err := parseSnarkjsField(coordinates[2][0], &z.A0); err != nil {
+		return err
+	}
+
+	if err := parseSnarkjsField(coordinates[2][1], &z.A1); err != nil {
+		return err
+	}
+
+	if z.IsZero() {
+		destination.SetInfinity()
+
+		return nil
+	}
+
+	if !z.IsOne() {
+		return ErrorInvalidSnarkjsJSON
+	}
+
+	components := []*fp.Element{
+		&destination.X.A0, &destination.X.A1,
+		&destination.Y.A0, &destination.Y.A1,
+	}
+
+	for index, component := range components {
+		if err := parseSnarkjsField(coordinates[index/2][index%2], component); err != nil {
+			return err
+		}
+	}
+
+	if !destination.IsOnCurve() || !destination.IsInSubGroup() {
+		return common.ErrorInvalidG2
+	}
+
+	return nil
+}
+
+// parseSnarkjsField parses a decimal string into a base field element.
+//
+// Returns ErrorInvalidSnarkjsJSON if value is not a non-negative decimal
+// integer lower than the base field modulus.
+func parseSnarkjsField(value string, destination *fp.Element) error {
+	parsed, ok := new(big.Int).SetString(value, 10)
+
+	if !ok || parsed.Sign() < 0 || parsed.Cmp(fp.Modulus()) >= 0 {
+		return ErrorInvalidSnarkjsJSON
+	}
+
+	destination.SetBigInt(parsed)
+
+	return nil
+}
@@ -0,0 +1,363 @@
+package bn254
+
+import (
+	"encoding/json"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bn254"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fp"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	"github.com/consensys/gnark/backend/groth16"
+	groth16bn254 "github.com/consensys/gnark/backend/groth16/bn254"
+	"github.com/consensys/gnark/frontend"
+	"github.com/privacy-ethereum/privacy-precompiles/common"
+	"github.com/stretchr/testify/assert"
+)
+
+// readSnarkjsFixture returns the content of a file of testdata/snarkjs,
+// which holds the snarkjs verification_key.json, proof.json and
+// public.json of VariablePublicCircuit with the public inputs 3 and 5.
+func readSnarkjsFixture(tb testing.TB, name string) []byte {
+	tb.Helper()
+
+	data, err := os.ReadFile(filepath.Join("testdata", "snarkjs", name))
+
+	if err != nil {
+		tb.Fatal(err)
+	}
+
+	return data
+}
+
+func TestParseVerifyingKeyFromSnarkjsJSON(t *testing.T) {
+	vkJSON := readSnarkjsFixture(t, "verification_key.json")
+	vk, err := ParseVerifyingKeyFromSnarkjsJSON(vkJSON)
+
+	assert.Nil(t, err)
+	assert.Len(t, vk.G1.K, 3)
+	assert.Equal(t, "19430552368104503419600434869514205811883534166778209331074044647411053051472", vk.G1.Alpha.X.String())
+
+	// vk_alphabeta_12 is e(alpha, beta), so it only matches if the G2
+	// coordinates were read with the snarkjs ordering.
+	var decoded struct {
+		AlphaBeta [][][]string `json:"vk_alphabeta_12"`
+	}
+
+	assert.Nil(t, json.Unmarshal(vkJSON, &decoded))
+
+	alphaBeta, err := bn254.Pair([]bn254.G1Affine{vk.G1.Alpha}, []bn254.G2Affine{vk.G2.Beta})
+	assert.Nil(t, err)
+
+	components := []*fp.Element{
+		&alphaBeta.C0.B0.A0, &alphaBeta.C0.B0.A1,
+		&alphaBeta.C0.B1.A0, &alphaBeta.C0.B1.A1,
+		&alphaBeta.C0.B2.A0, &alphaBeta.C0.B2.A1,
+		&alphaBeta.C1.B0.A0, &alphaBeta.C1.B0.A1,
+		&alphaBeta.C1.B1.A0, &alphaBeta.C1.B1.A1,
+		&alphaBeta.C1.B2.A0, &alphaBeta.C1.B2.A1,
+	}
+
+	for index, component := range components {
+		assert.Equal(t, decoded.AlphaBeta[index/6][index/2%3][index%2], component.String())
+	}
+
+	// The imported key serializes to the Solidity layout read by the
+	// precompile parser.
+	parser := SolidityBN254Parser{}
+	parsed, err := parser.ParseVerifyingKey(SerializeVerifyingKey(vk), 2)
+
+	assert.Nil(t, err)
+
+	typed := parsed.(*groth16bn254.VerifyingKey)
+
+	assert.Equal(t, vk.G1.Alpha, typed.G1.Alpha)
+	assert.Equal(t, vk.G1.K, typed.G1.K)
+	assert.Equal(t, vk.G2.Beta, typed.G2.Beta)
+	assert.Equal(t, vk.G2.Gamma, typed.G2.Gamma)
+	assert.Equal(t, vk.G2.Delta, typed.G2.Delta)
+}
+
+func TestParseVerifyingKeyFromSnarkjsJSONErrors(t *testing.T) {
+	vkJSON := readSnarkjsFixture(t, "verification_key.json")
+	modulus := fp.Modulus().String()
+
+	tests := []struct {
+		name          string
+		mutate        func(vk map[string]any)
+		data          []byte
+		expectedError error
+	}{
+		{
+			name:          "invalid json",
+			data:          []byte("{"),
+			expectedError: ErrorInvalidSnarkjsJSON,
+		},
+		{
+			name:          "plonk verifying key",
+			mutate:        func(vk map[string]any) { vk["protocol"] = "plonk" },
+			expectedError: ErrorInvalidSnarkjsJSON,
+		},
+		{
+			name:          "bls12381 verifying key",
+			mutate:        func(vk map[string]any) { vk["curve"] = "bls12381" },
+			expectedError: ErrorInvalidSnarkjsJSON,
+		},
+		{
+			name:          "nPublic not matching the IC points",
+			mutate:        func(vk map[string]any) { vk["nPublic"] = 3 },
+			expectedError: ErrorInvalidSnarkjsJSON,
+		},
+		{
+			name:          "missing IC",
+			mutate:        func(vk map[string]any) { delete(vk, "IC") },
+			expectedError: ErrorInvalidSnarkjsJSON,
+		},
+		{
+			name:          "missing alpha",
+			mutate:        func(vk map[string]any) { delete(vk, "vk_alpha_1") },
+			expectedError: ErrorInvalidSnarkjsJSON,
+		},
+		{
+			name:          "hexadecimal coordinate",
+			mutate:        func(vk map[string]any) { vk["vk_alpha_1"].([]any)[0] = "0x01" },
+			expectedError: ErrorInvalidSnarkjsJSON,
+		},
+		{
+			name:          "coordinate equal to the field modulus",
+			mutate:        func(vk map[string]any) { vk["vk_alpha_1"].([]any)[0] = modulus },
+			expectedError: ErrorInvalidSnarkjsJSON,
+		},
+		{
+			name:          "non-normalized projective coordinate",
+			mutate:        func(vk map[string]any) { vk["vk_alpha_1"].([]any)[2] = "2" },
+			expectedError: ErrorInvalidSnarkjsJSON,
+		},
+		{
+			name:          "G2 point with a missing coordinate",
+			mutate:        func(vk map[string]any) { vk["vk_beta_2"] = vk["vk_beta_2"].([]any)[:2] },
+			expectedError: ErrorInvalidSnarkjsJSON,
+		},
+		{
+			name:          "G1 point off the curve",
+			mutate:        func(vk map[string]any) { vk["IC"].([]any)[1].([]any)[1] = "1" },
+			expectedError: common.ErrorInvalidG1,
+		},
+		{
+			name: "G2 point in EIP-197 ordering",
+			mutate: func(vk map[string]any) {
+				beta := vk["vk_beta_2"].([]any)
+
+				for _, coordinate := range beta[:2] {
+					parts := coordinate.([]any)
+					parts[0], parts[1] = parts[1], parts[0]
+				}
+			},
+			expectedError: common.ErrorInvalidG2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := tt.data
+
+			if tt.mutate != nil {
+				var vk map[string]any
+
+				assert.Nil(t, json.Unmarshal(vkJSON, &vk))
+				tt.mutate(vk)
+
+				data, _ = json.Marshal(vk)
+			}
+
+			vk, err := ParseVerifyingKeyFromSnarkjsJSON(data)
+
+			assert.Nil(t, vk)
+			assert.Equal(t, tt.expectedError, err)
+		})
+	}
+}
+
+func TestParseProofFromSnarkjsJSON(t *testing.T) {
+	vk, err := ParseVerifyingKeyFromSnarkjsJSON(readSnarkjsFixture(t, "verification_key.json"))
+	assert.Nil(t, err)
+
+	proof, err := ParseProofFromSnarkjsJSON(readSnarkjsFixture(t, "proof.json"))
+	assert.Nil(t, err)
+
+	assert.Equal(t, "17221063597234665339155246618452643493628736191752349674097899639850321855908", proof.Ar.X.String())
+	assert.Equal(t, "10163174798820799089532306663949388871993941447364353114433698540278495773613", proof.Bs.X.A0.String())
+	assert.Empty(t, proof.Commitments)
+
+	assignment := &VariablePublicCircuit{Public: []frontend.Variable{3, 5}}
+	publicWitness, err := frontend.NewWitness(assignment, ecc.BN254.ScalarField(), frontend.PublicOnly())
+	assert.Nil(t, err)
+
+	assert.Nil(t, groth16.Verify(proof, vk, publicWitness))
+}
+
+func TestParseProofFromSnarkjsJSONErrors(t *testing.T) {
+	proofJSON := readSnarkjsFixture(t, "proof.json")
+
+	tests := []struct {
+		name          string
+		mutate        func(proof map[string]any)
+		data          []byte
+		expectedError error
+	}{
+		{
+			name:          "invalid json",
+			data:          []byte("[]"),
+			expectedError: ErrorInvalidSnarkjsJSON,
+		},
+		{
+			name:          "plonk proof",
+			mutate:        func(proof map[string]any) { proof["protocol"] = "plonk" },
+			expectedError: ErrorInvalidSnarkjsJSON,
+		},
+		{
+			name:          "missing pi_c",
+			mutate:        func(proof map[string]any) { delete(proof, "pi_c") },
+			expectedError: ErrorInvalidSnarkjsJSON,
+		},
+		{
+			name:          "negative coordinate",
+			mutate:        func(proof map[string]any) { proof["pi_a"].([]any)[1] = "-1" },
+			expectedError: ErrorInvalidSnarkjsJSON,
+		},
+		{
+			name:          "pi_a off the curve",
+			mutate:        func(proof map[string]any) { proof["pi_a"].([]any)[1] = "1" },
+			expectedError: common.ErrorInvalidG1,
+		},
+		{
+			name: "pi_b in EIP-197 ordering",
+			mutate: func(proof map[string]any) {
+				for _, coordinate := range proof["pi_b"].([]any)[:2] {
+					parts := coordinate.([]any)
+					parts[0], parts[1] = parts[1], parts[0]
+				}
+			},
+			expectedError: common.ErrorInvalidG2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := tt.data
+
+			if tt.mutate != nil {
+				var proof map[string]any
+
+				assert.Nil(t, json.Unmarshal(proofJSON, &proof))
+				tt.mutate(proof)
+
+				data, _ = json.Marshal(proof)
+			}
+
+			proof, err := ParseProofFromSnarkjsJSON(data)
+
+			assert.Nil(t, proof)
+			assert.Equal(t, tt.expectedError, err)
+		})
+	}
+}
+
+func TestParsePublicWitnessFromJSON(t *testing.T) {
+	modulus := fr.Modulus()
+
+	tests := []struct {
+		name          string
+		data          []byte
+		expected      []string
+		expectedError error
+	}{
+		{
+			name:     "snarkjs public signals",
+			data:     readSnarkjsFixture(t, "public.json"),
+			expected: []string{"3", "5"},
+		},
+		{
+			name:     "order is preserved",
+			data:     []byte(`["5", "3"]`),
+			expected: []string{"5", "3"},
+		},
+		{
+			name:     "values reduced into the scalar field",
+			data:     []byte(`["` + modulus.String() + `", "` + new(big.Int).Add(modulus, big.NewInt(7)).String() + `"]`),
+			expected: []string{"0", "7"},
+		},
+		{
+			name:     "no public signals",
+			data:     []byte(`[]`),
+			expected: []string{},
+		},
+		{
+			name:          "invalid json",
+			data:          []byte(`["3",`),
+			expectedError: ErrorInvalidSnarkjsJSON,
+		},
+		{
+			name:          "numbers instead of strings",
+			data:          []byte(`[3, 5]`),
+			expectedError: ErrorInvalidSnarkjsJSON,
+		},
+		{
+			name:          "hexadecimal signal",
+			data:          []byte(`["0x03"]`),
+			expectedError: ErrorInvalidSnarkjsJSON,
+		},
+		{
+			name:          "negative signal",
+			data:          []byte(`["-3"]`),
+			expectedError: ErrorInvalidSnarkjsJSON,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			publicWitness, err := ParsePublicWitnessFromJSON(tt.data)
+
+			if tt.expectedError != nil {
+				assert.Nil(t, publicWitness)
+				assert.Equal(t, tt.expectedError, err)
+
+				return
+			}
+
+			assert.Nil(t, err)
+
+			vector := publicWitness.Vector().(fr.Vector)
+			actual := make([]string, len(vector))
+
+			for index := range vector {
+				actual[index] = vector[index].String()
+			}
+
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func TestSnarkjsArtifactsVerify(t *testing.T) {
+	vk, err := ParseVerifyingKeyFromSnarkjsJSON(readSnarkjsFixture(t, "verification_key.json"))
+	assert.Nil(t, err)
+
+	proof, err := ParseProofFromSnarkjsJSON(readSnarkjsFixture(t, "proof.json"))
+	assert.Nil(t, err)
+
+	publicWitness, err := ParsePublicWitnessFromJSON(readSnarkjsFixture(t, "public.json"))
+	assert.Nil(t, err)
+
+	assert.Nil(t, groth16.Verify(proof, vk, publicWitness))
+
+	// gnark binds each public input to its IC point, so swapping the
+	// signals must break the proof.
+	swapped, err := ParsePublicWitnessFromJSON([]byte(`["5", "3"]`))
+	assert.Nil(t, err)
+
+	assert.NotNil(t, groth16.Verify(proof, vk, swapped))
+}
@@ -1,49 +1,39 @@
 package bn254
 
 import (
+	"math"
 	"math/big"
 	"reflect"
 
 	"github.com/consensys/gnark-crypto/ecc/bn254"
-	"github.com/consensys/gnark-crypto/ecc/bn254/fp"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr"
 	groth16bn254 "github.com/consensys/gnark/backend/groth16/bn254"
 	"github.com/consensys/gnark/frontend"
 	"github.com/leanovate/gopter"
 	"github.com/leanovate/gopter/gen"
-	"github.com/privacy-ethereum/privacy-precompiles/babyjubjub/utils"
 )
 
 // G1AffineGenerator returns a gopter generator for random BN254 G1 affine points.
-// It generates two uint64 values and maps them to the X and Y coordinates of a G1Affine point.
+// It generates a non-zero uint64 scalar and multiplies the G1 generator by it, so
+// every produced point lies on the curve and in the prime-order subgroup.
 func G1AffineGenerator() gopter.Gen {
-	return gen.SliceOfN(2, gen.UInt64()).Map(func(value []uint64) *bn254.G1Affine {
+	return gen.UInt64Range(1, math.MaxUint64).Map(func(value uint64) *bn254.G1Affine {
+		point := &bn254.G1Affine{}
+		point.ScalarMultiplicationBase(new(big.Int).SetUint64(value))
 
-		var X, Y fp.Element
-		X.SetUint64(value[0])
-		Y.SetUint64(value[1])
-
-		return &bn254.G1Affine{
-			X: X,
-			Y: Y,
-		}
+		return point
 	})
 }
 
 // G2AffineGenerator returns a gopter generator for random BN254 G2 affine points.
-// It generates four uint64 values and maps them to the coefficients of the X and Y components of a G2Affine point.
+// It generates a non-zero uint64 scalar and multiplies the G2 generator by it, so
+// every produced point lies on the curve and in the prime-order subgroup.
 func G2AffineGenerator() gopter.Gen {
-	return gen.SliceOfN(4, gen.UInt64()).Map(func(value []uint64) *bn254.G2Affine {
-		var X, Y bn254.E2
+	return gen.UInt64Range(1, math.MaxUint64).Map(func(value uint64) *bn254.G2Affine {
+		point := &bn254.G2Affine{}
+		point.ScalarMultiplicationBase(new(big.Int).SetUint64(value))
 
-		X.A1.SetUint64(value[0])
-		X.A0.SetUint64(value[1])
-		Y.A1.SetUint64(value[2])
-		Y.A0.SetUint64(value[3])
-
-		return &bn254.G2Affine{
-			X: X,
-			Y: Y,
-		}
+		return point
 	})
 }
 
@@ -72,32 +62,6 @@ func ProofBytesGenerator() gopter.Gen {
 	})
 }
 
-// SerializeVerifyingKey converts a gnark Groth16 verifying key into a byte slice.
-func SerializeProof(value *groth16bn254.Proof) []byte {
-	out := make([]byte, 0)
-
-	x := value.Ar.X.Bytes()
-	y := value.Ar.Y.Bytes()
-	out = append(out, x[:]...)
-	out = append(out, y[:]...)
-
-	x1 := value.Bs.X.A1.Bytes()
-	x0 := value.Bs.X.A0.Bytes()
-	y1 := value.Bs.Y.A1.Bytes()
-	y0 := value.Bs.Y.A0.Bytes()
-	out = append(out, x1[:]...)
-	out = append(out, x0[:]...)
-	out = append(out, y1[:]...)
-	out = append(out, y0[:]...)
-
-	x = value.Krs.X.Bytes()
-	y = value.Krs.Y.Bytes()
-	out = append(out, x[:]...)
-	out = append(out, y[:]...)
-
-	return out
-}
-
 // G1Struct represents the G1 components of a Groth16 verifying key.
 type G1Struct struct {
 	Alpha, Beta, Delta *bn254.G1Affine   // Key points in G1
@@ -150,45 +114,22 @@ func VerifyingKeyGenerator(numberOfPublicInputs int) gopter.Gen {
 	})
 }
 
-// SerializeVerifyingKey converts a gnark Groth16 verifying key into a byte slice.
-func SerializeVerifyingKey(value *groth16bn254.VerifyingKey) []byte {
-	out := make([]byte, 0)
-
-	serializeG1 := func(p bn254.G1Affine) {
-		x := p.X.Bytes()
-		y := p.Y.Bytes()
-		out = append(out, x[:]...)
-		out = append(out, y[:]...)
-	}
+// ScalarGenerator returns a gopter generator for random BN254 scalar
+// field elements. It draws BN254Groth16SinglePublicInputSize random bytes and
+// reduces them modulo the scalar field order, so every produced value is a
+// valid public input.
+func ScalarGenerator() gopter.Gen {
+	return gen.SliceOfN(BN254Groth16SinglePublicInputSize, gen.UInt8()).Map(func(bytes []byte) *big.Int {
+		x := new(big.Int).SetBytes(bytes)
 
-	serializeG2 := func(p bn254.G2Affine) {
-		x1 := p.X.A1.Bytes()
-		x0 := p.X.A0.Bytes()
-		y1 := p.Y.A1.Bytes()
-		y0 := p.Y.A0.Bytes()
-
-		out = append(out, x1[:]...)
-		out = append(out, x0[:]...)
-		out = append(out, y1[:]...)
-		out = append(out, y0[:]...)
-	}
-
-	serializeG1(value.G1.Alpha)
-	serializeG2(value.G2.Beta)
-	serializeG2(value.G2.Gamma)
-	serializeG2(value.G2.Delta)
-
-	for _, k := range value.G1.K {
-		serializeG1(k)
-	}
-
-	return out
+		return x.Mod(x, fr.Modulus())
+	})
 }
 
 // WitnessBytesGenerator returns a gopter generator that produces byte slices
 // representing sequences of BN254 field elements suitable for use as public witnesses.
 func WitnessBytesGenerator() gopter.Gen {
-	return gen.SliceOf(utils.ScalarGenerator().Map(func(v *big.Int) []byte {
+	return gen.SliceOf(ScalarGenerator().Map(func(v *big.Int) []byte {
 		return v.FillBytes(make([]byte, BN254Groth16FieldSize))
 	})).Map(func(chunks [][]byte) []byte {
 		out := make([]byte, 0, len(chunks)*BN254Groth16FieldSize)
@@ -0,0 +1,193 @@
+package groth16
+
+import (
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/privacy-ethereum/privacy-precompiles/common"
+	"github.com/privacy-ethereum/privacy-precompiles/utils"
+)
+
+// Groth16VerifyByID represents a Groth16 verification precompile whose
+// verifying keys are registered ahead of time and referenced by curve and
+// circuit identifier, for deployments serving many circuits.
+//
+// Keys are kept in a two-level registry: one Groth16Verify per supported
+// curve, each storing the parsed and precomputed keys of its circuits, so
+// Run never parses or precomputes a verifying key.
+type Groth16VerifyByID struct {
+	verifiers map[ecc.ID]*Groth16Verify
+}
+
+// NewGroth16VerifyByID creates a Groth16VerifyByID instance supporting
+// every curve of Groth16Params, with no registered circuit.
+func NewGroth16VerifyByID() *Groth16VerifyByID {
+	verifiers := make(map[ecc.ID]*Groth16Verify, len(Groth16Params))
+
+	for curveID := range Groth16Params {
+		verifiers[curveID] = newGroth16Verify(curveID, SolidityProofParsers[curveID])
+	}
+
+	return &Groth16VerifyByID{verifiers: verifiers}
+}
+
+// RegisterCircuit parses vkBytes for the curve curveID and stores the
+// resulting verifying key under circuitID.
+//
+// vkBytes uses the same encoding as for Groth16Verify.RegisterVerifyingKey.
+// Registering the same bytes again is a no-op. Returns
+// ErrorGroth16VerifyUnsupportedCurve for an unsupported curve,
+// ErrorGroth16VerifyInvalidVerifyingKey if vkBytes cannot be parsed and
+// ErrorGroth16VerifyVerifyingKeyAlreadyRegistered if another key is already
+// registered for the same curve and circuit.
+func (c *Groth16VerifyByID) RegisterCircuit(curveID ecc.ID, circuitID [Groth16CircuitIDSize]byte, vkBytes []byte) error {
+	verifier, ok := c.verifiers[curveID]
+
+	if !ok {
+		return ErrorGroth16VerifyUnsupportedCurve
+	}
+
+	return verifier.register(circuitID, vkBytes)
+}
+
+// Name returns the human-readable identifier of the precompile.
+func (c *Groth16VerifyByID) Name() string {
+	return "Groth16VerifyByID"
+}
+
+// RequiredGas returns the gas cost required to execute the precompile.
+//
+// It is the curve-specific base cost plus the per-public-input cost of
+// the selected curve, as for Groth16Verify. No verifying key parsing is
+// charged. If the curve is unsupported or the input is structurally
+// invalid, this function returns 0.
+func (c *Groth16VerifyByID) RequiredGas(input []byte) uint64 {
+	verifier, _, _, numberOfPublicInputs, err := c.splitInput(input)
+
+	if err != nil {
+		return 0
+	}
+
+	params := Groth16Params[verifier.curveID]
+
+	return uint64(params.baseGas) + uint64(params.perPublicInputGas)*uint64(numberOfPublicInputs)
+}
+
+// MaxOutputSize returns the byte length of the boolean result returned by
+// Run, which is Groth16VerifyOutputSize regardless of the input.
+func (c *Groth16VerifyByID) MaxOutputSize(input []byte) int {
+	return Groth16VerifyOutputSize
+}
+
+// Run verifies a Groth16 proof against a registered verifying key.
+//
+// Expected input layout:
+//
+//	[ Curve || CircuitID || Proof || PublicInputs ]
+//
+// Where:
+//   - Curve is a single byte holding the gnark-crypto ecc.ID of the curve,
+//     e.g. 1 for BN254.
+//   - CircuitID is the Groth16CircuitIDSize-byte identifier the verifying
+//     key was registered under with RegisterCircuit.
+//   - Proof is the plain fixed-size proof encoding of the curve.
+//   - PublicInputs contains n serialized field elements, where n must match
+//     the registered verifying key.
+//
+// Return value:
+//   - []byte{1} if the proof is valid.
+//   - []byte{0} if the proof is invalid.
+//   - ErrorGroth16VerifyUnsupportedCurve if Curve is not supported.
+//   - ErrorGroth16VerifyUnknownCircuit if no verifying key is registered
+//     for Curve and CircuitID.
+//   - ErrorGroth16VerifyInvalidInputLength, ErrorGroth16VerifyInvalidProof
+//     or ErrorGroth16VerifyInvalidPublicWitness for malformed inputs.
+//   - ErrorPanicGroth16Verify if verification panics.
+func (c *Groth16VerifyByID) Run(input []byte) (result []byte, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			result = nil
+			err = ErrorPanicGroth16Verify
+		}
+	}()
+
+	verifier, proofBytes, publicWitnessBytes, numberOfPublicInputs, err := c.splitInput(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	circuitID := [Groth16CircuitIDSize]byte(input[1 : 1+Groth16CircuitIDSize])
+	entry, ok := verifier.store.get(circuitID)
+
+	if !ok {
+		return nil, ErrorGroth16VerifyUnknownCircuit
+	}
+
+	if entry.numberOfPublicInputs != numberOfPublicInputs {
+		return nil, ErrorGroth16VerifyInvalidInputLength
+	}
+
+	proof, err := verifier.parser.ParseProof(proofBytes)
+
+	if err != nil {
+		return nil, ErrorGroth16VerifyInvalidProof
+	}
+
+	publicWitness, err := verifier.parser.ParsePublicWitness(publicWitnessBytes, numberOfPublicInputs)
+
+	if err != nil {
+		return nil, ErrorGroth16VerifyInvalidPublicWitness
+	}
+
+	valid, err := verifier.VerifyTyped(proof, entry.vk, publicWitness)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if !valid {
+		return []byte{0}, nil
+	}
+
+	return []byte{1}, nil
+}
+
+// splitInput validates the Run input layout and returns the verifier of
+// the selected curve, the proof and public witness slices, and the number
+// of public inputs.
+func (c *Groth16VerifyByID) splitInput(input []byte) (*Groth16Verify, []byte, []byte, int, error) {
+	if len(input) == 0 {
+		return nil, nil, nil, 0, ErrorGroth16VerifyInvalidInputLength
+	}
+
+	verifier, ok := c.verifiers[ecc.ID(input[0])]
+
+	if !ok {
+		return nil, nil, nil, 0, ErrorGroth16VerifyUnsupportedCurve
+	}
+
+	params := Groth16Params[verifier.curveID]
+	offset := 1 + Groth16CircuitIDSize
+
+	proofBytes, ok := utils.SafeSlice(input, offset, offset+params.proofSize)
+
+	if !ok {
+		return nil, nil, nil, 0, ErrorGroth16VerifyInvalidInputLength
+	}
+
+	publicWitnessBytes := input[offset+params.proofSize:]
+	numberOfPublicInputs := len(publicWitnessBytes) / params.singlePublicInputSize
+
+	if len(publicWitnessBytes)%params.singlePublicInputSize != 0 ||
+		numberOfPublicInputs <= 0 ||
+		numberOfPublicInputs > verifier.maxPublicInputs {
+		return nil, nil, nil, 0, ErrorGroth16VerifyInvalidInputLength
+	}
+
+	return verifier, proofBytes, publicWitnessBytes, numberOfPublicInputs, nil
+}
+
+// Ensure Groth16VerifyByID implements the common.Precompile interface.
+var _ common.Precompile = (*Groth16VerifyByID)(nil)
+
+// Ensure Groth16VerifyByID implements the common.OutputSizer interface.
+var _ common.OutputSizer = (*Groth16VerifyByID)(nil)
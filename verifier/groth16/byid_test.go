@@ -0,0 +1,189 @@
+package groth16
+
+import (
+	"crypto/sha256"
+	"slices"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/privacy-ethereum/privacy-precompiles/common"
+	"github.com/privacy-ethereum/privacy-precompiles/verifier/groth16/bn254"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGroth16VerifyByIDName(t *testing.T) {
+	precompile := NewGroth16VerifyByID()
+
+	assert.Equal(t, "Groth16VerifyByID", precompile.Name())
+}
+
+func TestGroth16VerifyByIDMaxOutputSize(t *testing.T) {
+	var sizer common.OutputSizer = NewGroth16VerifyByID()
+
+	assert.Equal(t, 1, sizer.MaxOutputSize(nil))
+}
+
+func TestGroth16VerifyByIDRegisterCircuit(t *testing.T) {
+	input := prepareCacheInput(t)
+	vkBytes := input[bn254.BN254Groth16ProofSize : len(input)-2*bn254.BN254Groth16SinglePublicInputSize]
+	circuitID := sha256.Sum256([]byte("circuit"))
+
+	tests := []struct {
+		name          string
+		curveID       ecc.ID
+		vkBytes       []byte
+		expectedError error
+	}{
+		{
+			name:    "valid verifying key",
+			curveID: ecc.BN254,
+			vkBytes: vkBytes,
+		},
+		{
+			name:          "unsupported curve",
+			curveID:       ecc.BW6_761,
+			vkBytes:       vkBytes,
+			expectedError: ErrorGroth16VerifyUnsupportedCurve,
+		},
+		{
+			name:          "verifying key for another curve",
+			curveID:       ecc.BLS12_381,
+			vkBytes:       vkBytes,
+			expectedError: ErrorGroth16VerifyInvalidVerifyingKey,
+		},
+		{
+			name:          "truncated verifying key",
+			curveID:       ecc.BN254,
+			vkBytes:       vkBytes[:len(vkBytes)-1],
+			expectedError: ErrorGroth16VerifyInvalidVerifyingKey,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			precompile := NewGroth16VerifyByID()
+
+			err := precompile.RegisterCircuit(tt.curveID, circuitID, tt.vkBytes)
+
+			assert.Equal(t, tt.expectedError, err)
+		})
+	}
+
+	precompile := NewGroth16VerifyByID()
+	otherVkBytes := buildDigestInput(t, 2)[bn254.BN254Groth16ProofSize : len(input)-2*bn254.BN254Groth16SinglePublicInputSize]
+
+	assert.Nil(t, precompile.RegisterCircuit(ecc.BN254, circuitID, vkBytes))
+	assert.Nil(t, precompile.RegisterCircuit(ecc.BN254, circuitID, vkBytes))
+	assert.Equal(t, ErrorGroth16VerifyVerifyingKeyAlreadyRegistered, precompile.RegisterCircuit(ecc.BN254, circuitID, otherVkBytes))
+}
+
+func TestGroth16VerifyByID(t *testing.T) {
+	input := prepareCacheInput(t)
+	publicInputsSize := 2 * bn254.BN254Groth16SinglePublicInputSize
+
+	proofBytes := input[:bn254.BN254Groth16ProofSize]
+	vkBytes := input[bn254.BN254Groth16ProofSize : len(input)-publicInputsSize]
+	publicInputs := input[len(input)-publicInputsSize:]
+
+	circuitID := sha256.Sum256([]byte("circuit"))
+	otherCircuitID := sha256.Sum256([]byte("other circuit"))
+
+	byID := func(curveID ecc.ID, circuitID [Groth16CircuitIDSize]byte, publicInputs []byte) []byte {
+		return slices.Concat([]byte{byte(curveID)}, circuitID[:], proofBytes, publicInputs)
+	}
+
+	tampered := slices.Clone(publicInputs)
+	tampered[len(tampered)-1] ^= 1
+
+	expectedGas := uint64(bn254.BN254Groth16VerifyBaseGas + 2*bn254.BN254Groth16PerPublicInputGas)
+
+	tests := []struct {
+		name          string
+		input         []byte
+		expected      []byte
+		expectedGas   uint64
+		expectedError error
+	}{
+		{
+			name:        "valid proof for a registered circuit",
+			input:       byID(ecc.BN254, circuitID, publicInputs),
+			expected:    []byte{1},
+			expectedGas: expectedGas,
+		},
+		{
+			name:        "invalid proof for a registered circuit",
+			input:       byID(ecc.BN254, circuitID, tampered),
+			expected:    []byte{0},
+			expectedGas: expectedGas,
+		},
+		{
+			name:          "unknown circuit",
+			input:         byID(ecc.BN254, otherCircuitID, publicInputs),
+			expectedError: ErrorGroth16VerifyUnknownCircuit,
+		},
+		{
+			name:          "unsupported curve",
+			input:         byID(ecc.BW6_761, circuitID, publicInputs),
+			expectedError: ErrorGroth16VerifyUnsupportedCurve,
+		},
+		{
+			name:          "unknown curve identifier",
+			input:         byID(ecc.UNKNOWN, circuitID, publicInputs),
+			expectedError: ErrorGroth16VerifyUnsupportedCurve,
+		},
+		{
+			name:          "public input count not matching the registered circuit",
+			input:         byID(ecc.BN254, circuitID, publicInputs[:bn254.BN254Groth16SinglePublicInputSize]),
+			expectedError: ErrorGroth16VerifyInvalidInputLength,
+		},
+		{
+			name:          "misaligned public inputs",
+			input:         byID(ecc.BN254, circuitID, publicInputs[1:]),
+			expectedError: ErrorGroth16VerifyInvalidInputLength,
+		},
+		{
+			name:          "truncated proof",
+			input:         slices.Concat([]byte{byte(ecc.BN254)}, circuitID[:], proofBytes[1:]),
+			expectedError: ErrorGroth16VerifyInvalidInputLength,
+		},
+		{
+			name:          "empty input",
+			input:         []byte{},
+			expectedError: ErrorGroth16VerifyInvalidInputLength,
+		},
+	}
+
+	precompile := NewGroth16VerifyByID()
+	assert.Nil(t, precompile.RegisterCircuit(ecc.BN254, circuitID, vkBytes))
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, err := precompile.Run(tt.input)
+
+			if tt.expectedError != nil {
+				assert.Equal(t, tt.expectedError, err)
+
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, tt.expected, actual)
+			assert.Equal(t, tt.expectedGas, precompile.RequiredGas(tt.input))
+		})
+	}
+}
+
+func TestGroth16VerifyByIDSeparatesCurves(t *testing.T) {
+	input := prepareCacheInput(t)
+	vkBytes := input[bn254.BN254Groth16ProofSize : len(input)-2*bn254.BN254Groth16SinglePublicInputSize]
+	circuitID := sha256.Sum256([]byte("circuit"))
+
+	precompile := NewGroth16VerifyByID()
+	assert.Nil(t, precompile.RegisterCircuit(ecc.BN254, circuitID, vkBytes))
+
+	_, ok := precompile.verifiers[ecc.BN254].store.get(circuitID)
+	assert.True(t, ok)
+
+	_, ok = precompile.verifiers[ecc.BLS12_381].store.get(circuitID)
+	assert.False(t, ok)
+}
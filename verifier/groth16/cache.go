@@ -0,0 +1,96 @@
+package groth16
+
+import (
+	"container/list"
+	"crypto/sha256"
+	"sync"
+
+	"github.com/consensys/gnark/backend/groth16"
+)
+
+// verifyingKeyCache is a bounded, concurrency-safe LRU cache of parsed and
+// precomputed Groth16 verifying keys, keyed by the SHA-256 hash of their
+// serialized bytes.
+//
+// Lookups update the recency order, so every access takes the exclusive
+// lock. A nil cache is valid and never stores anything.
+type verifyingKeyCache struct {
+	mutex    sync.Mutex
+	capacity int
+	entries  map[[sha256.Size]byte]*list.Element
+	order    *list.List
+}
+
+// verifyingKeyCacheEntry is a single element of the LRU order list.
+type verifyingKeyCacheEntry struct {
+	key [sha256.Size]byte
+	vk  groth16.VerifyingKey
+}
+
+// newVerifyingKeyCache returns an empty cache holding at most capacity keys.
+func newVerifyingKeyCache(capacity int) *verifyingKeyCache {
+	return &verifyingKeyCache{
+		capacity: capacity,
+		entries:  make(map[[sha256.Size]byte]*list.Element, capacity),
+		order:    list.New(),
+	}
+}
+
+// get returns the verifying key cached for key and marks it as most
+// recently used.
+func (c *verifyingKeyCache) get(key [sha256.Size]byte) (groth16.VerifyingKey, bool) {
+	if c == nil {
+		return nil, false
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	element, ok := c.entries[key]
+
+	if !ok {
+		return nil, false
+	}
+
+	c.order.MoveToFront(element)
+
+	return element.Value.(*verifyingKeyCacheEntry).vk, true
+}
+
+// add stores vk under key, evicting the least recently used entry when
+// the cache is full.
+func (c *verifyingKeyCache) add(key [sha256.Size]byte, vk groth16.VerifyingKey) {
+	if c == nil || c.capacity <= 0 {
+		return
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if element, ok := c.entries[key]; ok {
+		element.Value.(*verifyingKeyCacheEntry).vk = vk
+		c.order.MoveToFront(element)
+
+		return
+	}
+
+	if c.order.Len() >= c.capacity {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*verifyingKeyCacheEntry).key)
+	}
+
+	c.entries[key] = c.order.PushFront(&verifyingKeyCacheEntry{key: key, vk: vk})
+}
+
+// len returns the number of cached verifying keys.
+func (c *verifyingKeyCache) len() int {
+	if c == nil {
+		return 0
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	return c.order.Len()
+}
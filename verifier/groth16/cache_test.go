@@ -0,0 +1,145 @@
+package groth16
+
+import (
+	"crypto/sha256"
+	"sync"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark/backend/groth16"
+	groth16bn254 "github.com/consensys/gnark/backend/groth16/bn254"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/frontend/cs/r1cs"
+	"github.com/privacy-ethereum/privacy-precompiles/verifier/groth16/bn254"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVerifyingKeyCache(t *testing.T) {
+	cache := newVerifyingKeyCache(2)
+
+	first := sha256.Sum256([]byte{1})
+	second := sha256.Sum256([]byte{2})
+	third := sha256.Sum256([]byte{3})
+
+	firstKey := &groth16bn254.VerifyingKey{}
+	secondKey := &groth16bn254.VerifyingKey{}
+	thirdKey := &groth16bn254.VerifyingKey{}
+
+	cache.add(first, firstKey)
+	cache.add(second, secondKey)
+
+	// Touch the first key so the second one becomes least recently used.
+	vk, ok := cache.get(first)
+	assert.True(t, ok)
+	assert.Same(t, firstKey, vk)
+
+	cache.add(third, thirdKey)
+
+	_, ok = cache.get(second)
+	assert.False(t, ok)
+
+	vk, ok = cache.get(first)
+	assert.True(t, ok)
+	assert.Same(t, firstKey, vk)
+
+	vk, ok = cache.get(third)
+	assert.True(t, ok)
+	assert.Same(t, thirdKey, vk)
+
+	assert.Equal(t, 2, cache.len())
+}
+
+func TestVerifyingKeyCacheNil(t *testing.T) {
+	var cache *verifyingKeyCache
+
+	cache.add(sha256.Sum256([]byte{1}), &groth16bn254.VerifyingKey{})
+	_, ok := cache.get(sha256.Sum256([]byte{1}))
+
+	assert.False(t, ok)
+	assert.Equal(t, 0, cache.len())
+}
+
+func TestGroth16WarmCache(t *testing.T) {
+	valid := prepareCacheInput(t)
+
+	invalid := append([]byte{}, valid...)
+	invalid[len(invalid)-1] ^= 1
+
+	for _, input := range [][]byte{valid, invalid} {
+		cold := NewGroth16BN254Verify()
+		expected, expectedErr := cold.Run(input)
+
+		warm := NewGroth16BN254Verify()
+		_, _ = warm.Run(valid)
+		assert.Equal(t, 1, warm.cache.len())
+
+		actual, err := warm.Run(input)
+
+		assert.Equal(t, expectedErr, err)
+		assert.Equal(t, expected, actual)
+		assert.Equal(t, 1, warm.cache.len())
+	}
+}
+
+func TestGroth16WarmCacheConcurrent(t *testing.T) {
+	input := prepareCacheInput(t)
+	precompile := NewGroth16BN254Verify()
+
+	var wg sync.WaitGroup
+
+	for range 8 {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			result, err := precompile.Run(input)
+
+			assert.Nil(t, err)
+			assert.Equal(t, []byte{1}, result)
+		}()
+	}
+
+	wg.Wait()
+
+	assert.Equal(t, 1, precompile.cache.len())
+}
+
+func BenchmarkGroth16VerifyColdCache(b *testing.B) {
+	input := prepareCacheInput(b)
+
+	for b.Loop() {
+		precompile := NewGroth16BN254Verify()
+		_, _ = precompile.Run(input)
+	}
+}
+
+func BenchmarkGroth16VerifyWarmCache(b *testing.B) {
+	input := prepareCacheInput(b)
+	precompile := NewGroth16BN254Verify()
+	_, _ = precompile.Run(input)
+
+	for b.Loop() {
+		_, _ = precompile.Run(input)
+	}
+}
+
+// prepareCacheInput returns a valid BN254 Run input for a circuit with
+// two public inputs.
+func prepareCacheInput(tb testing.TB) []byte {
+	assignment := &twoPublicInputCircuit{X: 1, Y: 2}
+	ccs, _ := frontend.Compile(ecc.BN254.ScalarField(), r1cs.NewBuilder, &twoPublicInputCircuit{})
+	pk, vk, _ := groth16.Setup(ccs)
+	witness, _ := frontend.NewWitness(assignment, ecc.BN254.ScalarField())
+	witnessPublic, _ := witness.Public()
+
+	proof, err := groth16.Prove(ccs, pk, witness)
+	assert.Nil(tb, err)
+
+	proofBytes := bn254.SerializeProof(proof.(*groth16bn254.Proof))
+	vkBytes := bn254.SerializeVerifyingKey(vk.(*groth16bn254.VerifyingKey))
+	witnessBytes, _ := witnessPublic.MarshalBinary()
+	publicInputs, _ := bn254.StripWitnessHeader(witnessBytes)
+
+	return append(append(proofBytes, vkBytes...), publicInputs...)
+}
@@ -1,9 +1,14 @@
 package groth16
 
 import (
+	"maps"
+	"slices"
+
 	"github.com/consensys/gnark-crypto/ecc"
 	"github.com/consensys/gnark/backend/groth16"
 	"github.com/consensys/gnark/backend/witness"
+	bls12377Groth16 "github.com/privacy-ethereum/privacy-precompiles/verifier/groth16/bls12377"
+	bls12381Groth16 "github.com/privacy-ethereum/privacy-precompiles/verifier/groth16/bls12381"
 	bn254Groth16 "github.com/privacy-ethereum/privacy-precompiles/verifier/groth16/bn254"
 )
 
@@ -18,10 +23,12 @@ import (
 // Each supported curve must define its own parameter set.
 type Groth16CurveParams struct {
 	proofSize             int // Expected byte size of a serialized Groth16 proof
+	compressedProofSize   int // Byte size of a compressed proof, 0 if not supported
 	vkSize                int // Expected byte size of a serialized verifying key
 	g1Size                int // Byte size of a single G1 point
 	singlePublicInputSize int // Byte size of a single public input field element
 	baseGas               int // Base gas cost for executing Groth16 verification
+	perPublicInputGas     int // Gas cost per public input
 }
 
 // SolidityGroth16ByteParser defines the interface for parsing Groth16
@@ -48,6 +55,16 @@ type SolidityGroth16ByteParser interface {
 	ParsePublicWitness(data []byte, numberOfPublicInputs int) (witness.Witness, error)
 }
 
+// CompressedProofParser is implemented by parsers that can decode Groth16
+// proofs whose points use a compressed encoding.
+//
+// Groth16Verify uses it for inputs starting with Groth16CompressedInputFlag.
+type CompressedProofParser interface {
+	// ParseProofCompressed parses a serialized compressed Groth16 proof
+	// from the provided byte slice.
+	ParseProofCompressed(data []byte) (groth16.Proof, error)
+}
+
 // Groth16Params maps supported elliptic curves to their corresponding
 // Groth16 verification parameters.
 //
@@ -56,10 +73,28 @@ type SolidityGroth16ByteParser interface {
 var Groth16Params = map[ecc.ID]Groth16CurveParams{
 	ecc.BN254: {
 		proofSize:             bn254Groth16.BN254Groth16ProofSize,
+		compressedProofSize:   bn254Groth16.BN254Groth16ProofCompressedSize,
 		vkSize:                bn254Groth16.BN254Groth16VerifyVerifyingKeySize,
 		g1Size:                bn254Groth16.BN254Groth16G1Size,
 		singlePublicInputSize: bn254Groth16.BN254Groth16SinglePublicInputSize,
 		baseGas:               bn254Groth16.BN254Groth16VerifyBaseGas,
+		perPublicInputGas:     bn254Groth16.BN254Groth16PerPublicInputGas,
+	},
+	ecc.BLS12_381: {
+		proofSize:             bls12381Groth16.BLS12381Groth16ProofSize,
+		vkSize:                bls12381Groth16.BLS12381Groth16VerifyVerifyingKeySize,
+		g1Size:                bls12381Groth16.BLS12381Groth16G1Size,
+		singlePublicInputSize: bls12381Groth16.BLS12381Groth16SinglePublicInputSize,
+		baseGas:               bls12381Groth16.BLS12381Groth16VerifyBaseGas,
+		perPublicInputGas:     bls12381Groth16.BLS12381Groth16PerPublicInputGas,
+	},
+	ecc.BLS12_377: {
+		proofSize:             bls12377Groth16.BLS12377Groth16ProofSize,
+		vkSize:                bls12377Groth16.BLS12377Groth16VerifyVerifyingKeySize,
+		g1Size:                bls12377Groth16.BLS12377Groth16G1Size,
+		singlePublicInputSize: bls12377Groth16.BLS12377Groth16SinglePublicInputSize,
+		baseGas:               bls12377Groth16.BLS12377Groth16VerifyBaseGas,
+		perPublicInputGas:     bls12377Groth16.BLS12377Groth16PerPublicInputGas,
 	},
 }
 
@@ -68,14 +103,44 @@ var Groth16Params = map[ecc.ID]Groth16CurveParams{
 //
 // Each parser implementation handles curve-specific decoding logic.
 var SolidityProofParsers = map[ecc.ID]SolidityGroth16ByteParser{
-	ecc.BN254: &bn254Groth16.SolidityBN254Parser{},
+	ecc.BN254:     &bn254Groth16.SolidityBN254Parser{},
+	ecc.BLS12_381: &bls12381Groth16.SolidityBLS12381Parser{},
+	ecc.BLS12_377: &bls12377Groth16.SolidityBLS12377Parser{},
+}
+
+// SupportedGroth16Curves returns the identifiers of the curves supported
+// for Groth16 verification, i.e. the keys of Groth16Params, in ascending
+// order.
+func SupportedGroth16Curves() []ecc.ID {
+	return slices.Sorted(maps.Keys(Groth16Params))
+}
+
+// IsGroth16CurveSupported reports whether Groth16 verification is supported
+// for the curve id.
+func IsGroth16CurveSupported(id ecc.ID) bool {
+	_, ok := Groth16Params[id]
+
+	return ok
 }
 
 // Groth16Verify represents a Groth16 verification precompile
 // bound to a specific elliptic curve and input parser.
+//
+// Parsed verifying keys are cached per instance, so repeated
+// verifications against the same circuit skip parsing and precomputation.
+// Verifying keys can also be registered once with RegisterVerifyingKey and
+// then referenced by hash.
 type Groth16Verify struct {
 	curveID ecc.ID
 	parser  SolidityGroth16ByteParser
+	cache   *verifyingKeyCache
+	store   *verifyingKeyStore
+	verbose bool
+
+	// maxPublicInputs bounds the number of public inputs accepted by Run,
+	// RequiredGas and RegisterVerifyingKey. It defaults to
+	// Groth16MaxPublicInputs.
+	maxPublicInputs int
 }
 
 // NewGroth16BN254Verify creates a Groth16Verify instance configured for the
@@ -93,6 +158,92 @@ func NewGroth16BN254Verify() *Groth16Verify {
 	return newGroth16Verify(ecc.BN254, parser)
 }
 
+// NewGroth16BN254VerifyWithLimit creates a Groth16Verify instance
+// configured for the BN254 curve, like NewGroth16BN254Verify, that accepts
+// at most max public inputs instead of Groth16MaxPublicInputs.
+//
+// Inputs carrying more public inputs are rejected by Run with
+// ErrorGroth16VerifyInvalidInputLength and priced at 0 by RequiredGas.
+// A max lower than 1 keeps the Groth16MaxPublicInputs default.
+func NewGroth16BN254VerifyWithLimit(max int) *Groth16Verify {
+	verifier := NewGroth16BN254Verify()
+
+	if max > 0 {
+		verifier.maxPublicInputs = max
+	}
+
+	return verifier
+}
+
+// NewGroth16BN254StrictVerify creates a Groth16Verify instance configured
+// for the BN254 curve, like NewGroth16BN254Verify, that rejects public inputs
+// greater than or equal to the scalar field modulus instead of reducing
+// them.
+//
+// Run returns ErrorGroth16VerifyInvalidPublicWitness for such inputs, so
+// every accepted proof has a single public input encoding, as with the
+// snarkjs Solidity verifiers.
+func NewGroth16BN254StrictVerify() *Groth16Verify {
+	return newGroth16Verify(ecc.BN254, &bn254Groth16.SolidityBN254Parser{StrictPublicInputs: true})
+}
+
+// NewGroth16BLS12381Verify creates a Groth16Verify instance configured for
+// the BLS12-381 curve.
+//
+// It initializes the verifier with the BLS12-381 curve identifier and the
+// corresponding Solidity proof byte parser. Base field elements are encoded
+// using 48 bytes, while public inputs remain 32-byte scalar field elements.
+func NewGroth16BLS12381Verify() *Groth16Verify {
+	parser := SolidityProofParsers[ecc.BLS12_381]
+	return newGroth16Verify(ecc.BLS12_381, parser)
+}
+
+// NewGroth16BLS12377Verify creates a Groth16Verify instance configured for
+// the BLS12-377 curve.
+//
+// BLS12-377 is commonly used for inner proofs of recursive systems whose
+// outer proofs live on BW6-761. The encoding matches BLS12-381: 48-byte base
+// field elements and 32-byte public inputs.
+func NewGroth16BLS12377Verify() *Groth16Verify {
+	parser := SolidityProofParsers[ecc.BLS12_377]
+	return newGroth16Verify(ecc.BLS12_377, parser)
+}
+
+// Verbose returns a Groth16Verify for the same curve whose Run reports
+// the reason of a failed verification, as described in Run.
+//
+// The returned instance shares the verifying key cache and the registered
+// verifying keys of c.
+func (c *Groth16Verify) Verbose() *Groth16Verify {
+	verbose := *c
+	verbose.verbose = true
+
+	return &verbose
+}
+
+// Groth16BatchVerify represents a Groth16 batch verification precompile
+// bound to a specific elliptic curve and input parser.
+type Groth16BatchVerify struct {
+	curveID ecc.ID
+	parser  SolidityGroth16ByteParser
+}
+
+// NewGroth16BN254BatchVerify creates a Groth16BatchVerify instance
+// configured for the BN254 curve.
+//
+// Every record in the batch is expected to follow the BN254 Solidity
+// encoding accepted by NewGroth16BN254Verify.
+func NewGroth16BN254BatchVerify() *Groth16BatchVerify {
+	parser := SolidityProofParsers[ecc.BN254]
+	return newGroth16BatchVerify(ecc.BN254, parser)
+}
+
+// newGroth16BatchVerify returns a Groth16BatchVerify instance configured
+// for the given curve and byte parser.
+func newGroth16BatchVerify(curveID ecc.ID, parser SolidityGroth16ByteParser) *Groth16BatchVerify {
+	return &Groth16BatchVerify{curveID: curveID, parser: parser}
+}
+
 // newGroth16Verify returns a Groth16Verify instance configured for
 // the given curve and byte parser.
 //
@@ -100,5 +251,11 @@ func NewGroth16BN254Verify() *Groth16Verify {
 // Groth16 parameters. Verification should return an error if the
 // curve is unsupported.
 func newGroth16Verify(curveID ecc.ID, parser SolidityGroth16ByteParser) *Groth16Verify {
-	return &Groth16Verify{curveID: curveID, parser: parser}
+	return &Groth16Verify{
+		curveID:         curveID,
+		parser:          parser,
+		cache:           newVerifyingKeyCache(Groth16VerifyingKeyCacheSize),
+		store:           newVerifyingKeyStore(),
+		maxPublicInputs: Groth16MaxPublicInputs,
+	}
 }
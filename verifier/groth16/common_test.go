@@ -0,0 +1,60 @@
+package groth16
+
+import (
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSupportedGroth16Curves(t *testing.T) {
+	curves := SupportedGroth16Curves()
+
+	assert.Equal(t, []ecc.ID{ecc.BN254, ecc.BLS12_377, ecc.BLS12_381}, curves)
+	assert.Contains(t, curves, ecc.BN254)
+	assert.NotContains(t, curves, ecc.BW6_761)
+
+	for _, curveID := range curves {
+		assert.Contains(t, SolidityProofParsers, curveID)
+	}
+}
+
+func TestIsGroth16CurveSupported(t *testing.T) {
+	tests := []struct {
+		name     string
+		curveID  ecc.ID
+		expected bool
+	}{
+		{
+			name:     "BN254",
+			curveID:  ecc.BN254,
+			expected: true,
+		},
+		{
+			name:     "BLS12-381",
+			curveID:  ecc.BLS12_381,
+			expected: true,
+		},
+		{
+			name:     "BLS12-377",
+			curveID:  ecc.BLS12_377,
+			expected: true,
+		},
+		{
+			name:     "BW6-761",
+			curveID:  ecc.BW6_761,
+			expected: false,
+		},
+		{
+			name:     "unknown curve",
+			curveID:  ecc.UNKNOWN,
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, IsGroth16CurveSupported(tt.curveID))
+		})
+	}
+}
@@ -0,0 +1,29 @@
+package groth16
+
+import (
+	"os/exec"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestGroth16DoesNotImportBabyJubJub guards the import graph: the verifier
+// prices its work with curve-specific constants and must build without the
+// BabyJubJub precompiles. It lists the transitive, non-test dependencies of
+// this package with `go list -deps` and is skipped when the go tool is not
+// available.
+func TestGroth16DoesNotImportBabyJubJub(t *testing.T) {
+	goTool, err := exec.LookPath("go")
+
+	if err != nil {
+		t.Skip("go tool not available")
+	}
+
+	output, err := exec.Command(goTool, "list", "-deps", ".").Output()
+	assert.Nil(t, err)
+
+	for _, dependency := range strings.Fields(string(output)) {
+		assert.NotContains(t, dependency, "privacy-precompiles/babyjubjub")
+	}
+}
@@ -0,0 +1,192 @@
+package groth16
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/iden3/go-iden3-crypto/constants"
+	iden3Poseidon "github.com/iden3/go-iden3-crypto/poseidon"
+	"github.com/privacy-ethereum/privacy-precompiles/common"
+	"github.com/privacy-ethereum/privacy-precompiles/poseidon"
+	"github.com/privacy-ethereum/privacy-precompiles/utils"
+)
+
+// Groth16VerifyWithDigest represents a Groth16 verification precompile that
+// also returns a Poseidon commitment to the public inputs of the proof.
+//
+// It saves on-chain commitment schemes a second precompile call to hash the
+// public inputs they have just verified. Only BN254 is supported, since its
+// scalar field is the Poseidon field.
+type Groth16VerifyWithDigest struct {
+	verifier *Groth16Verify
+}
+
+// NewGroth16BN254VerifyWithDigest creates a Groth16VerifyWithDigest
+// instance configured for the BN254 curve.
+//
+// It accepts the same input layouts as the verifier returned by
+// NewGroth16BN254Verify.
+func NewGroth16BN254VerifyWithDigest() *Groth16VerifyWithDigest {
+	return &Groth16VerifyWithDigest{verifier: NewGroth16BN254Verify()}
+}
+
+// Name returns the human-readable identifier of the precompile, following
+// the format:
+//
+//	<CurveName>Groth16VerifyWithDigest
+func (c *Groth16VerifyWithDigest) Name() string {
+	return fmt.Sprintf("%sGroth16VerifyWithDigest", c.verifier.curveID.String())
+}
+
+// RequiredGas returns the gas cost of Groth16Verify.RequiredGas for input,
+// plus the Poseidon gas of hashing its public inputs as described in Run.
+//
+// Inputs priced at 0 by Groth16Verify.RequiredGas are priced at 0 as well.
+func (c *Groth16VerifyWithDigest) RequiredGas(input []byte) uint64 {
+	gas := c.verifier.RequiredGas(input)
+
+	if gas == 0 {
+		return 0
+	}
+
+	_, _, numberOfPublicInputs, _ := c.verifier.InspectInput(input)
+
+	return gas + digestGas(numberOfPublicInputs)
+}
+
+// MaxOutputSize returns the byte length of the result returned by Run,
+// which is Groth16VerifyWithDigestOutputSize regardless of the input.
+func (c *Groth16VerifyWithDigest) MaxOutputSize(input []byte) int {
+	return Groth16VerifyWithDigestOutputSize
+}
+
+// Run verifies a Groth16 proof and returns a Poseidon digest of its public
+// inputs.
+//
+// The input uses the same layouts as Groth16Verify.Run. The public inputs
+// p1, ..., pN are reduced modulo the scalar field and hashed in chunks:
+//
+//	digest = Poseidon(p1, ..., p16)
+//	digest = HashWithState(digest, p17, ..., p31; tag(N, 1))
+//	digest = HashWithState(digest, p32, ..., p46; tag(N, 2))
+//	...
+//
+// i.e. the first poseidon.PoseidonMaxParams inputs are hashed with
+// poseidon.Poseidon and each following chunk of at most
+// poseidon.PoseidonMaxParams - 1 inputs is folded in with a Poseidon
+// permutation whose initial capacity element is
+//
+//	tag(N, i) = "groth16.digest" * 2^64 + N * 2^32 + i
+//
+// for the i-th continuation step. For at most poseidon.PoseidonMaxParams
+// public inputs, the digest equals the Poseidon precompile output, whose
+// permutation width already depends on N. Longer inputs bind N and the step
+// index through the tag, so no digest of one length can be replayed as an
+// intermediate digest, or as the digest, of another length.
+//
+// Return value:
+//   - []byte{1} || digest if the proof is valid.
+//   - []byte{0} followed by Groth16VerifyWithDigestSize zero bytes if the
+//     proof is invalid.
+//   - An error if the input is malformed or unsupported, as for
+//     Groth16Verify.Run.
+func (c *Groth16VerifyWithDigest) Run(input []byte) ([]byte, error) {
+	valid, err := c.verifier.run(context.Background(), input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]byte, Groth16VerifyWithDigestOutputSize)
+
+	if !valid {
+		return result, nil
+	}
+
+	params := Groth16Params[c.verifier.curveID]
+	_, _, publicWitnessBytes, numberOfPublicInputs, _ := c.verifier.splitInput(input, &params)
+
+	digest, err := publicInputsDigest(publicWitnessBytes, numberOfPublicInputs, params.singlePublicInputSize)
+
+	if err != nil {
+		return nil, err
+	}
+
+	result[0] = 1
+	copy(result[1:], digest)
+
+	return result, nil
+}
+
+// publicInputsDigest hashes the numberOfPublicInputs field elements of size
+// bytes held by publicWitnessBytes, as described in Run.
+func publicInputsDigest(publicWitnessBytes []byte, numberOfPublicInputs, size int) ([]byte, error) {
+	words := make([]byte, 0, numberOfPublicInputs*poseidon.PoseidonInputWordSize)
+
+	for index := range numberOfPublicInputs {
+		element, _ := utils.ReadField(publicWitnessBytes, index*size, size)
+		element = new(big.Int).Mod(element, constants.Q)
+
+		words = append(words, element.FillBytes(make([]byte, poseidon.PoseidonInputWordSize))...)
+	}
+
+	chunk := min(len(words), poseidon.PoseidonMaxParams*poseidon.PoseidonInputWordSize)
+	digest, err := (&poseidon.Poseidon{}).Run(words[:chunk])
+
+	if err != nil {
+		return nil, err
+	}
+
+	for step := 1; len(words) > chunk; step++ {
+		words = words[chunk:]
+		chunk = min(len(words), (poseidon.PoseidonMaxParams-1)*poseidon.PoseidonInputWordSize)
+
+		elements := make([]*big.Int, 1+chunk/poseidon.PoseidonInputWordSize)
+		elements[0] = new(big.Int).SetBytes(digest)
+
+		for index := range elements[1:] {
+			elements[index+1], _ = utils.ReadField(words, index*poseidon.PoseidonInputWordSize, poseidon.PoseidonInputWordSize)
+		}
+
+		hash, err := iden3Poseidon.HashWithState(elements, digestStepTag(numberOfPublicInputs, step))
+
+		if err != nil {
+			return nil, err
+		}
+
+		digest = hash.FillBytes(make([]byte, poseidon.PoseidonOutputSize))
+	}
+
+	return digest, nil
+}
+
+// digestStepTag returns the capacity tag of the given continuation step of
+// the digest of numberOfPublicInputs public inputs, as described in Run.
+func digestStepTag(numberOfPublicInputs, step int) *big.Int {
+	tag, _ := new(big.Int).SetString(Groth16VerifyWithDigestDomainHex, 16)
+	tag.Lsh(tag, 64)
+
+	return tag.Add(tag, new(big.Int).SetUint64(uint64(numberOfPublicInputs)<<32|uint64(step)))
+}
+
+// digestGas returns the Poseidon gas of hashing numberOfPublicInputs public
+// inputs as described in Run: every call after the first hashes the prior
+// digest as an extra word.
+func digestGas(numberOfPublicInputs int) uint64 {
+	calls := 1
+
+	if remaining := numberOfPublicInputs - poseidon.PoseidonMaxParams; remaining > 0 {
+		calls += (remaining + poseidon.PoseidonMaxParams - 2) / (poseidon.PoseidonMaxParams - 1)
+	}
+
+	words := numberOfPublicInputs + calls - 1
+
+	return uint64(calls)*poseidon.PoseidonBaseGas + uint64(words)*poseidon.PoseidonPerWordGas
+}
+
+// Ensure Groth16VerifyWithDigest implements the common.Precompile interface.
+var _ common.Precompile = (*Groth16VerifyWithDigest)(nil)
+
+// Ensure Groth16VerifyWithDigest implements the common.OutputSizer interface.
+var _ common.OutputSizer = (*Groth16VerifyWithDigest)(nil)
@@ -0,0 +1,177 @@
+package groth16
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark/backend/groth16"
+	groth16bn254 "github.com/consensys/gnark/backend/groth16/bn254"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/frontend/cs/r1cs"
+	iden3Poseidon "github.com/iden3/go-iden3-crypto/poseidon"
+	"github.com/privacy-ethereum/privacy-precompiles/common"
+	"github.com/privacy-ethereum/privacy-precompiles/poseidon"
+	"github.com/privacy-ethereum/privacy-precompiles/verifier/groth16/bn254"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGroth16VerifyWithDigestName(t *testing.T) {
+	precompile := NewGroth16BN254VerifyWithDigest()
+
+	assert.Equal(t, "bn254Groth16VerifyWithDigest", precompile.Name())
+}
+
+func TestGroth16VerifyWithDigestMaxOutputSize(t *testing.T) {
+	var sizer common.OutputSizer = NewGroth16BN254VerifyWithDigest()
+
+	assert.Equal(t, 33, sizer.MaxOutputSize(nil))
+}
+
+func TestGroth16VerifyWithDigest(t *testing.T) {
+	input := prepareCacheInput(t)
+	publicInputs := input[len(input)-2*bn254.BN254Groth16SinglePublicInputSize:]
+
+	standalone, err := (&poseidon.Poseidon{}).Run(publicInputs)
+	assert.Nil(t, err)
+
+	tampered := append([]byte{}, input...)
+	tampered[len(tampered)-1] ^= 1
+
+	manyInputs := buildDigestInput(t, 20)
+
+	tests := []struct {
+		name          string
+		input         []byte
+		expected      []byte
+		expectedGas   uint64
+		expectedError error
+	}{
+		{
+			name:     "valid proof returns the Poseidon hash of its public inputs",
+			input:    input,
+			expected: append([]byte{1}, standalone...),
+			expectedGas: bn254.BN254Groth16VerifyBaseGas + 2*bn254.BN254Groth16PerPublicInputGas +
+				poseidon.PoseidonBaseGas + 2*poseidon.PoseidonPerWordGas,
+		},
+		{
+			name:     "valid proof with more public inputs than a single Poseidon call",
+			input:    manyInputs,
+			expected: append([]byte{1}, chainedDigest(20)...),
+			expectedGas: bn254.BN254Groth16VerifyBaseGas + 20*bn254.BN254Groth16PerPublicInputGas +
+				2*poseidon.PoseidonBaseGas + 21*poseidon.PoseidonPerWordGas,
+		},
+		{
+			name:     "invalid proof returns zero bytes",
+			input:    tampered,
+			expected: make([]byte, Groth16VerifyWithDigestOutputSize),
+			expectedGas: bn254.BN254Groth16VerifyBaseGas + 2*bn254.BN254Groth16PerPublicInputGas +
+				poseidon.PoseidonBaseGas + 2*poseidon.PoseidonPerWordGas,
+		},
+		{
+			name:          "malformed input",
+			input:         input[:bn254.BN254Groth16ProofSize],
+			expectedError: ErrorGroth16VerifyInvalidInputLength,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			precompile := NewGroth16BN254VerifyWithDigest()
+
+			actual, err := precompile.Run(tt.input)
+			gas := precompile.RequiredGas(tt.input)
+
+			if tt.expectedError != nil {
+				assert.Equal(t, tt.expectedError, err)
+				assert.Equal(t, uint64(0), gas)
+
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, tt.expected, actual)
+			assert.Equal(t, tt.expectedGas, gas)
+		})
+	}
+}
+
+func TestGroth16VerifyWithDigestLengthCollision(t *testing.T) {
+	inputs := make([]byte, 17*poseidon.PoseidonInputWordSize)
+
+	for index := range 17 {
+		inputs[(index+1)*poseidon.PoseidonInputWordSize-1] = byte(index + 1)
+	}
+
+	prefix, err := (&poseidon.Poseidon{}).Run(inputs[:16*poseidon.PoseidonInputWordSize])
+	assert.Nil(t, err)
+
+	folded := append(prefix, inputs[16*poseidon.PoseidonInputWordSize:]...)
+
+	long, err := publicInputsDigest(inputs, 17, poseidon.PoseidonInputWordSize)
+	assert.Nil(t, err)
+
+	short, err := publicInputsDigest(folded, 2, poseidon.PoseidonInputWordSize)
+	assert.Nil(t, err)
+
+	continued, err := (&poseidon.PoseidonContinue{}).Run(folded)
+	assert.Nil(t, err)
+
+	assert.NotEqual(t, short, long)
+	assert.NotEqual(t, continued, long)
+	assert.Equal(t, chainedDigest(17), long)
+
+	assert.NotEqual(t, digestStepTag(17, 1), digestStepTag(18, 1))
+	assert.NotEqual(t, digestStepTag(47, 1), digestStepTag(47, 2))
+}
+
+// buildDigestInput returns a valid Run input for a circuit exposing the
+// public inputs 1, 2, ..., size.
+func buildDigestInput(t *testing.T, size int) []byte {
+	circuit := &bn254.VariablePublicCircuit{Public: make([]frontend.Variable, size)}
+	assignment := &bn254.VariablePublicCircuit{Public: make([]frontend.Variable, size)}
+
+	for index := range assignment.Public {
+		assignment.Public[index] = index + 1
+	}
+
+	ccs, _ := frontend.Compile(ecc.BN254.ScalarField(), r1cs.NewBuilder, circuit)
+	pk, vk, _ := groth16.Setup(ccs)
+	fullWitness, _ := frontend.NewWitness(assignment, ecc.BN254.ScalarField())
+	publicWitness, _ := fullWitness.Public()
+
+	proof, err := groth16.Prove(ccs, pk, fullWitness)
+	assert.Nil(t, err)
+
+	input, err := bn254.BuildVerifyInput(proof.(*groth16bn254.Proof), vk.(*groth16bn254.VerifyingKey), publicWitness)
+	assert.Nil(t, err)
+
+	return input
+}
+
+// chainedDigest returns the digest of the public inputs 1, 2, ..., size
+// computed directly with Poseidon, hashing the first 16 inputs and then
+// each following chunk of at most 15 inputs together with the prior digest
+// under the tag of its step.
+func chainedDigest(size int) []byte {
+	elements := make([]*big.Int, size)
+
+	for index := range elements {
+		elements[index] = big.NewInt(int64(index + 1))
+	}
+
+	chunk := min(len(elements), poseidon.PoseidonMaxParams)
+	digest, _ := iden3Poseidon.Hash(elements[:chunk])
+
+	for step := 1; len(elements) > chunk; step++ {
+		elements = elements[chunk:]
+		chunk = min(len(elements), poseidon.PoseidonMaxParams-1)
+
+		tag, _ := new(big.Int).SetString(Groth16VerifyWithDigestDomainHex, 16)
+		tag.Lsh(tag, 64).Add(tag, big.NewInt(int64(size)<<32|int64(step)))
+
+		digest, _ = iden3Poseidon.HashWithState(append([]*big.Int{digest}, elements[:chunk]...), tag)
+	}
+
+	return digest.FillBytes(make([]byte, Groth16VerifyWithDigestSize))
+}
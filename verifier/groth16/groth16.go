@@ -1,11 +1,17 @@
 package groth16
 
 import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
 	"fmt"
 
 	"github.com/consensys/gnark/backend/groth16"
-	babyjubjubAdd "github.com/privacy-ethereum/privacy-precompiles/babyjubjub/add"
-	babyjubjubMul "github.com/privacy-ethereum/privacy-precompiles/babyjubjub/mul"
+	groth16bls12377 "github.com/consensys/gnark/backend/groth16/bls12-377"
+	groth16bls12381 "github.com/consensys/gnark/backend/groth16/bls12-381"
+	groth16bn254 "github.com/consensys/gnark/backend/groth16/bn254"
+	"github.com/consensys/gnark/backend/witness"
+	"github.com/iden3/go-iden3-crypto/keccak256"
 	"github.com/privacy-ethereum/privacy-precompiles/common"
 	"github.com/privacy-ethereum/privacy-precompiles/utils"
 )
@@ -32,11 +38,14 @@ func (c *Groth16Verify) Name() string {
 //   - A fixed curve-specific base cost.
 //   - An additional per-public-input cost.
 //
-// The per-public-input cost approximates the cost of computing
-// the linear combination of input commitments and is derived from
-// BabyJubJub addition and multiplication gas constants.
+// Both costs are curve-specific: the base cost covers the pairing check
+// and G2 point parsing, and the per-public-input cost prices one G1
+// multiplication and addition of the linear combination of input
+// commitments.
 //
-// If the curve is unsupported, this function returns 0.
+// If the curve is unsupported, or the input is structurally invalid and
+// would be rejected by Run with ErrorGroth16VerifyInvalidInputLength,
+// this function returns 0.
 func (c *Groth16Verify) RequiredGas(input []byte) uint64 {
 	params, ok := Groth16Params[c.curveID]
 
@@ -44,11 +53,24 @@ func (c *Groth16Verify) RequiredGas(input []byte) uint64 {
 		return 0
 	}
 
-	numberOfPublicInputs := c.calculateNumberOfPublicInputs(input, &params)
+	_, _, _, numberOfPublicInputs, err := c.splitInput(input, &params)
 
-	operationsCost := babyjubjubAdd.BabyJubJubCurveAddGas + babyjubjubMul.BabyJubJubCurveMulGas
+	if err != nil {
+		return 0
+	}
+
+	return uint64(params.baseGas) + uint64(params.perPublicInputGas)*uint64(numberOfPublicInputs)
+}
+
+// MaxOutputSize returns the byte length of the result returned by Run:
+// Groth16VerifyOutputSize, or Groth16VerifyVerboseOutputSize for
+// instances returned by Verbose.
+func (c *Groth16Verify) MaxOutputSize(input []byte) int {
+	if c.verbose {
+		return Groth16VerifyVerboseOutputSize
+	}
 
-	return uint64(params.baseGas) + operationsCost*uint64(numberOfPublicInputs)
+	return Groth16VerifyOutputSize
 }
 
 // Run executes Groth16 proof verification for the provided input.
@@ -62,6 +84,46 @@ func (c *Groth16Verify) RequiredGas(input []byte) uint64 {
 //   - VerifyingKey includes fixed elements plus (n+1) G1 IC points.
 //   - PublicInputs contains n serialized field elements.
 //
+// The input must end right after the last public input: trailing bytes
+// that do not form a full IC point and public input are rejected rather
+// than ignored.
+//
+// Proofs and verifying keys with a variable-size extension, such as gnark
+// commitments, use the extended layout instead:
+//
+//	[ Groth16ExtendedInputFlag || ProofLength || Proof || VerifyingKeyLength || VerifyingKey || PublicInputs ]
+//
+// Where both lengths are Groth16ExtendedInputLengthSize-byte big-endian
+// values. Plain inputs can never start with Groth16ExtendedInputFlag,
+// since the first byte of a canonical base field element is always lower.
+//
+// Curves supporting compressed proofs, such as BN254, also accept:
+//
+//	[ Groth16CompressedInputFlag || CompressedProof || VerifyingKey || PublicInputs ]
+//
+// Where CompressedProof is a fixed-size proof without commitments in the
+// curve's compressed point encoding, and the remaining fields follow the
+// plain layout.
+//
+// Verifying keys registered with RegisterVerifyingKey can be referenced by
+// hash instead of being inlined:
+//
+//	[ Groth16VerifyingKeyHashInputFlag || Proof || VerifyingKeyHash || PublicInputs ]
+//
+// Where VerifyingKeyHash is the Groth16VerifyingKeyHashSize-byte keccak256
+// hash of the registered verifying key bytes, Proof uses
+// the plain fixed-size encoding, and the number of public inputs must match
+// the registered key.
+//
+// The number of public inputs can also be stated explicitly instead of being
+// inferred from the input length:
+//
+//	[ Groth16ExplicitCountInputFlag || Count || Proof || VerifyingKey || PublicInputs ]
+//
+// Where Count is a Groth16ExplicitCountSize-byte big-endian value and the
+// remaining fields follow the plain layout. The input must then be exactly
+// as long as the plain layout for Count public inputs.
+//
 // Execution steps:
 //  1. Recover from unexpected panics and convert them to
 //     ErrorPanicGroth16Verify.
@@ -69,8 +131,9 @@ func (c *Groth16Verify) RequiredGas(input []byte) uint64 {
 //  3. Validate total input length and structural alignment.
 //  4. Extract proof, verifying key, and public witness slices.
 //  5. Parse proof, verifying key, and witness using the
-//     curve-specific Solidity parser.
-//  6. Execute groth16.Verify.
+//     curve-specific Solidity parser. Verifying keys already seen by
+//     this instance are served from its cache.
+//  6. Execute groth16.Verify through VerifyTyped.
 //  7. Return 1 if verification succeeds, 0 if it fails.
 //
 // Return value:
@@ -78,33 +141,266 @@ func (c *Groth16Verify) RequiredGas(input []byte) uint64 {
 //   - []byte{0} if the proof is invalid.
 //   - An error if the input is malformed or unsupported.
 //
+// Instances returned by Verbose instead return a 2-byte result
+// [ Valid || Reason ], where Valid is 1 or 0 and Reason is one of the
+// Groth16VerifyReason codes: Groth16VerifyReasonNone for a valid proof,
+// Groth16VerifyReasonProofRejected for a proof failing the pairing check,
+// and the code matching the error otherwise returned for malformed
+// inputs. Unsupported curves and panics are still returned as errors.
+//
 // Strict validation is enforced to prevent malformed calldata,
 // excessive memory usage, or denial-of-service vectors.
-func (c *Groth16Verify) Run(input []byte) (ret []byte, err error) {
+func (c *Groth16Verify) Run(input []byte) ([]byte, error) {
+	return c.RunContext(context.Background(), input)
+}
+
+// RunContext executes Groth16 proof verification like Run, but returns
+// ctx.Err() without verifying the proof if ctx is already done once the
+// input has been parsed, before the pairing check.
+//
+// The input layouts, results and panic recovery are the same as for Run.
+func (c *Groth16Verify) RunContext(ctx context.Context, input []byte) ([]byte, error) {
+	valid, err := c.run(ctx, input)
+
+	if c.verbose {
+		return verboseResult(valid, err)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if !valid {
+		return []byte{0}, nil
+	}
+
+	return []byte{1}, nil
+}
+
+// groth16VerifyReasons maps the Run errors reported by verbose instances
+// to their reason codes.
+var groth16VerifyReasons = map[error]byte{
+	ErrorGroth16VerifyInvalidInputLength:   Groth16VerifyReasonInvalidInputLength,
+	ErrorGroth16VerifyInvalidProof:         Groth16VerifyReasonInvalidProof,
+	ErrorGroth16VerifyInvalidVerifyingKey:  Groth16VerifyReasonInvalidVerifyingKey,
+	ErrorGroth16VerifyUnknownVerifyingKey:  Groth16VerifyReasonUnknownVerifyingKey,
+	ErrorGroth16VerifyInvalidPublicWitness: Groth16VerifyReasonInvalidPublicWitness,
+}
+
+// verboseResult encodes the outcome of run as the verbose Run result.
+func verboseResult(valid bool, err error) ([]byte, error) {
+	if err != nil {
+		reason, ok := groth16VerifyReasons[err]
+
+		if !ok {
+			return nil, err
+		}
+
+		return []byte{0, reason}, nil
+	}
+
+	if !valid {
+		return []byte{0, Groth16VerifyReasonProofRejected}, nil
+	}
+
+	return []byte{1, Groth16VerifyReasonNone}, nil
+}
+
+// run implements RunContext, reporting whether the proof is valid.
+func (c *Groth16Verify) run(ctx context.Context, input []byte) (valid bool, err error) {
 	defer func() {
 		if r := recover(); r != nil {
-			ret = nil
+			valid = false
 			err = ErrorPanicGroth16Verify
 		}
 	}()
 
-	length := len(input)
 	params, ok := Groth16Params[c.curveID]
 
 	if !ok {
-		return nil, ErrorGroth16VerifyUnsupportedCurve
+		return false, ErrorGroth16VerifyUnsupportedCurve
 	}
 
-	minInputSize := params.proofSize + params.vkSize
+	proofBytes, vkBytes, publicWitnessBytes, numberOfPublicInputs, err := c.splitInput(input, &params)
 
-	if length < minInputSize {
-		return nil, ErrorGroth16VerifyInvalidInputLength
+	if err != nil {
+		return false, err
 	}
 
-	numberOfPublicInputs := c.calculateNumberOfPublicInputs(input, &params)
+	proof, err := c.parseProof(proofBytes, isCompressedInput(input))
 
-	if numberOfPublicInputs <= 0 || numberOfPublicInputs > Groth16MaxPublicInputs {
-		return nil, ErrorGroth16VerifyInvalidInputLength
+	if err != nil {
+		return false, ErrorGroth16VerifyInvalidProof
+	}
+
+	vk, err := c.resolveVerifyingKey(input, vkBytes, numberOfPublicInputs)
+
+	if err != nil {
+		return false, err
+	}
+
+	publicWitness, err := c.parser.ParsePublicWitness(publicWitnessBytes, numberOfPublicInputs)
+
+	if err != nil {
+		return false, ErrorGroth16VerifyInvalidPublicWitness
+	}
+
+	if err := ctx.Err(); err != nil {
+		return false, err
+	}
+
+	return c.VerifyTyped(proof, vk, publicWitness)
+}
+
+// VerifyTyped verifies an already decoded Groth16 proof against a verifying
+// key and public witness, without any byte parsing.
+//
+// It is intended for Go callers that already hold gnark objects and want to
+// avoid a serialization round trip through Run. The proof and verifying key
+// must belong to the curve this instance is configured for.
+//
+// Return value:
+//   - true, nil if the proof is valid.
+//   - false, nil if the proof is invalid, including when its number of
+//     commitments does not match the verifying key.
+//   - ErrorGroth16VerifyUnsupportedCurve if the configured curve is not
+//     supported, or the proof or verifying key belong to another curve.
+//   - ErrorPanicGroth16Verify if verification panics, e.g. on nil arguments.
+func (c *Groth16Verify) VerifyTyped(
+	proof groth16.Proof,
+	vk groth16.VerifyingKey,
+	pub witness.Witness,
+) (ok bool, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			ok = false
+			err = ErrorPanicGroth16Verify
+		}
+	}()
+
+	if _, supported := Groth16Params[c.curveID]; !supported {
+		return false, ErrorGroth16VerifyUnsupportedCurve
+	}
+
+	if proof.CurveID() != c.curveID || vk.CurveID() != c.curveID {
+		return false, ErrorGroth16VerifyUnsupportedCurve
+	}
+
+	if !commitmentsMatch(proof, vk) {
+		return false, nil
+	}
+
+	if err := groth16.Verify(proof, vk, pub); err != nil {
+		return false, nil
+	}
+
+	return true, nil
+}
+
+// commitmentsMatch reports whether proof carries exactly one Pedersen
+// commitment per commitment key of vk. gnark indexes the proof commitments
+// by the verifying key ones, so a mismatch must be rejected beforehand.
+func commitmentsMatch(proof groth16.Proof, vk groth16.VerifyingKey) bool {
+	switch p := proof.(type) {
+	case *groth16bn254.Proof:
+		v, ok := vk.(*groth16bn254.VerifyingKey)
+
+		return ok && len(p.Commitments) == len(v.CommitmentKeys)
+	case *groth16bls12381.Proof:
+		v, ok := vk.(*groth16bls12381.VerifyingKey)
+
+		return ok && len(p.Commitments) == len(v.CommitmentKeys)
+	case *groth16bls12377.Proof:
+		v, ok := vk.(*groth16bls12377.VerifyingKey)
+
+		return ok && len(p.Commitments) == len(v.CommitmentKeys)
+	}
+
+	return true
+}
+
+// InspectInput reports how Run would split input, without parsing or
+// verifying anything.
+//
+// It returns the byte length of the proof and of the verifying key slices,
+// and the number of public inputs derived from the input length (or from
+// the public input bytes for the extended layout). Callers can use it
+// off-chain to check that their encoding carries the intended number of
+// public inputs.
+//
+// The same errors as the length validation of Run are returned:
+// ErrorGroth16VerifyUnsupportedCurve and ErrorGroth16VerifyInvalidInputLength.
+func (c *Groth16Verify) InspectInput(input []byte) (proofLen, vkLen, numPublicInputs int, err error) {
+	params, ok := Groth16Params[c.curveID]
+
+	if !ok {
+		return 0, 0, 0, ErrorGroth16VerifyUnsupportedCurve
+	}
+
+	proofBytes, vkBytes, _, numPublicInputs, err := c.splitInput(input, &params)
+
+	if err != nil {
+		return 0, 0, 0, err
+	}
+
+	return len(proofBytes), len(vkBytes), numPublicInputs, nil
+}
+
+// splitInput validates the input layout and splits it into the proof,
+// verifying key and public witness slices, returning the number of
+// public inputs as well.
+//
+// The plain, extended, compressed, verifying key hash and explicit count
+// layouts described in Run are supported.
+// ErrorGroth16VerifyInvalidInputLength is returned if the input is too
+// short, carries a number of public inputs outside of
+// [1, c.maxPublicInputs], uses the compressed layout on a curve without
+// compressed proofs, or states a count that does not match its length.
+func (c *Groth16Verify) splitInput(
+	input []byte,
+	params *Groth16CurveParams,
+) ([]byte, []byte, []byte, int, error) {
+	if isExtendedInput(input) {
+		return c.splitExtendedInput(input, params)
+	}
+
+	if isVerifyingKeyHashInput(input) {
+		return c.splitVerifyingKeyHashInput(input, params)
+	}
+
+	if isExplicitCountInput(input) {
+		return c.splitExplicitCountInput(input, params)
+	}
+
+	if isCompressedInput(input) {
+		if params.compressedProofSize == 0 {
+			return nil, nil, nil, 0, ErrorGroth16VerifyInvalidInputLength
+		}
+
+		// The compressed layout is the plain layout behind the flag byte,
+		// with a smaller proof.
+		compressedParams := *params
+		compressedParams.proofSize = params.compressedProofSize
+
+		return c.splitPlainInput(input[1:], &compressedParams)
+	}
+
+	return c.splitPlainInput(input, params)
+}
+
+// splitPlainInput splits an input using the plain layout described in Run.
+func (c *Groth16Verify) splitPlainInput(
+	input []byte,
+	params *Groth16CurveParams,
+) ([]byte, []byte, []byte, int, error) {
+	if len(input) < params.proofSize+params.vkSize {
+		return nil, nil, nil, 0, ErrorGroth16VerifyInvalidInputLength
+	}
+
+	numberOfPublicInputs := c.calculateNumberOfPublicInputs(input, params)
+
+	if numberOfPublicInputs <= 0 || numberOfPublicInputs > c.maxPublicInputs {
+		return nil, nil, nil, 0, ErrorGroth16VerifyInvalidInputLength
 	}
 
 	vkTotalSize :=
@@ -112,42 +408,297 @@ func (c *Groth16Verify) Run(input []byte) (ret []byte, err error) {
 			params.g1Size*(numberOfPublicInputs+1)
 	proofAndVkSize := params.proofSize + vkTotalSize
 
+	// The count is derived with an integer division, so reject any
+	// leftover bytes that do not form a full IC point and public input.
+	if len(input) != proofAndVkSize+numberOfPublicInputs*params.singlePublicInputSize {
+		return nil, nil, nil, 0, ErrorGroth16VerifyInvalidInputLength
+	}
+
 	proofBytes, _ := utils.SafeSlice(input, 0, params.proofSize)
 	vkBytes, _ := utils.SafeSlice(input, params.proofSize, proofAndVkSize)
-	publicWitnessBytes, _ := utils.SafeSlice(input, proofAndVkSize, proofAndVkSize+numberOfPublicInputs*params.singlePublicInputSize)
+	publicWitnessBytes := input[proofAndVkSize:]
 
-	proof, err := c.parser.ParseProof(proofBytes)
+	return proofBytes, vkBytes, publicWitnessBytes, numberOfPublicInputs, nil
+}
 
-	if err != nil {
+// isExtendedInput reports whether input uses the extended layout.
+func isExtendedInput(input []byte) bool {
+	return len(input) > 0 && input[0] == Groth16ExtendedInputFlag
+}
+
+// isCompressedInput reports whether input uses the compressed layout.
+func isCompressedInput(input []byte) bool {
+	return len(input) > 0 && input[0] == Groth16CompressedInputFlag
+}
+
+// isVerifyingKeyHashInput reports whether input references a registered
+// verifying key by hash.
+func isVerifyingKeyHashInput(input []byte) bool {
+	return len(input) > 0 && input[0] == Groth16VerifyingKeyHashInputFlag
+}
+
+// isExplicitCountInput reports whether input states its number of public
+// inputs explicitly.
+func isExplicitCountInput(input []byte) bool {
+	return len(input) > 0 && input[0] == Groth16ExplicitCountInputFlag
+}
+
+// splitExplicitCountInput splits an input using the explicit count layout
+// described in Run. The plain layout following the count must hold exactly
+// that many public inputs.
+func (c *Groth16Verify) splitExplicitCountInput(
+	input []byte,
+	params *Groth16CurveParams,
+) ([]byte, []byte, []byte, int, error) {
+	prefix, ok := utils.SafeSlice(input, 1, 1+Groth16ExplicitCountSize)
+
+	if !ok {
+		return nil, nil, nil, 0, ErrorGroth16VerifyInvalidInputLength
+	}
+
+	count := binary.BigEndian.Uint32(prefix)
+
+	if count == 0 || count > uint32(c.maxPublicInputs) {
+		return nil, nil, nil, 0, ErrorGroth16VerifyInvalidInputLength
+	}
+
+	numberOfPublicInputs := int(count)
+	plain := input[1+Groth16ExplicitCountSize:]
+
+	if len(plain) != params.proofSize+params.vkSize+
+		params.g1Size*(numberOfPublicInputs+1)+
+		params.singlePublicInputSize*numberOfPublicInputs {
+		return nil, nil, nil, 0, ErrorGroth16VerifyInvalidInputLength
+	}
+
+	return c.splitPlainInput(plain, params)
+}
+
+// splitVerifyingKeyHashInput splits an input using the verifying key hash
+// layout described in Run. The returned verifying key slice holds the hash.
+func (c *Groth16Verify) splitVerifyingKeyHashInput(
+	input []byte,
+	params *Groth16CurveParams,
+) ([]byte, []byte, []byte, int, error) {
+	proofAndHashSize := 1 + params.proofSize + Groth16VerifyingKeyHashSize
+
+	if len(input) < proofAndHashSize {
+		return nil, nil, nil, 0, ErrorGroth16VerifyInvalidInputLength
+	}
+
+	publicWitnessBytes := input[proofAndHashSize:]
+	numberOfPublicInputs := len(publicWitnessBytes) / params.singlePublicInputSize
+
+	if len(publicWitnessBytes)%params.singlePublicInputSize != 0 ||
+		numberOfPublicInputs <= 0 ||
+		numberOfPublicInputs > c.maxPublicInputs {
+		return nil, nil, nil, 0, ErrorGroth16VerifyInvalidInputLength
+	}
+
+	return input[1 : 1+params.proofSize], input[1+params.proofSize : proofAndHashSize], publicWitnessBytes, numberOfPublicInputs, nil
+}
+
+// splitExtendedInput splits an input using the extended layout described
+// in Run. The proof and verifying key must be at least as large as their
+// plain encodings, and the remaining bytes must hold a whole number of
+// public inputs.
+func (c *Groth16Verify) splitExtendedInput(
+	input []byte,
+	params *Groth16CurveParams,
+) ([]byte, []byte, []byte, int, error) {
+	offset := 1
+
+	proofBytes, offset, ok := readLengthPrefixed(input, offset)
+
+	if !ok || len(proofBytes) < params.proofSize {
+		return nil, nil, nil, 0, ErrorGroth16VerifyInvalidInputLength
+	}
+
+	vkBytes, offset, ok := readLengthPrefixed(input, offset)
+
+	if !ok {
+		return nil, nil, nil, 0, ErrorGroth16VerifyInvalidInputLength
+	}
+
+	publicWitnessBytes := input[offset:]
+	numberOfPublicInputs := len(publicWitnessBytes) / params.singlePublicInputSize
+
+	if len(publicWitnessBytes)%params.singlePublicInputSize != 0 ||
+		numberOfPublicInputs <= 0 ||
+		numberOfPublicInputs > c.maxPublicInputs ||
+		len(vkBytes) < params.vkSize+params.g1Size*(numberOfPublicInputs+1) {
+		return nil, nil, nil, 0, ErrorGroth16VerifyInvalidInputLength
+	}
+
+	return proofBytes, vkBytes, publicWitnessBytes, numberOfPublicInputs, nil
+}
+
+// readLengthPrefixed reads a Groth16ExtendedInputLengthSize-byte big-endian
+// length at offset followed by that many bytes, returning the bytes and the
+// new offset.
+func readLengthPrefixed(input []byte, offset int) ([]byte, int, bool) {
+	prefix, ok := utils.SafeSlice(input, offset, offset+Groth16ExtendedInputLengthSize)
+
+	if !ok {
+		return nil, offset, false
+	}
+
+	offset += Groth16ExtendedInputLengthSize
+	length := int(binary.BigEndian.Uint32(prefix))
+	data, ok := utils.SafeSlice(input, offset, offset+length)
+
+	if !ok {
+		return nil, offset, false
+	}
+
+	return data, offset + length, true
+}
+
+// parseProof parses proofBytes with the curve parser, using its compressed
+// encoding if compressed is set.
+func (c *Groth16Verify) parseProof(proofBytes []byte, compressed bool) (groth16.Proof, error) {
+	if !compressed {
+		return c.parser.ParseProof(proofBytes)
+	}
+
+	parser, ok := c.parser.(CompressedProofParser)
+
+	if !ok {
 		return nil, ErrorGroth16VerifyInvalidProof
 	}
 
-	vk, err := c.parser.ParseVerifyingKey(vkBytes, numberOfPublicInputs)
+	return parser.ParseProofCompressed(proofBytes)
+}
 
-	if err != nil {
-		return nil, ErrorGroth16VerifyInvalidVerifyingKey
+// RegisterVerifyingKey parses vkBytes and stores the resulting verifying
+// key under its keccak256 hash, so that later Run inputs using the
+// Groth16VerifyingKeyHashInputFlag layout can reference it. The hash is
+// returned to the caller and matches keccak256(vk) computed on-chain, so a
+// contract storing that hash can use it as the lookup key directly.
+//
+// vkBytes uses the plain verifying key encoding, optionally followed by
+// the curve's commitment extension; the number of public inputs is read
+// from the parsed key. Registering the same bytes again is a no-op.
+// Returns ErrorGroth16VerifyUnsupportedCurve for an unsupported curve and
+// ErrorGroth16VerifyInvalidVerifyingKey if vkBytes cannot be parsed.
+func (c *Groth16Verify) RegisterVerifyingKey(vkBytes []byte) ([Groth16VerifyingKeyHashSize]byte, error) {
+	hash := [Groth16VerifyingKeyHashSize]byte(keccak256.Hash(vkBytes))
+
+	return hash, c.register(hash, vkBytes)
+}
+
+// register parses vkBytes and stores the resulting verifying key under id,
+// as described in RegisterVerifyingKey. Returns
+// ErrorGroth16VerifyVerifyingKeyAlreadyRegistered if id already holds a
+// key parsed from different bytes.
+func (c *Groth16Verify) register(id [Groth16VerifyingKeyHashSize]byte, vkBytes []byte) error {
+	params, ok := Groth16Params[c.curveID]
+
+	if !ok {
+		return ErrorGroth16VerifyUnsupportedCurve
 	}
 
-	publicWitness, err := c.parser.ParsePublicWitness(publicWitnessBytes, numberOfPublicInputs)
+	// The commitment extension has a variable size, so the number of public
+	// inputs cannot be derived from the length alone: try every count whose
+	// IC points fit and keep the one the parsed key agrees with.
+	for count := 1; count <= c.maxPublicInputs && params.vkSize+params.g1Size*(count+1) <= len(vkBytes); count++ {
+		vk, err := c.parser.ParseVerifyingKey(vkBytes, count)
+
+		if err != nil || publicInputCount(vk) != count {
+			continue
+		}
+
+		return c.store.add(id, verifyingKeyStoreEntry{
+			vk:                   vk,
+			numberOfPublicInputs: count,
+			digest:               sha256.Sum256(vkBytes),
+		})
+	}
+
+	return ErrorGroth16VerifyInvalidVerifyingKey
+}
+
+// publicInputCount returns the number of public inputs expected by vk,
+// excluding the commitment wires gnark appends to its IC points.
+func publicInputCount(vk groth16.VerifyingKey) int {
+	switch v := vk.(type) {
+	case *groth16bn254.VerifyingKey:
+		return len(v.G1.K) - 1 - len(v.CommitmentKeys)
+	case *groth16bls12381.VerifyingKey:
+		return len(v.G1.K) - 1 - len(v.CommitmentKeys)
+	case *groth16bls12377.VerifyingKey:
+		return len(v.G1.K) - 1 - len(v.CommitmentKeys)
+	}
+
+	return vk.NbPublicWitness()
+}
+
+// resolveVerifyingKey returns the verifying key for a Run input: the key
+// registered under the hash held by vkBytes for the verifying key hash
+// layout, and the parsed vkBytes otherwise.
+func (c *Groth16Verify) resolveVerifyingKey(input, vkBytes []byte, numberOfPublicInputs int) (groth16.VerifyingKey, error) {
+	if !isVerifyingKeyHashInput(input) {
+		vk, err := c.parseVerifyingKey(vkBytes, numberOfPublicInputs)
+
+		if err != nil {
+			return nil, ErrorGroth16VerifyInvalidVerifyingKey
+		}
+
+		return vk, nil
+	}
+
+	entry, ok := c.store.get([Groth16VerifyingKeyHashSize]byte(vkBytes))
+
+	if !ok {
+		return nil, ErrorGroth16VerifyUnknownVerifyingKey
+	}
+
+	if entry.numberOfPublicInputs != numberOfPublicInputs {
+		return nil, ErrorGroth16VerifyInvalidInputLength
+	}
+
+	return entry.vk, nil
+}
+
+// parseVerifyingKey returns the parsed verifying key for vkBytes, using the
+// instance cache keyed by the SHA-256 hash of the bytes. On a miss, the key
+// is parsed and precomputed by the curve parser and then cached.
+func (c *Groth16Verify) parseVerifyingKey(vkBytes []byte, numberOfPublicInputs int) (groth16.VerifyingKey, error) {
+	key := sha256.Sum256(vkBytes)
+
+	if vk, ok := c.cache.get(key); ok {
+		return vk, nil
+	}
+
+	vk, err := c.parser.ParseVerifyingKey(vkBytes, numberOfPublicInputs)
 
 	if err != nil {
-		return nil, ErrorGroth16VerifyInvalidPublicWitness
+		return nil, err
 	}
 
-	if err := groth16.Verify(proof, vk, publicWitness); err != nil {
-		return []byte{0}, nil
+	if vk != nil {
+		c.cache.add(key, vk)
 	}
 
-	return []byte{1}, nil
+	return vk, nil
 }
 
 // calculateNumberOfPublicInputs returns the number of public inputs
-// encoded in the serialized Groth16 verification payload. No validation is performed.
+// encoded in the serialized Groth16 verification payload. No validation is performed,
+// but inputs shorter than the fixed proof and verifying key size yield 0 rather
+// than a negative count.
 func (c *Groth16Verify) calculateNumberOfPublicInputs(input []byte, params *Groth16CurveParams) int {
-	length := len(input)
+	remaining := len(input) - params.proofSize - params.vkSize - params.g1Size
 
-	return (length - params.proofSize - params.vkSize - params.g1Size) / (params.g1Size + params.singlePublicInputSize)
+	if remaining <= 0 {
+		return 0
+	}
+
+	return remaining / (params.g1Size + params.singlePublicInputSize)
 }
 
 // Ensure Groth16Verify implements the common.Precompile interface.
 var _ common.Precompile = (*Groth16Verify)(nil)
+
+// Ensure Groth16Verify implements the common.OutputSizer interface.
+var _ common.OutputSizer = (*Groth16Verify)(nil)
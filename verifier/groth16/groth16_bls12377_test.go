@@ -0,0 +1,145 @@
+package groth16
+
+import (
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark/backend/groth16"
+	groth16bls12377 "github.com/consensys/gnark/backend/groth16/bls12-377"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/frontend/cs/r1cs"
+	"github.com/privacy-ethereum/privacy-precompiles/verifier/groth16/bls12377"
+	"github.com/privacy-ethereum/privacy-precompiles/verifier/groth16/bn254"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGroth16BLS12377Name(t *testing.T) {
+	precompile := NewGroth16BLS12377Verify()
+
+	expected := "bls12_377Groth16Verify"
+	actual := precompile.Name()
+
+	assert.Equal(t, expected, actual)
+}
+
+func TestGroth16BLS12377(t *testing.T) {
+	prepareInput := func(circuit, assignment frontend.Circuit) ([]byte, []byte, []byte) {
+		ccs, _ := frontend.Compile(ecc.BLS12_377.ScalarField(), r1cs.NewBuilder, circuit)
+		pk, vk, _ := groth16.Setup(ccs)
+		witness, _ := frontend.NewWitness(assignment, ecc.BLS12_377.ScalarField())
+		witnessPublic, _ := witness.Public()
+
+		proof, err := groth16.Prove(ccs, pk, witness)
+		assert.Nil(t, err)
+
+		err = groth16.Verify(proof, vk, witnessPublic)
+		assert.Nil(t, err)
+
+		proofBytes := bls12377.SerializeProof(proof.(*groth16bls12377.Proof))
+		vkBytes := bls12377.SerializeVerifyingKey(vk.(*groth16bls12377.VerifyingKey))
+		witnessBytes, _ := witnessPublic.MarshalBinary()
+		publicInputs, _ := bn254.StripWitnessHeader(witnessBytes)
+
+		return proofBytes, vkBytes, publicInputs
+	}
+
+	tests := []struct {
+		name          string
+		input         []byte
+		expected      []byte
+		expectedGas   uint64
+		expectedError error
+	}{
+		{
+			name: "valid groth16 bls12-377 proof (1 public input)",
+			input: func() []byte {
+				proofBytes, vkBytes, witnessBytes := prepareInput(&onePublicInputCircuit{}, &onePublicInputCircuit{X: 1})
+
+				return append(append(proofBytes, vkBytes...), witnessBytes...)
+			}(),
+			expected:    []byte{1},
+			expectedGas: 272375,
+		},
+		{
+			name: "valid groth16 bls12-377 proof (2 public inputs)",
+			input: func() []byte {
+				proofBytes, vkBytes, witnessBytes := prepareInput(&twoPublicInputCircuit{}, &twoPublicInputCircuit{X: 1, Y: 2})
+
+				return append(append(proofBytes, vkBytes...), witnessBytes...)
+			}(),
+			expected:    []byte{1},
+			expectedGas: 284750,
+		},
+		{
+			name: "invalid groth16 bls12-377 proof",
+			input: func() []byte {
+				proofBytes, vkBytes, witnessBytes := prepareInput(&onePublicInputCircuit{}, &onePublicInputCircuit{X: 1})
+				witnessBytes[len(witnessBytes)-1] ^= 1
+
+				return append(append(proofBytes, vkBytes...), witnessBytes...)
+			}(),
+			expected:    []byte{0},
+			expectedGas: 272375,
+		},
+		{
+			name:          "not enough min length",
+			input:         make([]byte, bls12377.BLS12377Groth16ProofSize+bls12377.BLS12377Groth16VerifyVerifyingKeySize-1),
+			expectedError: ErrorGroth16VerifyInvalidInputLength,
+		},
+		{
+			name:          "zero public inputs",
+			input:         make([]byte, bls12377.BLS12377Groth16ProofSize+bls12377.BLS12377Groth16VerifyVerifyingKeySize),
+			expectedError: ErrorGroth16VerifyInvalidInputLength,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			precompile := NewGroth16BLS12377Verify()
+
+			actual, err := precompile.Run(tt.input)
+			gas := precompile.RequiredGas(tt.input)
+
+			if tt.expectedError != nil {
+				assert.NotNil(t, err)
+				assert.Equal(t, tt.expectedError, err)
+
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, tt.expected, actual)
+			assert.Equal(t, tt.expectedGas, gas)
+		})
+	}
+}
+
+func TestGroth16BLS12377BytesOnBN254(t *testing.T) {
+	assignment := &onePublicInputCircuit{X: 1}
+	ccs, _ := frontend.Compile(ecc.BLS12_377.ScalarField(), r1cs.NewBuilder, &onePublicInputCircuit{})
+	pk, vk, _ := groth16.Setup(ccs)
+	witness, _ := frontend.NewWitness(assignment, ecc.BLS12_377.ScalarField())
+	witnessPublic, _ := witness.Public()
+
+	proof, err := groth16.Prove(ccs, pk, witness)
+	assert.Nil(t, err)
+
+	proofBytes := bls12377.SerializeProof(proof.(*groth16bls12377.Proof))
+	vkBytes := bls12377.SerializeVerifyingKey(vk.(*groth16bls12377.VerifyingKey))
+	witnessBytes, _ := witnessPublic.MarshalBinary()
+	publicInputs, _ := bn254.StripWitnessHeader(witnessBytes)
+
+	input := append(append(proofBytes, vkBytes...), publicInputs...)
+
+	result, err := NewGroth16BLS12377Verify().Run(input)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []byte{1}, result)
+
+	// The same bytes must never verify on BN254; the verifier either rejects
+	// the malformed points or reports an invalid proof, without panicking.
+	result, err = NewGroth16BN254Verify().Run(input)
+
+	assert.NotEqual(t, ErrorPanicGroth16Verify, err)
+	assert.NotEqual(t, []byte{1}, result)
+}
@@ -0,0 +1,115 @@
+package groth16
+
+import (
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark/backend/groth16"
+	groth16bls12381 "github.com/consensys/gnark/backend/groth16/bls12-381"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/frontend/cs/r1cs"
+	"github.com/privacy-ethereum/privacy-precompiles/verifier/groth16/bls12381"
+	"github.com/privacy-ethereum/privacy-precompiles/verifier/groth16/bn254"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGroth16BLS12381Name(t *testing.T) {
+	precompile := NewGroth16BLS12381Verify()
+
+	expected := "bls12_381Groth16Verify"
+	actual := precompile.Name()
+
+	assert.Equal(t, expected, actual)
+}
+
+func TestGroth16BLS12381(t *testing.T) {
+	prepareInput := func(circuit, assignment frontend.Circuit) ([]byte, []byte, []byte) {
+		ccs, _ := frontend.Compile(ecc.BLS12_381.ScalarField(), r1cs.NewBuilder, circuit)
+		pk, vk, _ := groth16.Setup(ccs)
+		witness, _ := frontend.NewWitness(assignment, ecc.BLS12_381.ScalarField())
+		witnessPublic, _ := witness.Public()
+
+		proof, err := groth16.Prove(ccs, pk, witness)
+		assert.Nil(t, err)
+
+		err = groth16.Verify(proof, vk, witnessPublic)
+		assert.Nil(t, err)
+
+		proofBytes := bls12381.SerializeProof(proof.(*groth16bls12381.Proof))
+		vkBytes := bls12381.SerializeVerifyingKey(vk.(*groth16bls12381.VerifyingKey))
+		witnessBytes, _ := witnessPublic.MarshalBinary()
+		publicInputs, _ := bn254.StripWitnessHeader(witnessBytes)
+
+		return proofBytes, vkBytes, publicInputs
+	}
+
+	tests := []struct {
+		name          string
+		input         []byte
+		expected      []byte
+		expectedGas   uint64
+		expectedError error
+	}{
+		{
+			name: "valid groth16 bls12-381 proof (1 public input)",
+			input: func() []byte {
+				proofBytes, vkBytes, witnessBytes := prepareInput(&onePublicInputCircuit{}, &onePublicInputCircuit{X: 1})
+
+				return append(append(proofBytes, vkBytes...), witnessBytes...)
+			}(),
+			expected:    []byte{1},
+			expectedGas: 272375,
+		},
+		{
+			name: "valid groth16 bls12-381 proof (2 public inputs)",
+			input: func() []byte {
+				proofBytes, vkBytes, witnessBytes := prepareInput(&twoPublicInputCircuit{}, &twoPublicInputCircuit{X: 1, Y: 2})
+
+				return append(append(proofBytes, vkBytes...), witnessBytes...)
+			}(),
+			expected:    []byte{1},
+			expectedGas: 284750,
+		},
+		{
+			name: "invalid groth16 bls12-381 proof",
+			input: func() []byte {
+				proofBytes, vkBytes, witnessBytes := prepareInput(&onePublicInputCircuit{}, &onePublicInputCircuit{X: 1})
+				witnessBytes[len(witnessBytes)-1] ^= 1
+
+				return append(append(proofBytes, vkBytes...), witnessBytes...)
+			}(),
+			expected:    []byte{0},
+			expectedGas: 272375,
+		},
+		{
+			name:          "not enough min length",
+			input:         make([]byte, bls12381.BLS12381Groth16ProofSize+bls12381.BLS12381Groth16VerifyVerifyingKeySize-1),
+			expectedError: ErrorGroth16VerifyInvalidInputLength,
+		},
+		{
+			name:          "zero public inputs",
+			input:         make([]byte, bls12381.BLS12381Groth16ProofSize+bls12381.BLS12381Groth16VerifyVerifyingKeySize),
+			expectedError: ErrorGroth16VerifyInvalidInputLength,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			precompile := NewGroth16BLS12381Verify()
+
+			actual, err := precompile.Run(tt.input)
+			gas := precompile.RequiredGas(tt.input)
+
+			if tt.expectedError != nil {
+				assert.NotNil(t, err)
+				assert.Equal(t, tt.expectedError, err)
+
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, tt.expected, actual)
+			assert.Equal(t, tt.expectedGas, gas)
+		})
+	}
+}
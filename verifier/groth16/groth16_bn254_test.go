@@ -2,6 +2,11 @@ package groth16
 
 import (
 	"bytes"
+	"context"
+	"encoding/binary"
+	"math"
+	"math/big"
+	"slices"
 	"testing"
 
 	"github.com/consensys/gnark-crypto/ecc"
@@ -13,6 +18,8 @@ import (
 	"github.com/leanovate/gopter"
 	"github.com/leanovate/gopter/gen"
 	"github.com/leanovate/gopter/prop"
+	"github.com/privacy-ethereum/privacy-precompiles/common"
+	"github.com/privacy-ethereum/privacy-precompiles/internal/commontest"
 	"github.com/privacy-ethereum/privacy-precompiles/verifier/groth16/bn254"
 	"github.com/stretchr/testify/assert"
 )
@@ -110,7 +117,7 @@ func TestGroth16Name(t *testing.T) {
 
 func TestGroth16UnsupportedCurve(t *testing.T) {
 	parser := SolidityProofParsers[ecc.BN254]
-	precompile := newGroth16Verify(ecc.BLS12_377, parser)
+	precompile := newGroth16Verify(ecc.BW6_761, parser)
 
 	result, err := precompile.Run([]byte{})
 	gas := precompile.RequiredGas([]byte{})
@@ -186,11 +193,12 @@ func TestGroth16(t *testing.T) {
 				proofBytes := bn254.SerializeProof(proof.(*groth16bn254.Proof))
 				vkBytes := bn254.SerializeVerifyingKey(vk.(*groth16bn254.VerifyingKey))
 				witnessBytes, _ := witnessPublic.MarshalBinary()
+				publicInputs, _ := bn254.StripWitnessHeader(witnessBytes)
 
-				return append(append(proofBytes, vkBytes...), witnessBytes[12:]...)
+				return append(append(proofBytes, vkBytes...), publicInputs...)
 			}(),
 			expected:    []byte{1},
-			expectedGas: 246700,
+			expectedGas: 226150,
 		},
 		{
 			name: "valid groth16 bn254 proof (2 public inputs)",
@@ -210,11 +218,12 @@ func TestGroth16(t *testing.T) {
 				proofBytes := bn254.SerializeProof(proof.(*groth16bn254.Proof))
 				vkBytes := bn254.SerializeVerifyingKey(vk.(*groth16bn254.VerifyingKey))
 				witnessBytes, _ := witnessPublic.MarshalBinary()
+				publicInputs, _ := bn254.StripWitnessHeader(witnessBytes)
 
-				return append(append(proofBytes, vkBytes...), witnessBytes[12:]...)
+				return append(append(proofBytes, vkBytes...), publicInputs...)
 			}(),
 			expected:    []byte{1},
-			expectedGas: 273400,
+			expectedGas: 232300,
 		},
 		{
 			name: "invalid groth16 bn254 proof",
@@ -232,16 +241,61 @@ func TestGroth16(t *testing.T) {
 				assert.Nil(t, err)
 
 				proofBytes := bn254.SerializeProof(proof.(*groth16bn254.Proof))
-				proofBytes[len(proofBytes)-1] ^= 1
 				vkBytes := bn254.SerializeVerifyingKey(vk.(*groth16bn254.VerifyingKey))
-				vkBytes[len(vkBytes)-1] ^= 1
 				witnessBytes, _ := witnessPublic.MarshalBinary()
+				publicInputs, _ := bn254.StripWitnessHeader(witnessBytes)
 				witnessBytes[len(witnessBytes)-1] ^= 1
 
-				return append(append(proofBytes, vkBytes...), witnessBytes[12:]...)
+				return append(append(proofBytes, vkBytes...), publicInputs...)
 			}(),
 			expected:    []byte{0},
-			expectedGas: 246700,
+			expectedGas: 226150,
+		},
+		{
+			name: "off-curve groth16 bn254 proof point",
+			input: func() []byte {
+				assignment := &onePublicInputCircuit{X: 1}
+				ccs, _ := frontend.Compile(ecc.BN254.ScalarField(), r1cs.NewBuilder, &onePublicInputCircuit{})
+				pk, vk, _ := groth16.Setup(ccs)
+				witness, _ := frontend.NewWitness(assignment, ecc.BN254.ScalarField())
+				witnessPublic, _ := witness.Public()
+
+				proof, err := groth16.Prove(ccs, pk, witness)
+				assert.Nil(t, err)
+
+				proofBytes := bn254.SerializeProof(proof.(*groth16bn254.Proof))
+				proofBytes[len(proofBytes)-1] ^= 1
+				vkBytes := bn254.SerializeVerifyingKey(vk.(*groth16bn254.VerifyingKey))
+				witnessBytes, _ := witnessPublic.MarshalBinary()
+				publicInputs, _ := bn254.StripWitnessHeader(witnessBytes)
+
+				return append(append(proofBytes, vkBytes...), publicInputs...)
+			}(),
+			expectedGas:   226150,
+			expectedError: ErrorGroth16VerifyInvalidProof,
+		},
+		{
+			name: "off-curve groth16 bn254 verifying key point",
+			input: func() []byte {
+				assignment := &onePublicInputCircuit{X: 1}
+				ccs, _ := frontend.Compile(ecc.BN254.ScalarField(), r1cs.NewBuilder, &onePublicInputCircuit{})
+				pk, vk, _ := groth16.Setup(ccs)
+				witness, _ := frontend.NewWitness(assignment, ecc.BN254.ScalarField())
+				witnessPublic, _ := witness.Public()
+
+				proof, err := groth16.Prove(ccs, pk, witness)
+				assert.Nil(t, err)
+
+				proofBytes := bn254.SerializeProof(proof.(*groth16bn254.Proof))
+				vkBytes := bn254.SerializeVerifyingKey(vk.(*groth16bn254.VerifyingKey))
+				vkBytes[len(vkBytes)-1] ^= 1
+				witnessBytes, _ := witnessPublic.MarshalBinary()
+				publicInputs, _ := bn254.StripWitnessHeader(witnessBytes)
+
+				return append(append(proofBytes, vkBytes...), publicInputs...)
+			}(),
+			expectedGas:   226150,
+			expectedError: ErrorGroth16VerifyInvalidVerifyingKey,
 		},
 		{
 			name:          "not enough min length",
@@ -282,6 +336,8 @@ func TestGroth16(t *testing.T) {
 			actual, err := precompile.Run(tt.input)
 			gas := precompile.RequiredGas(tt.input)
 
+			assert.Equal(t, tt.expectedGas, gas)
+
 			if tt.expectedError != nil {
 				assert.NotNil(t, err)
 				assert.Equal(t, tt.expectedError, err)
@@ -291,7 +347,6 @@ func TestGroth16(t *testing.T) {
 
 			assert.Nil(t, err)
 			assert.Equal(t, tt.expected, actual)
-			assert.Equal(t, tt.expectedGas, gas)
 		})
 	}
 }
@@ -318,8 +373,9 @@ func TestRunProperties(t *testing.T) {
 			proofBytes := bn254.SerializeProof(proof.(*groth16bn254.Proof))
 			vkBytes := bn254.SerializeVerifyingKey(vk.(*groth16bn254.VerifyingKey))
 			witnessBytes, _ := witnessPublic.MarshalBinary()
+			publicInputs, _ := bn254.StripWitnessHeader(witnessBytes)
 
-			input := append(append(proofBytes, vkBytes...), witnessBytes[12:]...)
+			input := append(append(proofBytes, vkBytes...), publicInputs...)
 
 			result, err := precompile.Run(input)
 
@@ -379,5 +435,1025 @@ func TestRequiredGasProperties(t *testing.T) {
 		gen.IntRange(1, Groth16MaxPublicInputs),
 	))
 
+	properties.Property("Gas is positive iff the input length is accepted", prop.ForAll(
+		func(size int) bool {
+			precompile := NewGroth16BN254Verify()
+			input := make([]byte, size)
+
+			gas := precompile.RequiredGas(input)
+			_, err := precompile.Run(input)
+
+			return (gas > 0) == (err != ErrorGroth16VerifyInvalidInputLength)
+		},
+		gen.IntRange(0, buildInputSize(Groth16MaxPublicInputs+1)),
+	))
+
 	properties.TestingRun(t)
 }
+
+func TestRequiredGasSubMinimalInput(t *testing.T) {
+	sizes := []int{
+		0,
+		1,
+		bn254.BN254Groth16ProofSize,
+		bn254.BN254Groth16ProofSize + bn254.BN254Groth16VerifyVerifyingKeySize - 1,
+		bn254.BN254Groth16ProofSize + bn254.BN254Groth16VerifyVerifyingKeySize + bn254.BN254Groth16G1Size,
+	}
+
+	for _, size := range sizes {
+		precompile := NewGroth16BN254Verify()
+
+		gas := precompile.RequiredGas(make([]byte, size))
+
+		assert.Equal(t, uint64(0), gas)
+	}
+}
+
+func TestParsePublicWitnessMaxPublicInputs(t *testing.T) {
+	data := make([]byte, Groth16MaxPublicInputs*bn254.BN254Groth16FieldSize)
+
+	for index := range Groth16MaxPublicInputs {
+		data[(index+1)*bn254.BN254Groth16FieldSize-1] = byte(index)
+	}
+
+	parser := SolidityProofParsers[ecc.BN254]
+	result, err := parser.ParsePublicWitness(data, Groth16MaxPublicInputs)
+	assert.Nil(t, err)
+
+	parsed, err := result.MarshalBinary()
+	assert.Nil(t, err)
+
+	publicInputs, err := bn254.StripWitnessHeader(parsed)
+	assert.Nil(t, err)
+	assert.Equal(t, data, publicInputs)
+}
+
+func TestGroth16VerifyTyped(t *testing.T) {
+	ccs, _ := frontend.Compile(ecc.BN254.ScalarField(), r1cs.NewBuilder, &onePublicInputCircuit{})
+	pk, vk, _ := groth16.Setup(ccs)
+	fullWitness, _ := frontend.NewWitness(&onePublicInputCircuit{X: 1}, ecc.BN254.ScalarField())
+	publicWitness, _ := fullWitness.Public()
+	wrongWitness, _ := frontend.NewWitness(&onePublicInputCircuit{X: 2}, ecc.BN254.ScalarField(), frontend.PublicOnly())
+
+	proof, err := groth16.Prove(ccs, pk, fullWitness)
+	assert.Nil(t, err)
+
+	otherCcs, _ := frontend.Compile(ecc.BLS12_381.ScalarField(), r1cs.NewBuilder, &onePublicInputCircuit{})
+	otherPk, otherVk, _ := groth16.Setup(otherCcs)
+	otherWitness, _ := frontend.NewWitness(&onePublicInputCircuit{X: 1}, ecc.BLS12_381.ScalarField())
+	otherPublicWitness, _ := otherWitness.Public()
+
+	otherProof, err := groth16.Prove(otherCcs, otherPk, otherWitness)
+	assert.Nil(t, err)
+
+	tests := []struct {
+		name          string
+		precompile    *Groth16Verify
+		proof         groth16.Proof
+		vk            groth16.VerifyingKey
+		witness       witness.Witness
+		expected      bool
+		expectedError error
+	}{
+		{
+			name:       "valid proof",
+			precompile: NewGroth16BN254Verify(),
+			proof:      proof,
+			vk:         vk,
+			witness:    publicWitness,
+			expected:   true,
+		},
+		{
+			name:       "wrong public witness",
+			precompile: NewGroth16BN254Verify(),
+			proof:      proof,
+			vk:         vk,
+			witness:    wrongWitness,
+			expected:   false,
+		},
+		{
+			name:       "valid proof on matching curve instance",
+			precompile: NewGroth16BLS12381Verify(),
+			proof:      otherProof,
+			vk:         otherVk,
+			witness:    otherPublicWitness,
+			expected:   true,
+		},
+		{
+			name:          "proof from another curve",
+			precompile:    NewGroth16BN254Verify(),
+			proof:         otherProof,
+			vk:            otherVk,
+			witness:       otherPublicWitness,
+			expectedError: ErrorGroth16VerifyUnsupportedCurve,
+		},
+		{
+			name:          "unsupported curve",
+			precompile:    newGroth16Verify(ecc.BW6_761, SolidityProofParsers[ecc.BN254]),
+			proof:         proof,
+			vk:            vk,
+			witness:       publicWitness,
+			expectedError: ErrorGroth16VerifyUnsupportedCurve,
+		},
+		{
+			name:          "nil proof",
+			precompile:    NewGroth16BN254Verify(),
+			proof:         nil,
+			vk:            vk,
+			witness:       publicWitness,
+			expectedError: ErrorPanicGroth16Verify,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, err := tt.precompile.VerifyTyped(tt.proof, tt.vk, tt.witness)
+
+			if tt.expectedError != nil {
+				assert.NotNil(t, err)
+				assert.Equal(t, tt.expectedError, err)
+				assert.False(t, actual)
+
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+type commitmentCircuit struct {
+	X frontend.Variable `gnark:",public"`
+	Y frontend.Variable `gnark:",public"`
+	Z frontend.Variable
+}
+
+func (c *commitmentCircuit) Define(api frontend.API) error {
+	commitment, err := api.(frontend.Committer).Commit(c.Y, c.Z)
+
+	if err != nil {
+		return err
+	}
+
+	api.AssertIsDifferent(commitment, 0)
+	api.AssertIsEqual(api.Mul(c.Z, c.Z), c.X)
+
+	return nil
+}
+
+func TestGroth16WithCommitment(t *testing.T) {
+	ccs, _ := frontend.Compile(ecc.BN254.ScalarField(), r1cs.NewBuilder, &commitmentCircuit{})
+	pk, vk, _ := groth16.Setup(ccs)
+	witness, _ := frontend.NewWitness(&commitmentCircuit{X: 9, Y: 5, Z: 3}, ecc.BN254.ScalarField())
+	witnessPublic, _ := witness.Public()
+
+	proof, err := groth16.Prove(ccs, pk, witness)
+	assert.Nil(t, err)
+
+	err = groth16.Verify(proof, vk, witnessPublic)
+	assert.Nil(t, err)
+
+	assert.Len(t, proof.(*groth16bn254.Proof).Commitments, 1)
+	assert.Len(t, vk.(*groth16bn254.VerifyingKey).CommitmentKeys, 1)
+
+	proofBytes := bn254.SerializeProof(proof.(*groth16bn254.Proof))
+	vkBytes := bn254.SerializeVerifyingKey(vk.(*groth16bn254.VerifyingKey))
+	witnessBytes, _ := witnessPublic.MarshalBinary()
+	publicInputs, _ := bn254.StripWitnessHeader(witnessBytes)
+
+	tests := []struct {
+		name          string
+		input         []byte
+		expected      []byte
+		expectedGas   uint64
+		expectedError error
+	}{
+		{
+			name:        "valid proof with commitment",
+			input:       extendedInput(proofBytes, vkBytes, publicInputs),
+			expected:    []byte{1},
+			expectedGas: 232300,
+		},
+		{
+			name: "wrong public input",
+			input: func() []byte {
+				wrong := append([]byte{}, publicInputs...)
+				wrong[len(wrong)-1] ^= 1
+
+				return extendedInput(proofBytes, vkBytes, wrong)
+			}(),
+			expected:    []byte{0},
+			expectedGas: 232300,
+		},
+		{
+			name: "proof without its commitment extension",
+			input: extendedInput(
+				proofBytes[:bn254.BN254Groth16ProofSize],
+				vkBytes,
+				publicInputs,
+			),
+			expected:    []byte{0},
+			expectedGas: 232300,
+		},
+		{
+			name:          "plain layout cannot carry commitments",
+			input:         append(append(append([]byte{}, proofBytes...), vkBytes...), publicInputs...),
+			expectedError: ErrorGroth16VerifyInvalidInputLength,
+		},
+		{
+			name: "truncated commitment extension",
+			input: extendedInput(
+				proofBytes[:len(proofBytes)-1],
+				vkBytes,
+				publicInputs,
+			),
+			expectedError: ErrorGroth16VerifyInvalidProof,
+		},
+		{
+			name: "verifying key commitment index out of range",
+			input: func() []byte {
+				wrong := append([]byte{}, vkBytes...)
+				wrong[len(wrong)-1] = 3
+
+				return extendedInput(proofBytes, wrong, publicInputs)
+			}(),
+			expectedError: ErrorGroth16VerifyInvalidVerifyingKey,
+		},
+		{
+			name:          "missing verifying key length",
+			input:         append([]byte{Groth16ExtendedInputFlag, 0, 0, 0, 0}, 0, 0),
+			expectedError: ErrorGroth16VerifyInvalidInputLength,
+		},
+		{
+			name:          "public inputs not aligned",
+			input:         extendedInput(proofBytes, vkBytes, publicInputs[1:]),
+			expectedError: ErrorGroth16VerifyInvalidInputLength,
+		},
+		{
+			name:          "no public inputs",
+			input:         extendedInput(proofBytes, vkBytes, nil),
+			expectedError: ErrorGroth16VerifyInvalidInputLength,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			precompile := NewGroth16BN254Verify()
+
+			actual, err := precompile.Run(tt.input)
+			gas := precompile.RequiredGas(tt.input)
+
+			if tt.expectedError != nil {
+				assert.NotNil(t, err)
+				assert.Equal(t, tt.expectedError, err)
+
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, tt.expected, actual)
+			assert.Equal(t, tt.expectedGas, gas)
+		})
+	}
+}
+
+// extendedInput encodes proof, verifying key and public inputs using the
+// extended Run layout.
+func extendedInput(proof, vk, publicInputs []byte) []byte {
+	out := []byte{Groth16ExtendedInputFlag}
+	out = binary.BigEndian.AppendUint32(out, uint32(len(proof)))
+	out = append(out, proof...)
+	out = binary.BigEndian.AppendUint32(out, uint32(len(vk)))
+	out = append(out, vk...)
+
+	return append(out, publicInputs...)
+}
+
+func TestGroth16CompressedProof(t *testing.T) {
+	ccs, _ := frontend.Compile(ecc.BN254.ScalarField(), r1cs.NewBuilder, &twoPublicInputCircuit{})
+	pk, vk, _ := groth16.Setup(ccs)
+	witness, _ := frontend.NewWitness(&twoPublicInputCircuit{X: 1, Y: 2}, ecc.BN254.ScalarField())
+	witnessPublic, _ := witness.Public()
+
+	proof, err := groth16.Prove(ccs, pk, witness)
+	assert.Nil(t, err)
+
+	plainProofBytes := bn254.SerializeProof(proof.(*groth16bn254.Proof))
+	proofBytes := bn254.SerializeProofCompressed(proof.(*groth16bn254.Proof))
+	vkBytes := bn254.SerializeVerifyingKey(vk.(*groth16bn254.VerifyingKey))
+	witnessBytes, _ := witnessPublic.MarshalBinary()
+	publicInputs, _ := bn254.StripWitnessHeader(witnessBytes)
+
+	assert.Len(t, proofBytes, bn254.BN254Groth16ProofCompressedSize)
+
+	invalidWitnessBytes := slices.Clone(publicInputs)
+	invalidWitnessBytes[len(invalidWitnessBytes)-1] ^= 1
+
+	compressedInput := func(proof, vk, publicInputs []byte) []byte {
+		return slices.Concat([]byte{Groth16CompressedInputFlag}, proof, vk, publicInputs)
+	}
+
+	tests := []struct {
+		name          string
+		precompile    *Groth16Verify
+		input         []byte
+		expected      []byte
+		expectedGas   uint64
+		expectedError error
+	}{
+		{
+			name:        "valid compressed proof",
+			precompile:  NewGroth16BN254Verify(),
+			input:       compressedInput(proofBytes, vkBytes, publicInputs),
+			expected:    []byte{1},
+			expectedGas: 232300,
+		},
+		{
+			name:        "compressed proof with invalid public input",
+			precompile:  NewGroth16BN254Verify(),
+			input:       compressedInput(proofBytes, vkBytes, invalidWitnessBytes),
+			expected:    []byte{0},
+			expectedGas: 232300,
+		},
+		{
+			name:          "uncompressed proof behind the compressed flag",
+			precompile:    NewGroth16BN254Verify(),
+			input:         compressedInput(plainProofBytes[:bn254.BN254Groth16ProofCompressedSize], vkBytes, publicInputs),
+			expectedGas:   232300,
+			expectedError: ErrorGroth16VerifyInvalidProof,
+		},
+		{
+			name:          "truncated compressed input",
+			precompile:    NewGroth16BN254Verify(),
+			input:         compressedInput(proofBytes, vkBytes[:bn254.BN254Groth16VerifyVerifyingKeySize-1], nil),
+			expectedError: ErrorGroth16VerifyInvalidInputLength,
+		},
+		{
+			name:          "compressed flag on a curve without compressed proofs",
+			precompile:    NewGroth16BLS12381Verify(),
+			input:         compressedInput(proofBytes, vkBytes, publicInputs),
+			expectedError: ErrorGroth16VerifyInvalidInputLength,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, err := tt.precompile.Run(tt.input)
+			gas := tt.precompile.RequiredGas(tt.input)
+
+			assert.Equal(t, tt.expectedGas, gas)
+
+			if tt.expectedError != nil {
+				assert.NotNil(t, err)
+				assert.Equal(t, tt.expectedError, err)
+
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+
+	proofLen, vkLen, numPublicInputs, err := NewGroth16BN254Verify().InspectInput(compressedInput(proofBytes, vkBytes, publicInputs))
+
+	assert.Nil(t, err)
+	assert.Equal(t, bn254.BN254Groth16ProofCompressedSize, proofLen)
+	assert.Equal(t, len(vkBytes), vkLen)
+	assert.Equal(t, 2, numPublicInputs)
+}
+
+func TestGroth16InspectInput(t *testing.T) {
+	fixedSize := bn254.BN254Groth16ProofSize + bn254.BN254Groth16VerifyVerifyingKeySize + bn254.BN254Groth16G1Size
+	perInputSize := bn254.BN254Groth16G1Size + bn254.BN254Groth16SinglePublicInputSize
+
+	tests := []struct {
+		name                    string
+		precompile              *Groth16Verify
+		input                   []byte
+		expectedProofLen        int
+		expectedVkLen           int
+		expectedNumPublicInputs int
+		expectedError           error
+	}{
+		{
+			name:                    "one public input",
+			precompile:              NewGroth16BN254Verify(),
+			input:                   make([]byte, fixedSize+perInputSize),
+			expectedProofLen:        bn254.BN254Groth16ProofSize,
+			expectedVkLen:           bn254.BN254Groth16VerifyVerifyingKeySize + 2*bn254.BN254Groth16G1Size,
+			expectedNumPublicInputs: 1,
+		},
+		{
+			name:                    "max public inputs",
+			precompile:              NewGroth16BN254Verify(),
+			input:                   make([]byte, fixedSize+Groth16MaxPublicInputs*perInputSize),
+			expectedProofLen:        bn254.BN254Groth16ProofSize,
+			expectedVkLen:           bn254.BN254Groth16VerifyVerifyingKeySize + (Groth16MaxPublicInputs+1)*bn254.BN254Groth16G1Size,
+			expectedNumPublicInputs: Groth16MaxPublicInputs,
+		},
+		{
+			name:                    "extended layout",
+			precompile:              NewGroth16BN254Verify(),
+			input:                   extendedInput(make([]byte, 300), make([]byte, 700), make([]byte, 2*bn254.BN254Groth16SinglePublicInputSize)),
+			expectedProofLen:        300,
+			expectedVkLen:           700,
+			expectedNumPublicInputs: 2,
+		},
+		{
+			name:          "not enough min length",
+			precompile:    NewGroth16BN254Verify(),
+			input:         make([]byte, bn254.BN254Groth16ProofSize+bn254.BN254Groth16VerifyVerifyingKeySize-1),
+			expectedError: ErrorGroth16VerifyInvalidInputLength,
+		},
+		{
+			name:          "remaining is not divisible by field size",
+			precompile:    NewGroth16BN254Verify(),
+			input:         make([]byte, bn254.BN254Groth16ProofSize+bn254.BN254Groth16VerifyVerifyingKeySize+1),
+			expectedError: ErrorGroth16VerifyInvalidInputLength,
+		},
+		{
+			name:          "zero public inputs",
+			precompile:    NewGroth16BN254Verify(),
+			input:         make([]byte, bn254.BN254Groth16ProofSize+bn254.BN254Groth16VerifyVerifyingKeySize),
+			expectedError: ErrorGroth16VerifyInvalidInputLength,
+		},
+		{
+			name:          "more than max public inputs",
+			precompile:    NewGroth16BN254Verify(),
+			input:         make([]byte, fixedSize+(Groth16MaxPublicInputs+1)*perInputSize),
+			expectedError: ErrorGroth16VerifyInvalidInputLength,
+		},
+		{
+			name:          "unsupported curve",
+			precompile:    newGroth16Verify(ecc.BW6_761, SolidityProofParsers[ecc.BN254]),
+			input:         make([]byte, fixedSize+perInputSize),
+			expectedError: ErrorGroth16VerifyUnsupportedCurve,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			proofLen, vkLen, numPublicInputs, err := tt.precompile.InspectInput(tt.input)
+
+			if tt.expectedError != nil {
+				assert.NotNil(t, err)
+				assert.Equal(t, tt.expectedError, err)
+
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, tt.expectedProofLen, proofLen)
+			assert.Equal(t, tt.expectedVkLen, vkLen)
+			assert.Equal(t, tt.expectedNumPublicInputs, numPublicInputs)
+		})
+	}
+}
+
+func TestGroth16MaxOutputSize(t *testing.T) {
+	var sizer common.OutputSizer = NewGroth16BN254Verify()
+
+	assert.Equal(t, 1, sizer.MaxOutputSize(nil))
+
+	sizer = NewGroth16BN254Verify().Verbose()
+
+	assert.Equal(t, 2, sizer.MaxOutputSize(nil))
+}
+
+func TestGroth16Verbose(t *testing.T) {
+	ccs, _ := frontend.Compile(ecc.BN254.ScalarField(), r1cs.NewBuilder, &twoPublicInputCircuit{})
+	pk, vk, _ := groth16.Setup(ccs)
+	witness, _ := frontend.NewWitness(&twoPublicInputCircuit{X: 1, Y: 2}, ecc.BN254.ScalarField())
+	witnessPublic, _ := witness.Public()
+
+	proof, err := groth16.Prove(ccs, pk, witness)
+	assert.Nil(t, err)
+
+	proofBytes := bn254.SerializeProof(proof.(*groth16bn254.Proof))
+	vkBytes := bn254.SerializeVerifyingKey(vk.(*groth16bn254.VerifyingKey))
+	witnessBytes, _ := witnessPublic.MarshalBinary()
+	publicInputs, _ := bn254.StripWitnessHeader(witnessBytes)
+
+	invalidPublicInputs := slices.Clone(publicInputs)
+	invalidPublicInputs[len(invalidPublicInputs)-1] ^= 1
+
+	offCurveProofBytes := slices.Clone(proofBytes)
+	offCurveProofBytes[bn254.BN254Groth16G1Size-1] ^= 1
+
+	offCurveVkBytes := slices.Clone(vkBytes)
+	offCurveVkBytes[bn254.BN254Groth16G1Size-1] ^= 1
+
+	unknownHash := [Groth16VerifyingKeyHashSize]byte{}
+
+	tests := []struct {
+		name          string
+		precompile    *Groth16Verify
+		input         []byte
+		expected      []byte
+		expectedError error
+	}{
+		{
+			name:       "valid proof",
+			precompile: NewGroth16BN254Verify().Verbose(),
+			input:      slices.Concat(proofBytes, vkBytes, publicInputs),
+			expected:   []byte{1, Groth16VerifyReasonNone},
+		},
+		{
+			name:       "rejected proof",
+			precompile: NewGroth16BN254Verify().Verbose(),
+			input:      slices.Concat(proofBytes, vkBytes, invalidPublicInputs),
+			expected:   []byte{0, Groth16VerifyReasonProofRejected},
+		},
+		{
+			name:       "invalid input length",
+			precompile: NewGroth16BN254Verify().Verbose(),
+			input:      proofBytes[:bn254.BN254Groth16G1Size],
+			expected:   []byte{0, Groth16VerifyReasonInvalidInputLength},
+		},
+		{
+			name:       "invalid proof",
+			precompile: NewGroth16BN254Verify().Verbose(),
+			input:      slices.Concat(offCurveProofBytes, vkBytes, publicInputs),
+			expected:   []byte{0, Groth16VerifyReasonInvalidProof},
+		},
+		{
+			name:       "invalid verifying key",
+			precompile: NewGroth16BN254Verify().Verbose(),
+			input:      slices.Concat(proofBytes, offCurveVkBytes, publicInputs),
+			expected:   []byte{0, Groth16VerifyReasonInvalidVerifyingKey},
+		},
+		{
+			name:       "unknown verifying key",
+			precompile: NewGroth16BN254Verify().Verbose(),
+			input:      slices.Concat([]byte{Groth16VerifyingKeyHashInputFlag}, proofBytes, unknownHash[:], publicInputs),
+			expected:   []byte{0, Groth16VerifyReasonUnknownVerifyingKey},
+		},
+		{
+			name:       "invalid public witness",
+			precompile: newGroth16Verify(ecc.BN254, &invalidPublicWitnessParser{}).Verbose(),
+			input:      make([]byte, defaultMinSize),
+			expected:   []byte{0, Groth16VerifyReasonInvalidPublicWitness},
+		},
+		{
+			name:          "panic",
+			precompile:    newGroth16Verify(ecc.BN254, &panicParser{}).Verbose(),
+			input:         make([]byte, defaultMinSize),
+			expectedError: ErrorPanicGroth16Verify,
+		},
+		{
+			name:          "unsupported curve",
+			precompile:    newGroth16Verify(ecc.BW6_761, &panicParser{}).Verbose(),
+			input:         make([]byte, defaultMinSize),
+			expectedError: ErrorGroth16VerifyUnsupportedCurve,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, err := tt.precompile.Run(tt.input)
+
+			if tt.expectedError != nil {
+				assert.NotNil(t, err)
+				assert.Equal(t, tt.expectedError, err)
+
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+
+	precompile := NewGroth16BN254Verify()
+	verbose := precompile.Verbose()
+	hash, err := precompile.RegisterVerifyingKey(vkBytes)
+
+	assert.Nil(t, err)
+
+	actual, err := verbose.Run(slices.Concat([]byte{Groth16VerifyingKeyHashInputFlag}, proofBytes, hash[:], publicInputs))
+
+	assert.Nil(t, err)
+	assert.Equal(t, []byte{1, Groth16VerifyReasonNone}, actual)
+
+	actual, err = precompile.Run(slices.Concat(proofBytes, vkBytes, invalidPublicInputs))
+
+	assert.Nil(t, err)
+	assert.Equal(t, []byte{0}, actual)
+}
+
+func TestGroth16RunContext(t *testing.T) {
+	ccs, _ := frontend.Compile(ecc.BN254.ScalarField(), r1cs.NewBuilder, &onePublicInputCircuit{})
+	pk, vk, _ := groth16.Setup(ccs)
+	witness, _ := frontend.NewWitness(&onePublicInputCircuit{X: 1}, ecc.BN254.ScalarField())
+	witnessPublic, _ := witness.Public()
+
+	proof, err := groth16.Prove(ccs, pk, witness)
+	assert.Nil(t, err)
+
+	proofBytes := bn254.SerializeProof(proof.(*groth16bn254.Proof))
+	vkBytes := bn254.SerializeVerifyingKey(vk.(*groth16bn254.VerifyingKey))
+	witnessBytes, _ := witnessPublic.MarshalBinary()
+	publicInputs, _ := bn254.StripWitnessHeader(witnessBytes)
+	input := slices.Concat(proofBytes, vkBytes, publicInputs)
+
+	precompile := NewGroth16BN254Verify()
+
+	actual, err := precompile.RunContext(context.Background(), input)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []byte{1}, actual)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	actual, err = precompile.RunContext(ctx, input)
+
+	assert.Equal(t, context.Canceled, err)
+	assert.Nil(t, actual)
+
+	actual, err = precompile.Verbose().RunContext(ctx, input)
+
+	assert.Equal(t, context.Canceled, err)
+	assert.Nil(t, actual)
+
+	actual, err = newGroth16Verify(ecc.BN254, &panicParser{}).RunContext(ctx, make([]byte, defaultMinSize))
+
+	assert.Equal(t, ErrorPanicGroth16Verify, err)
+	assert.Nil(t, actual)
+}
+
+func TestGroth16BuildVerifyInput(t *testing.T) {
+	assert.Equal(t, Groth16ExtendedInputFlag, bn254.BN254Groth16ExtendedInputFlag)
+	assert.Equal(t, Groth16ExtendedInputLengthSize, bn254.BN254Groth16ExtendedInputLengthSize)
+
+	prove := func(circuit, assignment frontend.Circuit) (*groth16bn254.Proof, *groth16bn254.VerifyingKey, witness.Witness) {
+		ccs, _ := frontend.Compile(ecc.BN254.ScalarField(), r1cs.NewBuilder, circuit)
+		pk, vk, _ := groth16.Setup(ccs)
+		fullWitness, _ := frontend.NewWitness(assignment, ecc.BN254.ScalarField())
+		publicWitness, _ := fullWitness.Public()
+
+		proof, err := groth16.Prove(ccs, pk, fullWitness)
+		assert.Nil(t, err)
+
+		return proof.(*groth16bn254.Proof), vk.(*groth16bn254.VerifyingKey), publicWitness
+	}
+
+	proof, vk, publicWitness := prove(&twoPublicInputCircuit{}, &twoPublicInputCircuit{X: 1, Y: 2})
+	commitmentProof, commitmentVk, commitmentWitness := prove(&commitmentCircuit{}, &commitmentCircuit{X: 9, Y: 5, Z: 3})
+
+	invalidWitness, _ := frontend.NewWitness(&twoPublicInputCircuit{X: 1, Y: 3}, ecc.BN254.ScalarField(), frontend.PublicOnly())
+	shortWitness, _ := frontend.NewWitness(&onePublicInputCircuit{X: 1}, ecc.BN254.ScalarField(), frontend.PublicOnly())
+	otherCurveWitness, _ := frontend.NewWitness(&twoPublicInputCircuit{X: 1, Y: 2}, ecc.BLS12_381.ScalarField(), frontend.PublicOnly())
+
+	tests := []struct {
+		name          string
+		proof         *groth16bn254.Proof
+		vk            *groth16bn254.VerifyingKey
+		witness       witness.Witness
+		expected      []byte
+		expectedError error
+	}{
+		{
+			name:     "valid proof",
+			proof:    proof,
+			vk:       vk,
+			witness:  publicWitness,
+			expected: []byte{1},
+		},
+		{
+			name:     "valid proof with commitment",
+			proof:    commitmentProof,
+			vk:       commitmentVk,
+			witness:  commitmentWitness,
+			expected: []byte{1},
+		},
+		{
+			name:     "invalid public inputs",
+			proof:    proof,
+			vk:       vk,
+			witness:  invalidWitness,
+			expected: []byte{0},
+		},
+		{
+			name:          "public input count mismatch",
+			proof:         proof,
+			vk:            vk,
+			witness:       shortWitness,
+			expectedError: bn254.ErrorInvalidVerifyInput,
+		},
+		{
+			name:          "witness on another curve",
+			proof:         proof,
+			vk:            vk,
+			witness:       otherCurveWitness,
+			expectedError: bn254.ErrorInvalidVerifyInput,
+		},
+		{
+			name:          "nil proof",
+			vk:            vk,
+			witness:       publicWitness,
+			expectedError: bn254.ErrorInvalidVerifyInput,
+		},
+		{
+			name:          "nil verifying key",
+			proof:         proof,
+			witness:       publicWitness,
+			expectedError: bn254.ErrorInvalidVerifyInput,
+		},
+		{
+			name:          "nil witness",
+			proof:         proof,
+			vk:            vk,
+			expectedError: bn254.ErrorInvalidVerifyInput,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input, err := bn254.BuildVerifyInput(tt.proof, tt.vk, tt.witness)
+
+			if tt.expectedError != nil {
+				assert.NotNil(t, err)
+				assert.Equal(t, tt.expectedError, err)
+
+				return
+			}
+
+			assert.Nil(t, err)
+
+			actual, err := NewGroth16BN254Verify().Run(input)
+
+			assert.Nil(t, err)
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func TestGroth16VerifyWithLimit(t *testing.T) {
+	buildInput := func(size int) []byte {
+		circuit := &bn254.VariablePublicCircuit{Public: make([]frontend.Variable, size)}
+		assignment := &bn254.VariablePublicCircuit{Public: make([]frontend.Variable, size)}
+
+		for index := range assignment.Public {
+			assignment.Public[index] = index + 1
+		}
+
+		ccs, _ := frontend.Compile(ecc.BN254.ScalarField(), r1cs.NewBuilder, circuit)
+		pk, vk, _ := groth16.Setup(ccs)
+		fullWitness, _ := frontend.NewWitness(assignment, ecc.BN254.ScalarField())
+		publicWitness, _ := fullWitness.Public()
+
+		proof, err := groth16.Prove(ccs, pk, fullWitness)
+		assert.Nil(t, err)
+
+		input, err := bn254.BuildVerifyInput(proof.(*groth16bn254.Proof), vk.(*groth16bn254.VerifyingKey), publicWitness)
+		assert.Nil(t, err)
+
+		return input
+	}
+
+	fourInputs := buildInput(4)
+	fiveInputs := buildInput(5)
+
+	tests := []struct {
+		name          string
+		precompile    *Groth16Verify
+		input         []byte
+		expected      []byte
+		expectedGas   uint64
+		expectedError error
+	}{
+		{
+			name:        "limit of 4 accepts 4 public inputs",
+			precompile:  NewGroth16BN254VerifyWithLimit(4),
+			input:       fourInputs,
+			expected:    []byte{1},
+			expectedGas: bn254.BN254Groth16VerifyBaseGas + 4*bn254.BN254Groth16PerPublicInputGas,
+		},
+		{
+			name:          "limit of 4 rejects 5 public inputs",
+			precompile:    NewGroth16BN254VerifyWithLimit(4),
+			input:         fiveInputs,
+			expectedError: ErrorGroth16VerifyInvalidInputLength,
+		},
+		{
+			name:       "limit of 4 applies to verbose instances",
+			precompile: NewGroth16BN254VerifyWithLimit(4).Verbose(),
+			input:      fiveInputs,
+			expected:   []byte{0, Groth16VerifyReasonInvalidInputLength},
+		},
+		{
+			name:        "default limit accepts 5 public inputs",
+			precompile:  NewGroth16BN254Verify(),
+			input:       fiveInputs,
+			expected:    []byte{1},
+			expectedGas: bn254.BN254Groth16VerifyBaseGas + 5*bn254.BN254Groth16PerPublicInputGas,
+		},
+		{
+			name:        "non-positive limit keeps the default",
+			precompile:  NewGroth16BN254VerifyWithLimit(0),
+			input:       fiveInputs,
+			expected:    []byte{1},
+			expectedGas: bn254.BN254Groth16VerifyBaseGas + 5*bn254.BN254Groth16PerPublicInputGas,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, err := tt.precompile.Run(tt.input)
+			gas := tt.precompile.RequiredGas(tt.input)
+
+			if tt.expectedError != nil {
+				assert.NotNil(t, err)
+				assert.Equal(t, tt.expectedError, err)
+				assert.Equal(t, uint64(0), gas)
+
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, tt.expected, actual)
+			assert.Equal(t, tt.expectedGas, gas)
+		})
+	}
+}
+
+func TestGroth16StrictVerify(t *testing.T) {
+	input := prepareCacheInput(t)
+
+	// Replace the last public input p with p + r, which reduces to p.
+	unreduced := slices.Clone(input)
+	last := unreduced[len(unreduced)-bn254.BN254Groth16SinglePublicInputSize:]
+	new(big.Int).Add(new(big.Int).SetBytes(last), ecc.BN254.ScalarField()).FillBytes(last)
+
+	tests := []struct {
+		name          string
+		precompile    *Groth16Verify
+		input         []byte
+		expected      []byte
+		expectedError error
+	}{
+		{
+			name:       "strict instance accepts canonical public inputs",
+			precompile: NewGroth16BN254StrictVerify(),
+			input:      input,
+			expected:   []byte{1},
+		},
+		{
+			name:          "strict instance rejects a public input not below r",
+			precompile:    NewGroth16BN254StrictVerify(),
+			input:         unreduced,
+			expectedError: ErrorGroth16VerifyInvalidPublicWitness,
+		},
+		{
+			name:       "strict instance reports the reason on verbose instances",
+			precompile: NewGroth16BN254StrictVerify().Verbose(),
+			input:      unreduced,
+			expected:   []byte{0, Groth16VerifyReasonInvalidPublicWitness},
+		},
+		{
+			name:       "default instance reduces a public input not below r",
+			precompile: NewGroth16BN254Verify(),
+			input:      unreduced,
+			expected:   []byte{1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, err := tt.precompile.Run(tt.input)
+
+			if tt.expectedError != nil {
+				assert.NotNil(t, err)
+				assert.Equal(t, tt.expectedError, err)
+
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func BenchmarkGroth16BN254Verify(b *testing.B) {
+	commontest.BenchmarkGasRatio(b, NewGroth16BN254Verify(), prepareCacheInput(b))
+}
+
+func FuzzGroth16Run(f *testing.F) {
+	f.Add(prepareCacheInput(f))
+	f.Add([]byte{bn254.BN254Groth16ExtendedInputFlag})
+
+	precompile := NewGroth16BN254Verify()
+
+	f.Fuzz(func(t *testing.T, input []byte) {
+		// Any error is acceptable, including ErrorPanicGroth16Verify from a
+		// recovered panic: the fuzzer only fails if a panic escapes Run.
+		actual, err := precompile.Run(input)
+
+		if err == nil {
+			assert.LessOrEqual(t, len(actual), precompile.MaxOutputSize(input))
+		}
+	})
+}
+
+func TestGroth16ExplicitPublicInputCount(t *testing.T) {
+	plain := prepareCacheInput(t)
+
+	explicit := func(count uint32, body []byte) []byte {
+		input := []byte{Groth16ExplicitCountInputFlag}
+		input = binary.BigEndian.AppendUint32(input, count)
+
+		return append(input, body...)
+	}
+
+	padded := append(slices.Clone(plain), 0)
+	expectedGas := uint64(bn254.BN254Groth16VerifyBaseGas + 2*bn254.BN254Groth16PerPublicInputGas)
+
+	tests := []struct {
+		name          string
+		input         []byte
+		expected      []byte
+		expectedGas   uint64
+		expectedError error
+	}{
+		{
+			name:        "explicit count matching the input",
+			input:       explicit(2, plain),
+			expected:    []byte{1},
+			expectedGas: expectedGas,
+		},
+		{
+			name:          "inferred count rejects trailing padding",
+			input:         padded,
+			expectedError: ErrorGroth16VerifyInvalidInputLength,
+		},
+		{
+			name:          "explicit count rejects trailing padding",
+			input:         explicit(2, padded),
+			expectedError: ErrorGroth16VerifyInvalidInputLength,
+		},
+		{
+			name:          "explicit count lower than the byte math",
+			input:         explicit(1, plain),
+			expectedError: ErrorGroth16VerifyInvalidInputLength,
+		},
+		{
+			name:          "explicit count greater than the byte math",
+			input:         explicit(3, plain),
+			expectedError: ErrorGroth16VerifyInvalidInputLength,
+		},
+		{
+			name:          "explicit count of zero",
+			input:         explicit(0, plain),
+			expectedError: ErrorGroth16VerifyInvalidInputLength,
+		},
+		{
+			name:          "explicit count above the public input limit",
+			input:         explicit(math.MaxUint32, plain),
+			expectedError: ErrorGroth16VerifyInvalidInputLength,
+		},
+		{
+			name:          "truncated explicit count",
+			input:         []byte{Groth16ExplicitCountInputFlag, 0, 0},
+			expectedError: ErrorGroth16VerifyInvalidInputLength,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			precompile := NewGroth16BN254Verify()
+
+			actual, err := precompile.Run(tt.input)
+			gas := precompile.RequiredGas(tt.input)
+
+			if tt.expectedError != nil {
+				assert.Equal(t, tt.expectedError, err)
+				assert.Equal(t, uint64(0), gas)
+
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, tt.expected, actual)
+			assert.Equal(t, tt.expectedGas, gas)
+		})
+	}
+}
+
+func TestGroth16RejectsTrailingBytes(t *testing.T) {
+	plain := prepareCacheInput(t)
+	precompile := NewGroth16BN254Verify()
+
+	for trailing := 1; trailing < bn254.BN254Groth16G1Size; trailing++ {
+		input := append(slices.Clone(plain), make([]byte, trailing)...)
+
+		actual, err := precompile.Run(input)
+
+		assert.Nil(t, actual)
+		assert.Equal(t, ErrorGroth16VerifyInvalidInputLength, err)
+		assert.Equal(t, uint64(0), precompile.RequiredGas(input))
+	}
+}
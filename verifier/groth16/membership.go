@@ -0,0 +1,88 @@
+package groth16
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/iden3/go-iden3-crypto/poseidon"
+	"github.com/privacy-ethereum/privacy-precompiles/utils"
+)
+
+// RunWithMembership verifies a Groth16 proof and checks that leaf is included
+// in the Poseidon Merkle tree whose root is a public input of that proof.
+//
+// The input uses the same layouts as Run. The root is read from the public
+// input at position Groth16MembershipRootPublicInputIndex, i.e. circuits must
+// expose the Merkle root as their first public input.
+//
+// The path lists the sibling nodes from the leaf up to the root, each a
+// big-endian field element. Bit i of pathIndices is set if the node at level
+// i is the right child, in which case path[i] is hashed on the left:
+//
+//	node = poseidon(path[i], node)   if bit i of pathIndices is set
+//	node = poseidon(node, path[i])   otherwise
+//
+// This is the rule used by the PoseidonMerkleVerify precompile and by
+// index-based trees such as Semaphore's incremental Merkle tree.
+//
+// Execution steps:
+//  1. Validate the path length and indices.
+//  2. Verify the proof as Run does.
+//  3. Fold the path into leaf and compare the result against the root.
+//
+// Return value:
+//   - []byte{1} if the proof is valid and leaf is included under the root.
+//   - []byte{0} if the proof is invalid or the inclusion check fails.
+//   - An error if the input is malformed, the path is empty or longer than
+//     Groth16MembershipMaxPathLength, pathIndices has bits set at or above
+//     the path length, or any node is not inside the Poseidon field.
+//
+// The result uses this single-byte form even on instances returned by
+// Verbose.
+func (c *Groth16Verify) RunWithMembership(input []byte, leaf [32]byte, pathIndices uint32, path [][32]byte) ([]byte, error) {
+	if len(path) == 0 || len(path) > Groth16MembershipMaxPathLength ||
+		uint64(pathIndices)>>len(path) != 0 {
+		return nil, ErrorGroth16VerifyInvalidMembershipPath
+	}
+
+	valid, err := c.run(context.Background(), input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if !valid {
+		return []byte{0}, nil
+	}
+
+	params := Groth16Params[c.curveID]
+	_, _, publicWitnessBytes, _, _ := c.splitInput(input, &params)
+
+	root, _ := utils.ReadField(
+		publicWitnessBytes,
+		Groth16MembershipRootPublicInputIndex*params.singlePublicInputSize,
+		params.singlePublicInputSize,
+	)
+
+	node := new(big.Int).SetBytes(leaf[:])
+
+	for level, word := range path {
+		sibling := new(big.Int).SetBytes(word[:])
+
+		if pathIndices>>level&1 == 1 {
+			node, err = poseidon.Hash([]*big.Int{sibling, node})
+		} else {
+			node, err = poseidon.Hash([]*big.Int{node, sibling})
+		}
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if node.Cmp(root) != 0 {
+		return []byte{0}, nil
+	}
+
+	return []byte{1}, nil
+}
@@ -0,0 +1,225 @@
+package groth16
+
+import (
+	"encoding/binary"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	bn254Curve "github.com/consensys/gnark-crypto/ecc/bn254"
+	"github.com/consensys/gnark/backend/groth16"
+	groth16bn254 "github.com/consensys/gnark/backend/groth16/bn254"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/frontend/cs/r1cs"
+	"github.com/iden3/go-iden3-crypto/poseidon"
+	"github.com/privacy-ethereum/privacy-precompiles/poseidon/merkle"
+	"github.com/privacy-ethereum/privacy-precompiles/verifier/groth16/bn254"
+	"github.com/stretchr/testify/assert"
+)
+
+type membershipCircuit struct {
+	Root   frontend.Variable `gnark:",public"`
+	Nonce  frontend.Variable `gnark:",public"`
+	Secret frontend.Variable
+}
+
+func (c *membershipCircuit) Define(api frontend.API) error {
+	api.AssertIsEqual(api.Mul(c.Secret, c.Nonce), c.Root)
+
+	return nil
+}
+
+func TestGroth16MembershipMaxPathLength(t *testing.T) {
+	assert.Equal(t, merkle.PoseidonMerkleMaxDepth, Groth16MembershipMaxPathLength)
+}
+
+func TestGroth16RunWithMembership(t *testing.T) {
+	// Leaves in decreasing order, so that position order and sorted order
+	// differ at every level.
+	leaves := []*big.Int{big.NewInt(40), big.NewInt(30), big.NewInt(20), big.NewInt(10)}
+	root, path := buildTree(leaves, 1)
+	leaf := toWord(leaves[1])
+
+	input := prepareMembershipInput(t, root)
+
+	badPath := append([][32]byte{}, path...)
+	badPath[0] = toWord(big.NewInt(31))
+
+	// Replace Krs with the G1 generator: a valid point that breaks the proof.
+	_, _, generator, _ := bn254Curve.Generators()
+	invalidProof := append([]byte{}, input...)
+	copy(invalidProof[bn254.BN254Groth16ProofSize-bn254.BN254Groth16G1Size:], generator.Marshal())
+
+	tests := []struct {
+		name          string
+		input         []byte
+		leaf          [32]byte
+		pathIndices   uint32
+		path          [][32]byte
+		expected      []byte
+		expectedError error
+	}{
+		{
+			name:        "valid proof and inclusion path",
+			input:       input,
+			leaf:        leaf,
+			pathIndices: 1,
+			path:        path,
+			expected:    []byte{1},
+		},
+		{
+			name:        "bad inclusion path",
+			input:       input,
+			leaf:        leaf,
+			pathIndices: 1,
+			path:        badPath,
+			expected:    []byte{0},
+		},
+		{
+			name:        "leaf not in tree",
+			input:       input,
+			leaf:        toWord(big.NewInt(50)),
+			pathIndices: 1,
+			path:        path,
+			expected:    []byte{0},
+		},
+		{
+			name:        "invalid proof",
+			input:       invalidProof,
+			leaf:        leaf,
+			pathIndices: 1,
+			path:        path,
+			expected:    []byte{0},
+		},
+		{
+			name:        "wrong path indices",
+			input:       input,
+			leaf:        leaf,
+			pathIndices: 0,
+			path:        path,
+			expected:    []byte{0},
+		},
+		{
+			name:          "path indices beyond the path length",
+			input:         input,
+			leaf:          leaf,
+			pathIndices:   1 << 2,
+			path:          path,
+			expectedError: ErrorGroth16VerifyInvalidMembershipPath,
+		},
+		{
+			name:          "empty path",
+			input:         input,
+			leaf:          leaf,
+			path:          [][32]byte{},
+			expectedError: ErrorGroth16VerifyInvalidMembershipPath,
+		},
+		{
+			name:          "path too long",
+			input:         input,
+			leaf:          leaf,
+			path:          make([][32]byte, Groth16MembershipMaxPathLength+1),
+			expectedError: ErrorGroth16VerifyInvalidMembershipPath,
+		},
+		{
+			name:          "malformed input",
+			input:         input[:bn254.BN254Groth16ProofSize],
+			leaf:          leaf,
+			pathIndices:   1,
+			path:          path,
+			expectedError: ErrorGroth16VerifyInvalidInputLength,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			precompile := NewGroth16BN254Verify()
+
+			actual, err := precompile.RunWithMembership(tt.input, tt.leaf, tt.pathIndices, tt.path)
+
+			if tt.expectedError != nil {
+				assert.NotNil(t, err)
+				assert.Equal(t, tt.expectedError, err)
+
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func TestGroth16RunWithMembershipMatchesPoseidonMerkleVerify(t *testing.T) {
+	leaves := []*big.Int{big.NewInt(40), big.NewInt(30), big.NewInt(20), big.NewInt(10)}
+
+	for index, leaf := range leaves {
+		root, path := buildTree(leaves, index)
+
+		leafWord, rootWord := toWord(leaf), toWord(root)
+
+		input := append(leafWord[:], rootWord[:]...)
+		input = binary.BigEndian.AppendUint32(input, uint32(len(path)))
+
+		for level, sibling := range path {
+			input = append(append(input, sibling[:]...), byte(index>>level&1))
+		}
+
+		actual, err := (&merkle.PoseidonMerkleVerify{}).Run(input)
+
+		assert.Nil(t, err)
+		assert.Equal(t, []byte{1}, actual)
+	}
+}
+
+// prepareMembershipInput proves membershipCircuit for the given root and
+// returns the serialized Run input.
+func prepareMembershipInput(t *testing.T, root *big.Int) []byte {
+	nonce := big.NewInt(7)
+	secret := new(big.Int).ModInverse(nonce, ecc.BN254.ScalarField())
+	secret.Mul(secret, root).Mod(secret, ecc.BN254.ScalarField())
+
+	assignment := &membershipCircuit{Root: root, Nonce: nonce, Secret: secret}
+	ccs, _ := frontend.Compile(ecc.BN254.ScalarField(), r1cs.NewBuilder, &membershipCircuit{})
+	pk, vk, _ := groth16.Setup(ccs)
+	witness, _ := frontend.NewWitness(assignment, ecc.BN254.ScalarField())
+	witnessPublic, _ := witness.Public()
+
+	proof, err := groth16.Prove(ccs, pk, witness)
+	assert.Nil(t, err)
+
+	proofBytes := bn254.SerializeProof(proof.(*groth16bn254.Proof))
+	vkBytes := bn254.SerializeVerifyingKey(vk.(*groth16bn254.VerifyingKey))
+	witnessBytes, _ := witnessPublic.MarshalBinary()
+	publicInputs, _ := bn254.StripWitnessHeader(witnessBytes)
+
+	return append(append(proofBytes, vkBytes...), publicInputs...)
+}
+
+// buildTree returns the Poseidon Merkle root of leaves, hashing every pair
+// in position order, and the sibling path of the leaf at index.
+func buildTree(leaves []*big.Int, index int) (*big.Int, [][32]byte) {
+	path := make([][32]byte, 0)
+	level := leaves
+
+	for len(level) > 1 {
+		path = append(path, toWord(level[index^1]))
+		next := make([]*big.Int, len(level)/2)
+
+		for i := range next {
+			next[i], _ = poseidon.Hash([]*big.Int{level[2*i], level[2*i+1]})
+		}
+
+		level = next
+		index /= 2
+	}
+
+	return level[0], path
+}
+
+func toWord(value *big.Int) [32]byte {
+	var word [32]byte
+	value.FillBytes(word[:])
+
+	return word
+}
@@ -14,7 +14,154 @@ const (
 	//
 	// If the number of provided public inputs exceeds this value,
 	// verification must fail.
+	//
+	// It is the default limit of Groth16Verify instances; instances created
+	// with NewGroth16BN254VerifyWithLimit use their own limit instead.
 	Groth16MaxPublicInputs = 64
+
+	// Groth16MembershipRootPublicInputIndex defines the position, among the
+	// proof public inputs, of the Poseidon Merkle root checked by
+	// RunWithMembership. Circuits used with RunWithMembership must declare
+	// the root as their first public input.
+	Groth16MembershipRootPublicInputIndex = 0
+
+	// Groth16MembershipMaxPathLength defines the maximum number of siblings
+	// in a RunWithMembership path. It matches merkle.PoseidonMerkleMaxDepth
+	// without importing the merkle package.
+	Groth16MembershipMaxPathLength = 32
+
+	// Groth16BatchVerifyMaxProofs defines the maximum number of proof
+	// records accepted by a single Groth16BatchVerify call.
+	Groth16BatchVerifyMaxProofs = 16
+
+	// Groth16BatchVerifyRecordLengthSize defines the byte size of the
+	// big-endian length prefix preceding each Groth16BatchVerify record.
+	Groth16BatchVerifyRecordLengthSize = 4
+
+	// Groth16BatchVerifyBaseGas defines the fixed gas cost of a
+	// Groth16BatchVerify call, paid once for the whole batch on top of
+	// the per-proof and per-public-input costs.
+	Groth16BatchVerifyBaseGas = 10000
+
+	// Groth16ComputeVkXBaseGas defines the fixed gas cost of a
+	// Groth16ComputeVkX call, covering the addition of IC[0] to the
+	// multi-scalar multiplication priced per public input.
+	Groth16ComputeVkXBaseGas = 150
+
+	// Groth16ValidateVerifyingKeyCountSize defines the byte size of the
+	// big-endian public input count preceding the verifying key in a
+	// Groth16ValidateVerifyingKey input.
+	Groth16ValidateVerifyingKeyCountSize = 4
+
+	// Groth16ValidateVerifyingKeyBaseGas defines the fixed gas cost of a
+	// Groth16ValidateVerifyingKey call, covering the subgroup checks of the
+	// Beta, Gamma and Delta G2 points and of Alpha.
+	Groth16ValidateVerifyingKeyBaseGas = 3000
+
+	// Groth16ValidateVerifyingKeyPerPointGas defines the gas cost of
+	// checking a single IC point in a Groth16ValidateVerifyingKey call.
+	Groth16ValidateVerifyingKeyPerPointGas = 100
+
+	// Groth16VerifyingKeyCacheSize defines the maximum number of parsed
+	// verifying keys kept by each Groth16Verify instance. When the cache
+	// is full, the least recently used key is evicted.
+	Groth16VerifyingKeyCacheSize = 32
+
+	// Groth16ExtendedInputFlag defines the leading byte that selects the
+	// extended, length-prefixed Run input layout used by proofs and
+	// verifying keys with variable-size extensions such as gnark
+	// commitments.
+	//
+	// The value is larger than the first byte of any canonical base field
+	// element of a supported curve, so it never collides with the plain
+	// layout.
+	Groth16ExtendedInputFlag = 0x80
+
+	// Groth16CompressedInputFlag defines the leading byte that selects the
+	// compressed Run input layout, where the proof uses the curve's
+	// compressed point encoding. Only curves whose parser implements
+	// CompressedProofParser accept it.
+	Groth16CompressedInputFlag = 0x81
+
+	// Groth16VerifyingKeyHashInputFlag defines the leading byte that selects
+	// the Run input layout where the verifying key is replaced by its
+	// keccak256 hash, under which it was registered with
+	// RegisterVerifyingKey.
+	Groth16VerifyingKeyHashInputFlag = 0x82
+
+	// Groth16ExplicitCountInputFlag defines the leading byte that selects
+	// the Run input layout where the number of public inputs is stated
+	// explicitly instead of being inferred from the input length.
+	Groth16ExplicitCountInputFlag = 0x83
+
+	// Groth16VerifyingKeyHashSize defines the byte size of a verifying key
+	// keccak256 hash in the Groth16VerifyingKeyHashInputFlag input layout.
+	Groth16VerifyingKeyHashSize = 32
+
+	// Groth16CircuitIDSize defines the byte size of the circuit identifier
+	// under which Groth16VerifyByID verifying keys are registered.
+	Groth16CircuitIDSize = Groth16VerifyingKeyHashSize
+
+	// Groth16VerifyOutputSize defines the fixed byte length of the output
+	// produced by Run: a single boolean byte.
+	Groth16VerifyOutputSize = 1
+
+	// Groth16VerifyVerboseOutputSize defines the fixed byte length of the
+	// output produced by Run on a verbose instance: the boolean byte
+	// followed by a reason code.
+	Groth16VerifyVerboseOutputSize = 2
+
+	// Groth16VerifyWithDigestSize defines the byte length of the Poseidon
+	// digest of the public inputs returned by Groth16VerifyWithDigest.
+	Groth16VerifyWithDigestSize = 32
+
+	// Groth16VerifyWithDigestOutputSize defines the fixed byte length of the
+	// output produced by Groth16VerifyWithDigest: the boolean byte followed
+	// by the digest.
+	Groth16VerifyWithDigestOutputSize = 1 + Groth16VerifyWithDigestSize
+
+	// Groth16VerifyWithDigestDomainHex is the hexadecimal encoding of the
+	// ASCII string "groth16.digest", the prefix of the capacity tag of every
+	// continuation step of the Groth16VerifyWithDigest digest.
+	Groth16VerifyWithDigestDomainHex = "67726f746831362e646967657374"
+
+	// Groth16VerifyReasonNone is the verbose Run reason code of a valid
+	// proof.
+	Groth16VerifyReasonNone = 0x00
+
+	// Groth16VerifyReasonProofRejected is the verbose Run reason code of a
+	// well-formed proof that fails the pairing check.
+	Groth16VerifyReasonProofRejected = 0x01
+
+	// Groth16VerifyReasonInvalidInputLength is the verbose Run reason code
+	// matching ErrorGroth16VerifyInvalidInputLength.
+	Groth16VerifyReasonInvalidInputLength = 0x02
+
+	// Groth16VerifyReasonInvalidProof is the verbose Run reason code
+	// matching ErrorGroth16VerifyInvalidProof.
+	Groth16VerifyReasonInvalidProof = 0x03
+
+	// Groth16VerifyReasonInvalidVerifyingKey is the verbose Run reason code
+	// matching ErrorGroth16VerifyInvalidVerifyingKey.
+	Groth16VerifyReasonInvalidVerifyingKey = 0x04
+
+	// Groth16VerifyReasonUnknownVerifyingKey is the verbose Run reason code
+	// matching ErrorGroth16VerifyUnknownVerifyingKey.
+	Groth16VerifyReasonUnknownVerifyingKey = 0x05
+
+	// Groth16VerifyReasonInvalidPublicWitness is the verbose Run reason code
+	// matching ErrorGroth16VerifyInvalidPublicWitness.
+	Groth16VerifyReasonInvalidPublicWitness = 0x06
+
+	// Groth16ExtendedInputLengthSize defines the byte size of the
+	// big-endian proof and verifying key lengths of the extended input
+	// layout.
+	Groth16ExtendedInputLengthSize = 4
+
+	// Groth16ExplicitCountSize defines the byte size of the big-endian
+	// number of public inputs of the Groth16ExplicitCountInputFlag input
+	// layout.
+	Groth16ExplicitCountSize = 4
 )
 
 var (
@@ -54,4 +201,25 @@ var (
 	// provided public inputs (public witness) are malformed or exceed
 	// the maximum allowed number of inputs.
 	ErrorGroth16VerifyInvalidPublicWitness = errors.New("invalid public witness")
+
+	// ErrorGroth16VerifyUnknownVerifyingKey is returned when an input
+	// references a verifying key hash that was not registered with
+	// RegisterVerifyingKey.
+	ErrorGroth16VerifyUnknownVerifyingKey = errors.New("unknown verifying key")
+
+	// ErrorGroth16VerifyVerifyingKeyAlreadyRegistered is returned when a
+	// verifying key is registered under a hash or circuit identifier that
+	// already holds a key parsed from different bytes.
+	ErrorGroth16VerifyVerifyingKeyAlreadyRegistered = errors.New("verifying key already registered")
+
+	// ErrorGroth16VerifyUnknownCircuit is returned when a Groth16VerifyByID
+	// input references a circuit identifier that was not registered for
+	// its curve with RegisterCircuit.
+	ErrorGroth16VerifyUnknownCircuit = errors.New("unknown circuit")
+
+	// ErrorGroth16VerifyInvalidMembershipPath is returned when the Merkle
+	// path provided to RunWithMembership is empty or longer than
+	// Groth16MembershipMaxPathLength, or its path indices have bits set at or
+	// above the path length.
+	ErrorGroth16VerifyInvalidMembershipPath = errors.New("invalid membership path")
 )
@@ -0,0 +1,62 @@
+package groth16
+
+import (
+	"encoding/json"
+	"math/big"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/privacy-ethereum/privacy-precompiles/verifier/groth16/bn254"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGroth16BN254VerifySnarkjsArtifacts(t *testing.T) {
+	fixture := func(name string) []byte {
+		data, err := os.ReadFile(filepath.Join("bn254", "testdata", "snarkjs", name))
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return data
+	}
+
+	vk, err := bn254.ParseVerifyingKeyFromSnarkjsJSON(fixture("verification_key.json"))
+	assert.Nil(t, err)
+
+	proof, err := bn254.ParseProofFromSnarkjsJSON(fixture("proof.json"))
+	assert.Nil(t, err)
+
+	// public.json lists the public signals as decimal strings, each encoded
+	// as a 32-byte big-endian field element in the Run input.
+	var signals []string
+	assert.Nil(t, json.Unmarshal(fixture("public.json"), &signals))
+
+	publicInputs := make([]byte, 0, len(signals)*bn254.BN254Groth16SinglePublicInputSize)
+
+	for _, signal := range signals {
+		value, ok := new(big.Int).SetString(signal, 10)
+		assert.True(t, ok)
+
+		publicInputs = append(publicInputs, value.FillBytes(make([]byte, bn254.BN254Groth16SinglePublicInputSize))...)
+	}
+
+	input := slices.Concat(bn254.SerializeProof(proof), bn254.SerializeVerifyingKey(vk), publicInputs)
+	precompile := NewGroth16BN254Verify()
+
+	actual, err := precompile.Run(input)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []byte{1}, actual)
+
+	// Swapping the public signals breaks the proof, as gnark binds each
+	// public input to its IC point.
+	swapped := slices.Concat(publicInputs[bn254.BN254Groth16SinglePublicInputSize:], publicInputs[:bn254.BN254Groth16SinglePublicInputSize])
+
+	actual, err = precompile.Run(slices.Concat(bn254.SerializeProof(proof), bn254.SerializeVerifyingKey(vk), swapped))
+
+	assert.Nil(t, err)
+	assert.Equal(t, []byte{0}, actual)
+}
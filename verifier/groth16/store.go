@@ -0,0 +1,73 @@
+package groth16
+
+import (
+	"crypto/sha256"
+	"sync"
+
+	"github.com/consensys/gnark/backend/groth16"
+)
+
+// verifyingKeyStore is a concurrency-safe map of parsed and precomputed
+// Groth16 verifying keys, keyed by the hash they were registered under.
+//
+// Unlike verifyingKeyCache, entries are never evicted. A nil store is
+// valid and never stores anything.
+type verifyingKeyStore struct {
+	mutex   sync.RWMutex
+	entries map[[Groth16VerifyingKeyHashSize]byte]verifyingKeyStoreEntry
+}
+
+// verifyingKeyStoreEntry is a registered verifying key together with the
+// number of public inputs it was parsed for and the SHA-256 hash of the
+// bytes it was parsed from.
+type verifyingKeyStoreEntry struct {
+	vk                   groth16.VerifyingKey
+	numberOfPublicInputs int
+	digest               [sha256.Size]byte
+}
+
+// newVerifyingKeyStore returns an empty store.
+func newVerifyingKeyStore() *verifyingKeyStore {
+	return &verifyingKeyStore{
+		entries: make(map[[Groth16VerifyingKeyHashSize]byte]verifyingKeyStoreEntry),
+	}
+}
+
+// get returns the entry registered under hash.
+func (s *verifyingKeyStore) get(hash [Groth16VerifyingKeyHashSize]byte) (verifyingKeyStoreEntry, bool) {
+	if s == nil {
+		return verifyingKeyStoreEntry{}, false
+	}
+
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	entry, ok := s.entries[hash]
+
+	return entry, ok
+}
+
+// add stores entry under hash. Adding an entry parsed from the same bytes
+// as the one already stored under hash is a no-op, while an entry parsed
+// from different bytes is rejected with
+// ErrorGroth16VerifyVerifyingKeyAlreadyRegistered.
+func (s *verifyingKeyStore) add(hash [Groth16VerifyingKeyHashSize]byte, entry verifyingKeyStoreEntry) error {
+	if s == nil {
+		return nil
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if existing, ok := s.entries[hash]; ok {
+		if existing.digest != entry.digest {
+			return ErrorGroth16VerifyVerifyingKeyAlreadyRegistered
+		}
+
+		return nil
+	}
+
+	s.entries[hash] = entry
+
+	return nil
+}
@@ -0,0 +1,280 @@
+package groth16
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"slices"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark/backend/groth16"
+	groth16bn254 "github.com/consensys/gnark/backend/groth16/bn254"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/frontend/cs/r1cs"
+	"github.com/iden3/go-iden3-crypto/keccak256"
+	"github.com/privacy-ethereum/privacy-precompiles/verifier/groth16/bn254"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVerifyingKeyStore(t *testing.T) {
+	store := newVerifyingKeyStore()
+
+	hash := sha256.Sum256([]byte{1})
+	firstKey := &groth16bn254.VerifyingKey{}
+	secondKey := &groth16bn254.VerifyingKey{}
+
+	_, ok := store.get(hash)
+	assert.False(t, ok)
+
+	assert.Nil(t, store.add(hash, verifyingKeyStoreEntry{vk: firstKey, numberOfPublicInputs: 1, digest: sha256.Sum256([]byte{1})}))
+
+	entry, ok := store.get(hash)
+	assert.True(t, ok)
+	assert.Same(t, firstKey, entry.vk)
+	assert.Equal(t, 1, entry.numberOfPublicInputs)
+
+	// Adding the same bytes again keeps the registered key.
+	assert.Nil(t, store.add(hash, verifyingKeyStoreEntry{vk: secondKey, numberOfPublicInputs: 1, digest: sha256.Sum256([]byte{1})}))
+
+	entry, ok = store.get(hash)
+	assert.True(t, ok)
+	assert.Same(t, firstKey, entry.vk)
+
+	// Adding different bytes under the same hash is rejected.
+	err := store.add(hash, verifyingKeyStoreEntry{vk: secondKey, numberOfPublicInputs: 2, digest: sha256.Sum256([]byte{2})})
+	assert.Equal(t, ErrorGroth16VerifyVerifyingKeyAlreadyRegistered, err)
+
+	entry, ok = store.get(hash)
+	assert.True(t, ok)
+	assert.Same(t, firstKey, entry.vk)
+	assert.Equal(t, 1, entry.numberOfPublicInputs)
+
+	var nilStore *verifyingKeyStore
+
+	assert.Nil(t, nilStore.add(hash, verifyingKeyStoreEntry{vk: firstKey, numberOfPublicInputs: 1}))
+
+	_, ok = nilStore.get(hash)
+	assert.False(t, ok)
+}
+
+func TestGroth16RegisterVerifyingKey(t *testing.T) {
+	ccs, _ := frontend.Compile(ecc.BN254.ScalarField(), r1cs.NewBuilder, &twoPublicInputCircuit{})
+	_, vk, _ := groth16.Setup(ccs)
+	vkBytes := bn254.SerializeVerifyingKey(vk.(*groth16bn254.VerifyingKey))
+
+	commitmentCcs, _ := frontend.Compile(ecc.BN254.ScalarField(), r1cs.NewBuilder, &commitmentCircuit{})
+	_, commitmentVk, _ := groth16.Setup(commitmentCcs)
+	commitmentVkBytes := bn254.SerializeVerifyingKey(commitmentVk.(*groth16bn254.VerifyingKey))
+
+	// Alpha = (1, 3) is not on the curve y^2 = x^3 + 3.
+	offCurve := slices.Clone(vkBytes)
+	clear(offCurve[:bn254.BN254Groth16G1Size])
+	offCurve[bn254.BN254Groth16G1Size/2-1] = 1
+	offCurve[bn254.BN254Groth16G1Size-1] = 3
+
+	tests := []struct {
+		name          string
+		precompile    *Groth16Verify
+		vkBytes       []byte
+		expectedCount int
+		expectedError error
+	}{
+		{
+			name:          "valid verifying key",
+			precompile:    NewGroth16BN254Verify(),
+			vkBytes:       vkBytes,
+			expectedCount: 2,
+		},
+		{
+			name:          "verifying key with a commitment extension",
+			precompile:    NewGroth16BN254Verify(),
+			vkBytes:       commitmentVkBytes,
+			expectedCount: 2,
+		},
+		{
+			name:          "verifying key with more public inputs than the limit",
+			precompile:    NewGroth16BN254VerifyWithLimit(1),
+			vkBytes:       vkBytes,
+			expectedError: ErrorGroth16VerifyInvalidVerifyingKey,
+		},
+		{
+			name:          "truncated verifying key",
+			precompile:    NewGroth16BN254Verify(),
+			vkBytes:       vkBytes[:len(vkBytes)-1],
+			expectedError: ErrorGroth16VerifyInvalidVerifyingKey,
+		},
+		{
+			name:          "verifying key without public inputs",
+			precompile:    NewGroth16BN254Verify(),
+			vkBytes:       vkBytes[:bn254.BN254Groth16VerifyVerifyingKeySize+bn254.BN254Groth16G1Size],
+			expectedError: ErrorGroth16VerifyInvalidVerifyingKey,
+		},
+		{
+			name:          "verifying key with a point off the curve",
+			precompile:    NewGroth16BN254Verify(),
+			vkBytes:       offCurve,
+			expectedError: ErrorGroth16VerifyInvalidVerifyingKey,
+		},
+		{
+			name:          "unsupported curve",
+			precompile:    newGroth16Verify(ecc.UNKNOWN, nil),
+			vkBytes:       vkBytes,
+			expectedError: ErrorGroth16VerifyUnsupportedCurve,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hash, err := tt.precompile.RegisterVerifyingKey(tt.vkBytes)
+
+			assert.Equal(t, keccak256.Hash(tt.vkBytes), hash[:])
+
+			if tt.expectedError != nil {
+				assert.NotNil(t, err)
+				assert.Equal(t, tt.expectedError, err)
+
+				_, ok := tt.precompile.store.get(hash)
+				assert.False(t, ok)
+
+				return
+			}
+
+			assert.Nil(t, err)
+
+			entry, ok := tt.precompile.store.get(hash)
+			assert.True(t, ok)
+			assert.Equal(t, tt.expectedCount, entry.numberOfPublicInputs)
+
+			again, err := tt.precompile.RegisterVerifyingKey(tt.vkBytes)
+			assert.Nil(t, err)
+			assert.Equal(t, hash, again)
+		})
+	}
+}
+
+func TestGroth16RegisterVerifyingKeyHashIsKeccak256(t *testing.T) {
+	// keccak256("") as returned by Solidity, which differs from SHA3-256("").
+	expected := "c5d2460186f7233c927e7db2dcc703c0e500b653ca82273b7bfad8045d85a470"
+
+	hash, err := NewGroth16BN254Verify().RegisterVerifyingKey(nil)
+
+	assert.Equal(t, ErrorGroth16VerifyInvalidVerifyingKey, err)
+	assert.Equal(t, expected, hex.EncodeToString(hash[:]))
+}
+
+func TestGroth16VerifyingKeyHash(t *testing.T) {
+	ccs, _ := frontend.Compile(ecc.BN254.ScalarField(), r1cs.NewBuilder, &twoPublicInputCircuit{})
+	pk, vk, _ := groth16.Setup(ccs)
+	witness, _ := frontend.NewWitness(&twoPublicInputCircuit{X: 1, Y: 2}, ecc.BN254.ScalarField())
+	witnessPublic, _ := witness.Public()
+
+	proof, err := groth16.Prove(ccs, pk, witness)
+	assert.Nil(t, err)
+
+	proofBytes := bn254.SerializeProof(proof.(*groth16bn254.Proof))
+	vkBytes := bn254.SerializeVerifyingKey(vk.(*groth16bn254.VerifyingKey))
+	witnessBytes, _ := witnessPublic.MarshalBinary()
+	publicInputs, _ := bn254.StripWitnessHeader(witnessBytes)
+
+	invalidPublicInputs := slices.Clone(publicInputs)
+	invalidPublicInputs[len(invalidPublicInputs)-1] ^= 1
+
+	unknownHash := sha256.Sum256(nil)
+
+	precompile := NewGroth16BN254Verify()
+	hash, err := precompile.RegisterVerifyingKey(vkBytes)
+	assert.Nil(t, err)
+
+	hashInput := func(proof []byte, hash [Groth16VerifyingKeyHashSize]byte, publicInputs []byte) []byte {
+		return slices.Concat([]byte{Groth16VerifyingKeyHashInputFlag}, proof, hash[:], publicInputs)
+	}
+
+	tests := []struct {
+		name          string
+		precompile    *Groth16Verify
+		input         []byte
+		expected      []byte
+		expectedGas   uint64
+		expectedError error
+	}{
+		{
+			name:        "registered verifying key",
+			precompile:  precompile,
+			input:       hashInput(proofBytes, hash, publicInputs),
+			expected:    []byte{1},
+			expectedGas: 232300,
+		},
+		{
+			name:        "registered verifying key with invalid public input",
+			precompile:  precompile,
+			input:       hashInput(proofBytes, hash, invalidPublicInputs),
+			expected:    []byte{0},
+			expectedGas: 232300,
+		},
+		{
+			name:          "unknown verifying key hash",
+			precompile:    precompile,
+			input:         hashInput(proofBytes, unknownHash, publicInputs),
+			expectedGas:   232300,
+			expectedError: ErrorGroth16VerifyUnknownVerifyingKey,
+		},
+		{
+			name:          "verifying key registered on another instance",
+			precompile:    NewGroth16BN254Verify(),
+			input:         hashInput(proofBytes, hash, publicInputs),
+			expectedGas:   232300,
+			expectedError: ErrorGroth16VerifyUnknownVerifyingKey,
+		},
+		{
+			name:          "public input count mismatch",
+			precompile:    precompile,
+			input:         hashInput(proofBytes, hash, publicInputs[:bn254.BN254Groth16SinglePublicInputSize]),
+			expectedGas:   226150,
+			expectedError: ErrorGroth16VerifyInvalidInputLength,
+		},
+		{
+			name:          "missing public inputs",
+			precompile:    precompile,
+			input:         hashInput(proofBytes, hash, nil),
+			expectedError: ErrorGroth16VerifyInvalidInputLength,
+		},
+		{
+			name:          "misaligned public inputs",
+			precompile:    precompile,
+			input:         hashInput(proofBytes, hash, publicInputs[1:]),
+			expectedError: ErrorGroth16VerifyInvalidInputLength,
+		},
+		{
+			name:          "truncated hash",
+			precompile:    precompile,
+			input:         hashInput(proofBytes, hash, nil)[:1+bn254.BN254Groth16ProofSize+Groth16VerifyingKeyHashSize-1],
+			expectedError: ErrorGroth16VerifyInvalidInputLength,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, err := tt.precompile.Run(tt.input)
+			gas := tt.precompile.RequiredGas(tt.input)
+
+			assert.Equal(t, tt.expectedGas, gas)
+
+			if tt.expectedError != nil {
+				assert.NotNil(t, err)
+				assert.Equal(t, tt.expectedError, err)
+
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+
+	proofLen, vkLen, numPublicInputs, err := precompile.InspectInput(hashInput(proofBytes, hash, publicInputs))
+
+	assert.Nil(t, err)
+	assert.Equal(t, bn254.BN254Groth16ProofSize, proofLen)
+	assert.Equal(t, Groth16VerifyingKeyHashSize, vkLen)
+	assert.Equal(t, 2, numPublicInputs)
+}
@@ -0,0 +1,132 @@
+package groth16
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	bn254Curve "github.com/consensys/gnark-crypto/ecc/bn254"
+	"github.com/privacy-ethereum/privacy-precompiles/common"
+	"github.com/privacy-ethereum/privacy-precompiles/verifier/groth16/bn254"
+)
+
+// Groth16ValidateVerifyingKey implements a precompile checking that a BN254
+// Groth16 verifying key is structurally valid, without verifying a proof.
+//
+// Contracts can use it to vet a verifying key once, e.g. when it is
+// registered, instead of discovering a malformed key on the first
+// verification.
+type Groth16ValidateVerifyingKey struct{}
+
+// Name returns the human-readable identifier of the precompile, following
+// the format:
+//
+//	<CurveName>Groth16ValidateVerifyingKey
+func (c *Groth16ValidateVerifyingKey) Name() string {
+	return fmt.Sprintf("%sGroth16ValidateVerifyingKey", ecc.BN254.String())
+}
+
+// RequiredGas returns the gas cost of executing this precompile.
+//
+// Gas is calculated as:
+//
+//	Groth16ValidateVerifyingKeyBaseGas + k * Groth16ValidateVerifyingKeyPerPointGas
+//
+// Where k is the number of whole IC points following the fixed part of the
+// verifying key. Inputs rejected by Run with
+// ErrorGroth16VerifyInvalidInputLength are priced at 0.
+func (c *Groth16ValidateVerifyingKey) RequiredGas(input []byte) uint64 {
+	if len(input) < Groth16ValidateVerifyingKeyCountSize {
+		return 0
+	}
+
+	icPoints := max(0, len(input)-Groth16ValidateVerifyingKeyCountSize-bn254.BN254Groth16VerifyVerifyingKeySize) /
+		bn254.BN254Groth16G1Size
+
+	return Groth16ValidateVerifyingKeyBaseGas + uint64(icPoints)*Groth16ValidateVerifyingKeyPerPointGas
+}
+
+// MaxOutputSize returns the byte length of the boolean result returned by
+// Run, which is Groth16VerifyOutputSize regardless of the input.
+func (c *Groth16ValidateVerifyingKey) MaxOutputSize(input []byte) int {
+	return Groth16VerifyOutputSize
+}
+
+// Run checks the structure of a BN254 Groth16 verifying key.
+//
+// Expected input layout:
+//
+//	[ Count || VerifyingKey ]
+//
+// Where:
+//   - Count is a Groth16ValidateVerifyingKeyCountSize-byte big-endian
+//     number of public inputs n.
+//   - VerifyingKey uses the plain encoding of Groth16Verify: Alpha, Beta,
+//     Gamma, Delta and n + 1 IC points. The commitment extension is not
+//     supported.
+//
+// Return value:
+//   - []byte{1} if 1 <= n <= Groth16MaxPublicInputs, VerifyingKey holds
+//     exactly n + 1 IC points, and every point is on its curve and in the
+//     prime-order subgroup.
+//   - []byte{0} otherwise.
+//   - ErrorGroth16VerifyInvalidInputLength if the input is shorter than
+//     Count.
+func (c *Groth16ValidateVerifyingKey) Run(input []byte) ([]byte, error) {
+	if len(input) < Groth16ValidateVerifyingKeyCountSize {
+		return nil, ErrorGroth16VerifyInvalidInputLength
+	}
+
+	count := binary.BigEndian.Uint32(input[:Groth16ValidateVerifyingKeyCountSize])
+	vkBytes := input[Groth16ValidateVerifyingKeyCountSize:]
+
+	if count == 0 || count > Groth16MaxPublicInputs {
+		return []byte{0}, nil
+	}
+
+	numberOfPublicInputs := int(count)
+
+	if len(vkBytes) != bn254.BN254Groth16VerifyVerifyingKeySize+(numberOfPublicInputs+1)*bn254.BN254Groth16G1Size {
+		return []byte{0}, nil
+	}
+
+	if !validVerifyingKeyPoints(vkBytes, numberOfPublicInputs) {
+		return []byte{0}, nil
+	}
+
+	return []byte{1}, nil
+}
+
+// validVerifyingKeyPoints reports whether Alpha, Beta, Gamma, Delta and the
+// numberOfPublicInputs + 1 IC points of vkBytes are all valid, using
+// bn254.ParseValidatedG1 and bn254.ParseValidatedG2.
+func validVerifyingKeyPoints(vkBytes []byte, numberOfPublicInputs int) bool {
+	var g1 bn254Curve.G1Affine
+	var g2 bn254Curve.G2Affine
+
+	offset, err := bn254.ParseValidatedG1(vkBytes, 0, &g1)
+
+	if err != nil {
+		return false
+	}
+
+	for range 3 {
+		if offset, err = bn254.ParseValidatedG2(vkBytes, offset, &g2); err != nil {
+			return false
+		}
+	}
+
+	for range numberOfPublicInputs + 1 {
+		if offset, err = bn254.ParseValidatedG1(vkBytes, offset, &g1); err != nil {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Ensure Groth16ValidateVerifyingKey implements the common.Precompile interface.
+var _ common.Precompile = (*Groth16ValidateVerifyingKey)(nil)
+
+// Ensure Groth16ValidateVerifyingKey implements the common.OutputSizer interface.
+var _ common.OutputSizer = (*Groth16ValidateVerifyingKey)(nil)
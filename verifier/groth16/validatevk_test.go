@@ -0,0 +1,146 @@
+package groth16
+
+import (
+	"encoding/binary"
+	"slices"
+	"testing"
+
+	"github.com/privacy-ethereum/privacy-precompiles/common"
+	"github.com/privacy-ethereum/privacy-precompiles/verifier/groth16/bn254"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGroth16ValidateVerifyingKeyName(t *testing.T) {
+	precompile := Groth16ValidateVerifyingKey{}
+
+	assert.Equal(t, "bn254Groth16ValidateVerifyingKey", precompile.Name())
+}
+
+func TestGroth16ValidateVerifyingKeyMaxOutputSize(t *testing.T) {
+	precompile := Groth16ValidateVerifyingKey{}
+
+	var sizer common.OutputSizer = &precompile
+
+	assert.Equal(t, 1, sizer.MaxOutputSize(nil))
+}
+
+func TestGroth16ValidateVerifyingKey(t *testing.T) {
+	input := prepareCacheInput(t)
+	vkBytes := input[bn254.BN254Groth16ProofSize : len(input)-2*bn254.BN254Groth16SinglePublicInputSize]
+
+	withCount := func(count uint32, vkBytes []byte) []byte {
+		return append(binary.BigEndian.AppendUint32(nil, count), vkBytes...)
+	}
+
+	// (1, 1) does not satisfy y^2 = x^3 + 3.
+	offCurveG1 := make([]byte, bn254.BN254Groth16G1Size)
+	offCurveG1[bn254.BN254Groth16FieldSize-1] = 1
+	offCurveG1[bn254.BN254Groth16G1Size-1] = 1
+
+	offCurveIC := slices.Concat(vkBytes[:len(vkBytes)-bn254.BN254Groth16G1Size], offCurveG1)
+	offCurveAlpha := slices.Concat(offCurveG1, vkBytes[bn254.BN254Groth16G1Size:])
+
+	swappedBeta := slices.Clone(vkBytes)
+	beta := swappedBeta[bn254.BN254Groth16G1Size : bn254.BN254Groth16G1Size+bn254.BN254Groth16G2Size]
+	x1 := slices.Clone(beta[:bn254.BN254Groth16FieldSize])
+	copy(beta[:bn254.BN254Groth16FieldSize], beta[bn254.BN254Groth16FieldSize:2*bn254.BN254Groth16FieldSize])
+	copy(beta[bn254.BN254Groth16FieldSize:2*bn254.BN254Groth16FieldSize], x1)
+
+	gas := func(icPoints int) uint64 {
+		return Groth16ValidateVerifyingKeyBaseGas + uint64(icPoints)*Groth16ValidateVerifyingKeyPerPointGas
+	}
+
+	tests := []struct {
+		name          string
+		input         []byte
+		expected      []byte
+		expectedGas   uint64
+		expectedError error
+	}{
+		{
+			name:        "valid verifying key",
+			input:       withCount(2, vkBytes),
+			expected:    []byte{1},
+			expectedGas: gas(3),
+		},
+		{
+			name:        "off-curve IC point",
+			input:       withCount(2, offCurveIC),
+			expected:    []byte{0},
+			expectedGas: gas(3),
+		},
+		{
+			name:        "off-curve alpha",
+			input:       withCount(2, offCurveAlpha),
+			expected:    []byte{0},
+			expectedGas: gas(3),
+		},
+		{
+			name:        "beta with swapped coordinates",
+			input:       withCount(2, swappedBeta),
+			expected:    []byte{0},
+			expectedGas: gas(3),
+		},
+		{
+			name:        "count lower than the IC points",
+			input:       withCount(1, vkBytes),
+			expected:    []byte{0},
+			expectedGas: gas(3),
+		},
+		{
+			name:        "count greater than the IC points",
+			input:       withCount(3, vkBytes),
+			expected:    []byte{0},
+			expectedGas: gas(3),
+		},
+		{
+			name:        "count of zero",
+			input:       withCount(0, vkBytes[:len(vkBytes)-2*bn254.BN254Groth16G1Size]),
+			expected:    []byte{0},
+			expectedGas: gas(1),
+		},
+		{
+			name:        "count above the public input limit",
+			input:       withCount(Groth16MaxPublicInputs+1, vkBytes),
+			expected:    []byte{0},
+			expectedGas: gas(3),
+		},
+		{
+			name:        "truncated verifying key",
+			input:       withCount(2, vkBytes[:len(vkBytes)-1]),
+			expected:    []byte{0},
+			expectedGas: gas(2),
+		},
+		{
+			name:        "count without verifying key",
+			input:       withCount(2, nil),
+			expected:    []byte{0},
+			expectedGas: gas(0),
+		},
+		{
+			name:          "truncated count",
+			input:         []byte{0, 0, 2},
+			expectedError: ErrorGroth16VerifyInvalidInputLength,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			precompile := Groth16ValidateVerifyingKey{}
+
+			actual, err := precompile.Run(tt.input)
+			gas := precompile.RequiredGas(tt.input)
+
+			if tt.expectedError != nil {
+				assert.Equal(t, tt.expectedError, err)
+				assert.Equal(t, uint64(0), gas)
+
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, tt.expected, actual)
+			assert.Equal(t, tt.expectedGas, gas)
+		})
+	}
+}
@@ -0,0 +1,146 @@
+package groth16
+
+import (
+	"fmt"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	bn254Curve "github.com/consensys/gnark-crypto/ecc/bn254"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	"github.com/privacy-ethereum/privacy-precompiles/common"
+	"github.com/privacy-ethereum/privacy-precompiles/verifier/groth16/bn254"
+)
+
+// Groth16ComputeVkX implements a precompile computing the linear combination
+// of the verifying key IC points with the public inputs over BN254:
+//
+//	vk_x = IC[0] + input_1 * IC[1] + ... + input_n * IC[n]
+//
+// This is the public input term of the Groth16 pairing check. Exposing it
+// on its own lets callers split a verification across transactions or
+// cache the term for repeated public inputs.
+type Groth16ComputeVkX struct{}
+
+// Name returns the human-readable identifier of the precompile, following
+// the format:
+//
+//	<CurveName>Groth16ComputeVkX
+func (c *Groth16ComputeVkX) Name() string {
+	return fmt.Sprintf("%sGroth16ComputeVkX", ecc.BN254.String())
+}
+
+// RequiredGas returns the gas cost of executing this precompile.
+//
+// Gas is calculated as:
+//
+//	Groth16ComputeVkXBaseGas + n * bn254.BN254Groth16PerPublicInputGas
+//
+// Where n is the number of public inputs, each priced like a public input
+// of the BN254 verifier. Inputs rejected by Run with
+// ErrorGroth16VerifyInvalidInputLength are priced at 0.
+func (c *Groth16ComputeVkX) RequiredGas(input []byte) uint64 {
+	numberOfPublicInputs, err := computeVkXPublicInputs(input)
+
+	if err != nil {
+		return 0
+	}
+
+	return Groth16ComputeVkXBaseGas + uint64(numberOfPublicInputs)*bn254.BN254Groth16PerPublicInputGas
+}
+
+// MaxOutputSize returns the byte length of the G1 point returned by Run,
+// which is bn254.BN254Groth16G1Size regardless of the input.
+func (c *Groth16ComputeVkX) MaxOutputSize(input []byte) int {
+	return bn254.BN254Groth16G1Size
+}
+
+// Run computes vk_x for the provided IC points and public inputs.
+//
+// Expected input layout:
+//
+//	[ IC[0] || IC[1] || ... || IC[n] || input_1 || ... || input_n ]
+//
+// Where:
+//   - Each IC[i] is an uncompressed G1 point encoded as X || Y, with
+//     32-byte big-endian coordinates, as in the verifying key encoding.
+//   - Each input_i is a 32-byte big-endian scalar field element.
+//   - 1 <= n <= Groth16MaxPublicInputs.
+//
+// The result is returned as an uncompressed G1 point X || Y. The point at
+// infinity is encoded as 64 zero bytes.
+//
+// Returns an error if:
+//   - The input length does not match any valid n
+//     (ErrorGroth16VerifyInvalidInputLength).
+//   - Any IC point is not on the curve or not in the prime-order subgroup
+//     (ErrorGroth16VerifyInvalidVerifyingKey).
+//   - Any public input is not smaller than the scalar field modulus
+//     (ErrorGroth16VerifyInvalidPublicWitness).
+func (c *Groth16ComputeVkX) Run(input []byte) ([]byte, error) {
+	numberOfPublicInputs, err := computeVkXPublicInputs(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	ic := make([]bn254Curve.G1Affine, numberOfPublicInputs+1)
+	offset := 0
+
+	for index := range ic {
+		offset, err = bn254.ParseValidatedG1(input, offset, &ic[index])
+
+		if err != nil {
+			return nil, ErrorGroth16VerifyInvalidVerifyingKey
+		}
+	}
+
+	scalars := make([]fr.Element, numberOfPublicInputs)
+
+	for index := range scalars {
+		next := offset + bn254.BN254Groth16SinglePublicInputSize
+
+		if err := scalars[index].SetBytesCanonical(input[offset:next]); err != nil {
+			return nil, ErrorGroth16VerifyInvalidPublicWitness
+		}
+
+		offset = next
+	}
+
+	var vkX bn254Curve.G1Affine
+
+	if _, err := vkX.MultiExp(ic[1:], scalars, ecc.MultiExpConfig{}); err != nil {
+		return nil, err
+	}
+
+	vkX.Add(&vkX, &ic[0])
+
+	x := vkX.X.Bytes()
+	y := vkX.Y.Bytes()
+
+	return append(x[:], y[:]...), nil
+}
+
+// computeVkXPublicInputs returns the number of public inputs n encoded in a
+// Groth16ComputeVkX input, which must hold exactly n + 1 IC points followed
+// by n public inputs with 1 <= n <= Groth16MaxPublicInputs.
+func computeVkXPublicInputs(input []byte) (int, error) {
+	remaining := len(input) - bn254.BN254Groth16G1Size
+	pairSize := bn254.BN254Groth16G1Size + bn254.BN254Groth16SinglePublicInputSize
+
+	if remaining <= 0 || remaining%pairSize != 0 {
+		return 0, ErrorGroth16VerifyInvalidInputLength
+	}
+
+	numberOfPublicInputs := remaining / pairSize
+
+	if numberOfPublicInputs > Groth16MaxPublicInputs {
+		return 0, ErrorGroth16VerifyInvalidInputLength
+	}
+
+	return numberOfPublicInputs, nil
+}
+
+// Ensure Groth16ComputeVkX implements the common.Precompile interface.
+var _ common.Precompile = (*Groth16ComputeVkX)(nil)
+
+// Ensure Groth16ComputeVkX implements the common.OutputSizer interface.
+var _ common.OutputSizer = (*Groth16ComputeVkX)(nil)
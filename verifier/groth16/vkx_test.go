@@ -0,0 +1,200 @@
+package groth16
+
+import (
+	"math/big"
+	"slices"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	bn254Curve "github.com/consensys/gnark-crypto/ecc/bn254"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	"github.com/consensys/gnark/backend/groth16"
+	groth16bn254 "github.com/consensys/gnark/backend/groth16/bn254"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/frontend/cs/r1cs"
+	"github.com/privacy-ethereum/privacy-precompiles/common"
+	"github.com/privacy-ethereum/privacy-precompiles/verifier/groth16/bn254"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGroth16ComputeVkXName(t *testing.T) {
+	precompile := Groth16ComputeVkX{}
+
+	assert.Equal(t, "bn254Groth16ComputeVkX", precompile.Name())
+}
+
+func TestGroth16ComputeVkXMaxOutputSize(t *testing.T) {
+	precompile := Groth16ComputeVkX{}
+
+	var sizer common.OutputSizer = &precompile
+
+	assert.Equal(t, 64, sizer.MaxOutputSize(nil))
+}
+
+func TestGroth16ComputeVkX(t *testing.T) {
+	_, _, g1, _ := bn254Curve.Generators()
+
+	g1Bytes := serializeG1(g1)
+	offCurveG1 := make([]byte, bn254.BN254Groth16G1Size)
+	offCurveG1[bn254.BN254Groth16G1Size-1] = 1
+
+	scalarBytes := func(value *big.Int) []byte {
+		return value.FillBytes(make([]byte, bn254.BN254Groth16SinglePublicInputSize))
+	}
+
+	tests := []struct {
+		name          string
+		input         []byte
+		expected      []byte
+		expectedGas   uint64
+		expectedError error
+	}{
+		{
+			name:        "single public input",
+			input:       slices.Concat(g1Bytes, g1Bytes, scalarBytes(big.NewInt(2))),
+			expected:    serializeG1(naiveVkX([]bn254Curve.G1Affine{g1, g1}, []int64{2})),
+			expectedGas: Groth16ComputeVkXBaseGas + bn254.BN254Groth16PerPublicInputGas,
+		},
+		{
+			name:        "zero public inputs cancel out",
+			input:       slices.Concat(g1Bytes, g1Bytes, g1Bytes, scalarBytes(big.NewInt(0)), scalarBytes(big.NewInt(0))),
+			expected:    g1Bytes,
+			expectedGas: Groth16ComputeVkXBaseGas + 2*bn254.BN254Groth16PerPublicInputGas,
+		},
+		{
+			name: "result at infinity",
+			input: slices.Concat(
+				g1Bytes,
+				g1Bytes,
+				scalarBytes(new(big.Int).Sub(fr.Modulus(), big.NewInt(1))),
+			),
+			expected:    make([]byte, bn254.BN254Groth16G1Size),
+			expectedGas: Groth16ComputeVkXBaseGas + bn254.BN254Groth16PerPublicInputGas,
+		},
+		{
+			name:          "empty input",
+			input:         []byte{},
+			expectedError: ErrorGroth16VerifyInvalidInputLength,
+		},
+		{
+			name:          "IC[0] without public inputs",
+			input:         g1Bytes,
+			expectedError: ErrorGroth16VerifyInvalidInputLength,
+		},
+		{
+			name:          "misaligned input",
+			input:         slices.Concat(g1Bytes, g1Bytes, scalarBytes(big.NewInt(2)), []byte{0}),
+			expectedError: ErrorGroth16VerifyInvalidInputLength,
+		},
+		{
+			name:          "too many public inputs",
+			input:         make([]byte, bn254.BN254Groth16G1Size+(Groth16MaxPublicInputs+1)*(bn254.BN254Groth16G1Size+bn254.BN254Groth16SinglePublicInputSize)),
+			expectedError: ErrorGroth16VerifyInvalidInputLength,
+		},
+		{
+			name:          "off-curve IC point",
+			input:         slices.Concat(g1Bytes, offCurveG1, scalarBytes(big.NewInt(2))),
+			expectedError: ErrorGroth16VerifyInvalidVerifyingKey,
+		},
+		{
+			name:          "public input equal to the field modulus",
+			input:         slices.Concat(g1Bytes, g1Bytes, scalarBytes(fr.Modulus())),
+			expectedError: ErrorGroth16VerifyInvalidPublicWitness,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			precompile := Groth16ComputeVkX{}
+
+			actual, err := precompile.Run(tt.input)
+			gas := precompile.RequiredGas(tt.input)
+
+			if tt.expectedError != nil {
+				assert.Equal(t, tt.expectedError, err)
+
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, tt.expected, actual)
+			assert.Equal(t, tt.expectedGas, gas)
+		})
+	}
+}
+
+func TestGroth16ComputeVkXMatchesGnarkProof(t *testing.T) {
+	size := 3
+	circuit := &bn254.VariablePublicCircuit{Public: make([]frontend.Variable, size)}
+	assignment := &bn254.VariablePublicCircuit{Public: make([]frontend.Variable, size)}
+
+	for index := range assignment.Public {
+		assignment.Public[index] = index + 7
+	}
+
+	ccs, _ := frontend.Compile(ecc.BN254.ScalarField(), r1cs.NewBuilder, circuit)
+	pk, vk, _ := groth16.Setup(ccs)
+	fullWitness, _ := frontend.NewWitness(assignment, ecc.BN254.ScalarField())
+	publicWitness, _ := fullWitness.Public()
+
+	proof, err := groth16.Prove(ccs, pk, fullWitness)
+	assert.Nil(t, err)
+
+	typedProof := proof.(*groth16bn254.Proof)
+	typedVk := vk.(*groth16bn254.VerifyingKey)
+
+	input := make([]byte, 0)
+
+	for _, point := range typedVk.G1.K {
+		input = append(input, serializeG1(point)...)
+	}
+
+	for _, value := range publicWitness.Vector().(fr.Vector) {
+		bytes := value.Bytes()
+		input = append(input, bytes[:]...)
+	}
+
+	precompile := Groth16ComputeVkX{}
+	actual, err := precompile.Run(input)
+	assert.Nil(t, err)
+
+	var vkX bn254Curve.G1Affine
+	_, err = bn254.ParseG1(actual, 0, &vkX)
+	assert.Nil(t, err)
+
+	// e(A, B) = e(alpha, beta) * e(vk_x, gamma) * e(C, delta)
+	var alphaNeg, vkXNeg, krsNeg bn254Curve.G1Affine
+	alphaNeg.Neg(&typedVk.G1.Alpha)
+	vkXNeg.Neg(&vkX)
+	krsNeg.Neg(&typedProof.Krs)
+
+	ok, err := bn254Curve.PairingCheck(
+		[]bn254Curve.G1Affine{typedProof.Ar, alphaNeg, vkXNeg, krsNeg},
+		[]bn254Curve.G2Affine{typedProof.Bs, typedVk.G2.Beta, typedVk.G2.Gamma, typedVk.G2.Delta},
+	)
+
+	assert.Nil(t, err)
+	assert.True(t, ok)
+}
+
+// naiveVkX computes IC[0] + sum(inputs[i] * IC[i+1]) with one scalar
+// multiplication per public input.
+func naiveVkX(ic []bn254Curve.G1Affine, inputs []int64) bn254Curve.G1Affine {
+	result := ic[0]
+
+	for index, input := range inputs {
+		var term bn254Curve.G1Affine
+		term.ScalarMultiplication(&ic[index+1], big.NewInt(input))
+		result.Add(&result, &term)
+	}
+
+	return result
+}
+
+// serializeG1 encodes a G1 point as X || Y.
+func serializeG1(point bn254Curve.G1Affine) []byte {
+	x := point.X.Bytes()
+	y := point.Y.Bytes()
+
+	return append(x[:], y[:]...)
+}